@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/githealthchecker/git-health-checker/internal/cache"
+	"github.com/spf13/cobra"
+)
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the analyzer result cache",
+}
+
+var cacheCleanCmd = &cobra.Command{
+	Use:   "clean",
+	Short: "Remove all cached analyzer results",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		analysisCache, err := cache.New()
+		if err != nil {
+			return err
+		}
+
+		removed, err := analysisCache.Clean()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Removed %d cached result(s) from %s\n", removed, analysisCache.Dir())
+		return nil
+	},
+}
+
+func init() {
+	checkCmd.AddCommand(cacheCmd)
+	cacheCmd.AddCommand(cacheCleanCmd)
+}
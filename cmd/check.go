@@ -1,17 +1,29 @@
 package cmd
 
 import (
+	stdctx "context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/githealthchecker/git-health-checker/internal/analyzer"
+	"github.com/githealthchecker/git-health-checker/internal/baseline"
+	"github.com/githealthchecker/git-health-checker/internal/cache"
 	"github.com/githealthchecker/git-health-checker/internal/config"
+	"github.com/githealthchecker/git-health-checker/internal/filekind"
 	"github.com/githealthchecker/git-health-checker/internal/git"
+	"github.com/githealthchecker/git-health-checker/internal/progress"
 	"github.com/githealthchecker/git-health-checker/internal/report"
 	"github.com/githealthchecker/git-health-checker/internal/scanner"
+	"github.com/githealthchecker/git-health-checker/internal/scope"
+	"github.com/githealthchecker/git-health-checker/internal/verifier"
+	"github.com/gobwas/glob"
 	"github.com/spf13/cobra"
 )
 
@@ -27,15 +39,42 @@ and maintenance problems. If no path is provided, the current directory is used.
 }
 
 var (
-	enableSecurity     bool
-	enablePerformance  bool
-	enableQuality      bool
-	enableMaintenance  bool
-	enableWorkflow     bool
-	enableDependencies bool
-	enableGoWarnings   bool
-	failOnIssues       bool
-	severityThreshold  string
+	enableSecurity        bool
+	enablePerformance     bool
+	enableQuality         bool
+	enableMaintenance     bool
+	enableWorkflow        bool
+	enableDependencies    bool
+	enableLinters         bool
+	failOnIssues          bool
+	severityThreshold     string
+	useBaseline           bool
+	updateBaseline        bool
+	baselineFile          string
+	noCache               bool
+	osvDBPath             string
+	osvSymbols            bool
+	vulnerabilitySource   string
+	vulnerabilityCacheTTL time.Duration
+	failOnAnalyzerError   bool
+	progressMode          string
+	strictSuppressions    bool
+	rulesFile             string
+	verifyMode            string
+	filePatternFlags      []string
+	sbomFormat            string
+	sbomOutPath           string
+	checkTimeout          time.Duration
+	noBlame               bool
+	scanHistory           bool
+	historySince          string
+	historyBaselineFile   string
+	updateHistoryBaseline bool
+	scanJobs              int
+	scopePatterns         []string
+	buildTags             []string
+	newFromBaseline       bool
+	newFromPatch          string
 )
 
 func init() {
@@ -47,18 +86,139 @@ func init() {
 	checkCmd.Flags().BoolVar(&enableMaintenance, "maintenance", false, "enable maintenance analysis")
 	checkCmd.Flags().BoolVar(&enableWorkflow, "workflow", false, "enable workflow analysis")
 	checkCmd.Flags().BoolVar(&enableDependencies, "dependencies", false, "enable dependency analysis")
-	checkCmd.Flags().BoolVar(&enableGoWarnings, "go-warnings", false, "enable Go compiler warnings analysis")
+	checkCmd.Flags().BoolVar(&enableLinters, "linters", false, "enable the pluggable external-linter subsystem (go vet, staticcheck, eslint, ruff, ...)")
 	checkCmd.Flags().BoolVar(&failOnIssues, "fail-on-issues", false, "exit with non-zero code if issues found")
 	checkCmd.Flags().StringVar(&severityThreshold, "severity", "low", "minimum severity level (low, medium, high, critical)")
+	checkCmd.Flags().BoolVar(&useBaseline, "baseline", false, "only report issues not already accepted in the baseline lockfile")
+	checkCmd.Flags().BoolVar(&updateBaseline, "update-baseline", false, "accept the current set of issues into the baseline lockfile")
+	checkCmd.Flags().StringVar(&baselineFile, "baseline-file", ".healthcheck.lock", "path to the baseline lockfile used by --baseline/--update-baseline")
+	checkCmd.Flags().BoolVar(&noCache, "no-cache", false, "disable the analyzer result cache and always re-run every analyzer")
+	checkCmd.Flags().StringVar(&osvDBPath, "osv-db", "", "path to a local clone of the OSV JSON database, queried instead of api.osv.dev")
+	checkCmd.Flags().BoolVar(&osvSymbols, "symbols", false, "only report OSV vulnerabilities whose affected symbols appear in the repository's source")
+	checkCmd.Flags().StringVar(&vulnerabilitySource, "vulnerability-source", "", "vulnerability backend to query: osv (default), github, or local (offline cache only)")
+	checkCmd.Flags().DurationVar(&vulnerabilityCacheTTL, "vulnerability-cache-ttl", 0, "cache vulnerability lookups on disk for this long before refetching, e.g. 24h (0 disables caching)")
+	checkCmd.Flags().BoolVar(&failOnAnalyzerError, "fail-on-analyzer-error", false, "exit with non-zero code if any analyzer itself fails to run, instead of best-effort continuing")
+	checkCmd.Flags().StringVar(&progressMode, "progress", "auto", "progress renderer for long analyses: auto, tty, json, or none")
+	checkCmd.Flags().BoolVar(&strictSuppressions, "strict", false, "report unused 'githealth:ignore' suppression comments as their own finding")
+	checkCmd.Flags().StringVar(&rulesFile, "rules-file", "", "path to a YAML file of structured secret-detection rules, replacing the built-in rule pack")
+	checkCmd.Flags().StringVar(&verifyMode, "verify", "off", "confirm detected secrets are live against their issuing service: off, safe (read-only identity checks only), or all")
+	checkCmd.Flags().StringArrayVar(&filePatternFlags, "file-patterns", nil, "additional kind=regex file classification, e.g. --file-patterns go=\\.tmpl\\.go$ (repeatable, merges over the built-in pack and config file_patterns)")
+	checkCmd.Flags().StringVar(&sbomFormat, "sbom", "", "emit a software bill of materials instead of a health report: cyclonedx or spdx")
+	checkCmd.Flags().StringVar(&sbomOutPath, "sbom-out", "", "path to write the SBOM to (default: stdout)")
+	checkCmd.Flags().DurationVar(&checkTimeout, "timeout", 0, "maximum time to allow the scan to run, e.g. 5m (0 disables the timeout)")
+	checkCmd.Flags().BoolVar(&noBlame, "no-blame", false, "skip git-blame attribution (author, last-modified, commit) for file/line-scoped issues")
+	checkCmd.Flags().BoolVar(&scanHistory, "scan-history", false, "also scan every blob in git history for secrets, not just the files in HEAD (slower)")
+	checkCmd.Flags().StringVar(&historySince, "since", "", "with --scan-history, stop walking history at this ref (exclusive) instead of the full history")
+	checkCmd.Flags().StringVar(&historyBaselineFile, "history-baseline-file", ".healthcheck-baseline.json", "path to the baseline file used to suppress already-triaged --scan-history findings")
+	checkCmd.Flags().BoolVar(&updateHistoryBaseline, "update-history-baseline", false, "accept the current --scan-history findings into the history baseline")
+	checkCmd.Flags().IntVar(&scanJobs, "jobs", 0, "max files scanned concurrently per analyzer (0 uses GOMAXPROCS)")
+	checkCmd.Flags().StringArrayVar(&scopePatterns, "scope", nil, "restrict the scan to Go-style package patterns, e.g. --scope ./cmd/... (repeatable); prefix with \"-\" to exclude, e.g. --scope -internal/testdata/...")
+	checkCmd.Flags().StringSliceVar(&buildTags, "tags", nil, "comma-separated Go build tags to consider satisfied when resolving unused dependencies, e.g. --tags=integration,linux")
+	checkCmd.Flags().BoolVar(&newFromBaseline, "new-from-baseline", false, "shorthand for --baseline plus --fail-on-issues: only report new issues, and fail the build if there are any")
+	checkCmd.Flags().StringVar(&newFromPatch, "new-from-patch", "", "only report issues on lines added or modified by this unified diff file, e.g. from 'git diff'")
+}
+
+// resolveFileKinds merges cfg.FilePatterns with any --file-patterns flag
+// values into a single filekind.Resolver shared by the security and quality
+// analyzers, so both classify files the same way.
+func resolveFileKinds(cfg *config.Config) (*filekind.Resolver, error) {
+	overrides := append([]filekind.Pattern{}, cfg.FilePatterns...)
+
+	for _, raw := range filePatternFlags {
+		kind, pattern, ok := strings.Cut(raw, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid --file-patterns value %q, expected kind=regex", raw)
+		}
+		overrides = append(overrides, filekind.Pattern{Kind: kind, Pattern: pattern})
+	}
+
+	return filekind.NewResolver(overrides)
+}
+
+// newFileScanner builds a scanner.FileScanner for repo, applying --jobs over
+// the scanner's own GOMAXPROCS-based default when the user set it, and
+// restricting it to --scope's package patterns when the user supplied any.
+func newFileScanner(repo *git.Repository) (*scanner.FileScanner, error) {
+	fileScanner, err := scanner.NewFileScanner(repo.GetPath())
+	if err != nil {
+		return nil, err
+	}
+	if scanJobs > 0 {
+		fileScanner.SetJobs(scanJobs)
+	}
+
+	repoScope, err := scope.Parse(scopePatterns)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --scope pattern: %w", err)
+	}
+	fileScanner.SetScope(repoScope)
+
+	return fileScanner, nil
+}
+
+// filterIssuesByScope drops issues whose File falls outside --scope's
+// package patterns, for analyzers like LintersAnalyzer that invoke an
+// external tool over the whole repository rather than consuming a
+// scanner.FileScanner directly. An issue with no File (a repository-wide
+// finding) always passes through, since it isn't scoped to any one path.
+func filterIssuesByScope(issues []report.Issue) ([]report.Issue, error) {
+	if len(scopePatterns) == 0 {
+		return issues, nil
+	}
+
+	repoScope, err := scope.Parse(scopePatterns)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --scope pattern: %w", err)
+	}
+
+	var filtered []report.Issue
+	for _, issue := range issues {
+		if issue.File == "" || repoScope.Match(issue.File) {
+			filtered = append(filtered, issue)
+		}
+	}
+	return filtered, nil
+}
+
+// resolveProgressMode turns --progress=auto into "tty" or "json" depending
+// on whether stderr (where progress is written, like the existing
+// verbose Reporter) is a terminal, so CI logs get structured lines instead
+// of carriage-return redraws by default.
+func resolveProgressMode() string {
+	if progressMode != "auto" {
+		return progressMode
+	}
+	if progress.IsTerminal(os.Stderr) {
+		return "tty"
+	}
+	return "json"
+}
+
+// resolveBaselineMode reconciles --new-from-baseline with --baseline and
+// --fail-on-issues: it's sugar for the common incremental-adoption entry
+// point, "only report issues not already accepted, and fail the build if
+// there are any".
+func resolveBaselineMode() {
+	if newFromBaseline {
+		useBaseline = true
+		failOnIssues = true
+	}
 }
 
 func runCheck(cmd *cobra.Command, args []string) error {
 	startTime := time.Now()
 
+	resolveBaselineMode()
+
 	context, err := setupCheckContext(cmd, args)
 	if err != nil {
 		return err
 	}
+	defer context.cancel()
+
+	if sbomFormat != "" {
+		return writeSBOM(context.absPath)
+	}
 
 	healthReport, err := performHealthCheck(context, startTime)
 	if err != nil {
@@ -75,6 +235,8 @@ func runCheck(cmd *cobra.Command, args []string) error {
 }
 
 type checkContext struct {
+	ctx     stdctx.Context
+	cancel  stdctx.CancelFunc
 	absPath string
 	repo    *git.Repository
 	cfg     *config.Config
@@ -83,7 +245,25 @@ type checkContext struct {
 	verbose bool
 }
 
+// buildRootContext wires up the root context for a scan: it's canceled on
+// Ctrl-C, and additionally bounded by --timeout when the user supplied one,
+// so a scan of a large monorepo can be interrupted either way.
+func buildRootContext() (stdctx.Context, stdctx.CancelFunc) {
+	ctx, stop := signal.NotifyContext(stdctx.Background(), os.Interrupt)
+	if checkTimeout <= 0 {
+		return ctx, stop
+	}
+
+	ctx, cancel := stdctx.WithTimeout(ctx, checkTimeout)
+	return ctx, func() {
+		cancel()
+		stop()
+	}
+}
+
 func setupCheckContext(cmd *cobra.Command, args []string) (*checkContext, error) {
+	ctx, cancel := buildRootContext()
+
 	targetPath := "."
 	if len(args) > 0 {
 		targetPath = args[0]
@@ -91,25 +271,30 @@ func setupCheckContext(cmd *cobra.Command, args []string) (*checkContext, error)
 
 	absPath, err := filepath.Abs(targetPath)
 	if err != nil {
+		cancel()
 		return nil, fmt.Errorf("failed to resolve path %s: %w", targetPath, err)
 	}
 
 	if !git.IsGitRepository(absPath) {
+		cancel()
 		return nil, fmt.Errorf("path %s is not a Git repository", absPath)
 	}
 
 	configPath, _ := cmd.Flags().GetString("config")
-	cfg, err := config.Load(configPath)
+	cfg, err := config.LoadCtx(ctx, configPath)
 	if err != nil {
+		cancel()
 		return nil, fmt.Errorf("failed to load configuration: %w", err)
 	}
 
 	if err := cfg.Validate(); err != nil {
+		cancel()
 		return nil, fmt.Errorf("invalid configuration: %w", err)
 	}
 
 	repo, err := git.OpenRepository(absPath)
 	if err != nil {
+		cancel()
 		return nil, fmt.Errorf("failed to open repository: %w", err)
 	}
 
@@ -126,6 +311,8 @@ func setupCheckContext(cmd *cobra.Command, args []string) (*checkContext, error)
 	verbose, _ := cmd.Flags().GetBool("verbose")
 
 	return &checkContext{
+		ctx:     ctx,
+		cancel:  cancel,
 		absPath: absPath,
 		repo:    repo,
 		cfg:     cfg,
@@ -149,26 +336,217 @@ func performHealthCheck(context *checkContext, startTime time.Time) (*report.Rep
 	enableAllAnalysisIfNoneSelected()
 
 	if context.verbose {
-		fmt.Printf("Analyzing repository: %s\n", context.absPath)
-		fmt.Printf("Branch: %s | Commit: %s\n", context.branch, context.commit[:8])
-		fmt.Println("Running health checks...")
+		logger.Info(fmt.Sprintf("Analyzing repository: %s", context.absPath), "phase", "start", "path", context.absPath)
+		logger.Info(fmt.Sprintf("Branch: %s | Commit: %s", context.branch, context.commit[:8]), "phase", "start", "branch", context.branch, "commit", context.commit)
+		logger.Info("Running health checks...", "phase", "start")
 	}
 
-	if err := runAnalyses(context.repo, context.cfg, healthReport, context.verbose); err != nil {
-		return nil, fmt.Errorf("analysis failed: %w", err)
+	// Code stats run first: the linters analysis (inside runAnalyses) uses
+	// their LanguageBreakdown to decide which per-language linters apply.
+	if err := runCodeStatsAnalysis(context.ctx, context.repo, healthReport, context.verbose); err != nil {
+		return nil, fmt.Errorf("code stats analysis failed: %w", err)
 	}
 
-	// Analyze code statistics
-	if err := runCodeStatsAnalysis(context.repo, healthReport, context.verbose); err != nil {
-		return nil, fmt.Errorf("code stats analysis failed: %w", err)
+	if err := runAnalyses(context.ctx, context.repo, context.cfg, healthReport, context.verbose); err != nil {
+		if failOnAnalyzerError {
+			return nil, fmt.Errorf("analysis failed: %w", err)
+		}
+		if context.verbose {
+			logger.Info(fmt.Sprintf("  - continuing after analyzer failure(s): %v", err), "phase", "analyze")
+		}
+	}
+
+	healthReport.Issues = applyRuleOverrides(context.cfg, healthReport.Issues)
+
+	healthReport.Issues = applySuppressions(context.cfg, context.absPath, healthReport.Issues, strictSuppressions)
+
+	if err := applyBaseline(context.absPath, healthReport); err != nil {
+		return nil, fmt.Errorf("baseline processing failed: %w", err)
+	}
+
+	if err := applyPatchFilter(context.absPath, healthReport); err != nil {
+		return nil, fmt.Errorf("patch filtering failed: %w", err)
 	}
 
+	enrichBlame(context.repo, context.cfg, healthReport.Issues)
+
+	report.EnrichHelpURIs(healthReport.Issues)
+
+	healthReport.Issues = report.ApplySeverityRules(healthReport.Issues, context.cfg.Severity)
+
 	healthReport.Duration = time.Since(startTime).String()
 	healthReport.Summary = calculateSummary(healthReport.Issues)
 
 	return healthReport, nil
 }
 
+// applyBaseline loads the .healthcheck.lock lockfile (when --baseline or
+// --update-baseline is set) and either rewrites it with the current issues
+// or filters healthReport.Issues down to the ones not already accepted,
+// pruning any lockfile entry that no longer matches a current issue either
+// way. Issues are fingerprinted against their surrounding source (see
+// baseline.ReadContext) rather than their raw line number, so an unrelated
+// edit that shifts a known issue up or down a few lines doesn't make it
+// look newly introduced.
+func applyBaseline(repoPath string, healthReport *report.Report) error {
+	if !useBaseline && !updateBaseline {
+		return nil
+	}
+
+	lockPath := baselineFile
+	if !filepath.IsAbs(lockPath) {
+		lockPath = filepath.Join(repoPath, lockPath)
+	}
+
+	lock, err := baseline.Load(lockPath)
+	if err != nil {
+		return err
+	}
+
+	contextFor := func(issue report.Issue) []string {
+		return baseline.ReadContext(repoPath, issue, 3)
+	}
+
+	if updateBaseline {
+		baseline.RebuildWithContext(lock, healthReport.Issues, contextFor)
+		if err := lock.Save(lockPath); err != nil {
+			return err
+		}
+		logger.Info(fmt.Sprintf("📌 Accepted %d issue(s) into baseline lockfile: %s", len(lock.Issues), lockPath),
+			"phase", "baseline", "action", "update", "issues", len(lock.Issues), "path", lockPath)
+		return nil
+	}
+
+	pruned := baseline.PruneWithContext(lock, healthReport.Issues, contextFor)
+	newIssues := baseline.FilterNewWithContext(healthReport.Issues, lock, contextFor)
+
+	if pruned > 0 {
+		if err := lock.Save(lockPath); err != nil {
+			return err
+		}
+	}
+
+	logger.Info(fmt.Sprintf("📌 Baseline suppressed %d known issue(s), %d pruned from lockfile", len(healthReport.Issues)-len(newIssues), pruned),
+		"phase", "baseline", "action", "filter", "suppressed", len(healthReport.Issues)-len(newIssues), "pruned", pruned, "path", lockPath)
+
+	healthReport.Issues = newIssues
+	return nil
+}
+
+// applyPatchFilter narrows healthReport.Issues down to only the ones on
+// lines --new-from-patch's unified diff added or modified (see
+// baseline.ParsePatch), the other half of the incremental-adoption story
+// --baseline covers: reviewing just what one patch changed instead of
+// fixing every pre-existing finding in the files it touches.
+func applyPatchFilter(repoPath string, healthReport *report.Report) error {
+	if newFromPatch == "" {
+		return nil
+	}
+
+	patchPath := newFromPatch
+	if !filepath.IsAbs(patchPath) {
+		patchPath = filepath.Join(repoPath, patchPath)
+	}
+
+	diff, err := os.ReadFile(patchPath)
+	if err != nil {
+		return fmt.Errorf("failed to read patch file %s: %w", patchPath, err)
+	}
+
+	healthReport.Issues = baseline.FilterByPatch(healthReport.Issues, baseline.ParsePatch(string(diff)))
+	return nil
+}
+
+// applyRuleOverrides drops any issue whose rule a config profile disabled
+// via Config.RuleEnabled, and applies a profile's severity override via
+// Config.RuleSeverity to everything that survives - the single place every
+// analyzer's findings pass through Rules, so a profile's `rules:` section
+// (including anything pulled in via `extends`) applies uniformly without
+// each analyzer having to consult it individually.
+func applyRuleOverrides(cfg *config.Config, issues []report.Issue) []report.Issue {
+	if len(cfg.Rules) == 0 {
+		return issues
+	}
+
+	kept := issues[:0]
+	for _, issue := range issues {
+		if issue.Rule != "" && !cfg.RuleEnabled(issue.Rule) {
+			continue
+		}
+		issue.Severity = cfg.RuleSeverity(issue.Rule, issue.Severity)
+		kept = append(kept, issue)
+	}
+
+	return kept
+}
+
+// applySuppressions drops every issue an inline `githealth:ignore` comment
+// silences, reading referenced files relative to repoPath. When strict is
+// set (and the profile hasn't disabled it via Suppressions.DisableUnusedCheck)
+// it also appends an `unused-suppression` diagnostic for every directive
+// that matched nothing, the same way --strict already surfaces unused
+// secret-detection suppressions.
+func applySuppressions(cfg *config.Config, repoPath string, issues []report.Issue, strict bool) []report.Issue {
+	suppressions := report.NewSuppressions(func(path string) ([]byte, error) {
+		return os.ReadFile(filepath.Join(repoPath, path))
+	})
+
+	kept := suppressions.Apply(issues)
+
+	if strict && !cfg.Suppressions.DisableUnusedCheck {
+		kept = append(kept, suppressions.UnusedIssues()...)
+	}
+
+	return kept
+}
+
+// enrichBlame attributes each file/line-scoped issue to whoever git blame
+// says last touched that line - run after baseline filtering so blame is
+// only computed for issues that actually survive into the report. It's
+// skipped entirely under --no-blame, and BlameLine itself skips any file
+// not yet committed to HEAD. A quality issue whose line has gone untouched
+// for at least cfg.Quality.StaleCodeDays is tagged in Metadata, so reports
+// can call out long-neglected findings distinctly from freshly introduced
+// ones. An issue that already carries a CommitHash - a
+// analyzer.HistorySecretAnalyzer finding, attributed to the historical
+// commit that introduced it rather than HEAD - is left untouched, since
+// blaming HEAD would overwrite that with the wrong commit.
+func enrichBlame(repo *git.Repository, cfg *config.Config, issues []report.Issue) {
+	if noBlame {
+		return
+	}
+
+	for i := range issues {
+		issue := &issues[i]
+		if issue.File == "" || issue.Line <= 0 || issue.CommitHash != "" {
+			continue
+		}
+
+		info, err := repo.BlameLine(issue.File, issue.Line)
+		if err != nil || info == nil {
+			continue
+		}
+
+		issue.Author = info.Author
+		issue.AuthorEmail = info.AuthorEmail
+		issue.LastModified = info.LastModified
+		issue.CommitHash = info.CommitHash
+
+		if issue.Category != report.CategoryQuality || cfg.Quality.StaleCodeDays <= 0 {
+			continue
+		}
+
+		staleAfter := time.Duration(cfg.Quality.StaleCodeDays) * 24 * time.Hour
+		if age := time.Since(info.LastModified); age >= staleAfter {
+			if issue.Metadata == nil {
+				issue.Metadata = make(map[string]string)
+			}
+			issue.Metadata["stale"] = "true"
+			issue.Metadata["last_modified_days_ago"] = fmt.Sprintf("%d", int(age.Hours()/24))
+		}
+	}
+}
+
 func enableAllAnalysisIfNoneSelected() {
 	if !anyAnalysisEnabled() {
 		enableSecurity = true
@@ -177,26 +555,42 @@ func enableAllAnalysisIfNoneSelected() {
 		enableMaintenance = true
 		enableWorkflow = true
 		enableDependencies = true
-		enableGoWarnings = true
+		enableLinters = true
 	}
 }
 
 func outputResults(cmd *cobra.Command, healthReport *report.Report, verbose bool) error {
 	formatFlag, _ := cmd.Flags().GetString("format")
-	formatter := report.GetFormatter(formatFlag)
+	templatePath, _ := cmd.Flags().GetString("format-template")
+	if templatePath != "" {
+		formatFlag = "template"
+	}
+
+	outputPath, _ := cmd.Flags().GetString("output")
+
+	// SARIF streams straight to its destination via report.SARIFWriter
+	// rather than going through Formatter.Format, so a large scan's SARIF
+	// log isn't held as a second full in-memory string before being written.
+	if strings.EqualFold(formatFlag, "sarif") {
+		return writeSARIFResults(healthReport, outputPath, verbose)
+	}
+
+	formatter, err := report.GetFormatter(formatFlag, templatePath)
+	if err != nil {
+		return err
+	}
 
 	output, err := formatter.Format(healthReport)
 	if err != nil {
 		return fmt.Errorf("failed to format report: %w", err)
 	}
 
-	outputPath, _ := cmd.Flags().GetString("output")
 	if outputPath != "" {
 		if err := writeOutputToFile(output, outputPath); err != nil {
 			return fmt.Errorf("failed to write output file: %w", err)
 		}
 		if verbose {
-			fmt.Printf("Report written to: %s\n", outputPath)
+			logger.Info(fmt.Sprintf("Report written to: %s", outputPath), "phase", "output", "path", outputPath)
 		}
 	} else {
 		fmt.Print(output)
@@ -205,6 +599,67 @@ func outputResults(cmd *cobra.Command, healthReport *report.Report, verbose bool
 	return nil
 }
 
+// writeSARIFResults streams healthReport's SARIF log to outputPath, or to
+// stdout if outputPath is empty.
+func writeSARIFResults(healthReport *report.Report, outputPath string, verbose bool) error {
+	dst := os.Stdout
+
+	if outputPath != "" {
+		if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+			return fmt.Errorf("failed to write output file: %w", err)
+		}
+		file, err := os.Create(outputPath)
+		if err != nil {
+			return fmt.Errorf("failed to write output file: %w", err)
+		}
+		defer file.Close()
+		dst = file
+	}
+
+	if err := report.NewSARIFWriter().WriteTo(dst, healthReport); err != nil {
+		return fmt.Errorf("failed to format report: %w", err)
+	}
+
+	if outputPath != "" && verbose {
+		logger.Info(fmt.Sprintf("Report written to: %s", outputPath), "phase", "output", "path", outputPath)
+	}
+
+	return nil
+}
+
+// writeSBOM renders repoPath's dependencies as a CycloneDX or SPDX document
+// per --sbom and writes it to --sbom-out, or stdout if that's unset. It runs
+// instead of the full health check, since an SBOM isn't a report.Report.
+func writeSBOM(repoPath string) error {
+	exporter := analyzer.NewSBOMExporter(repoPath)
+
+	var (
+		data []byte
+		err  error
+	)
+	switch sbomFormat {
+	case "cyclonedx":
+		data, err = exporter.CycloneDX()
+	case "spdx":
+		data, err = exporter.SPDX()
+	default:
+		return fmt.Errorf("invalid --sbom value %q, expected cyclonedx or spdx", sbomFormat)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to generate SBOM: %w", err)
+	}
+
+	if sbomOutPath == "" {
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if err := writeOutputToFile(string(data), sbomOutPath); err != nil {
+		return fmt.Errorf("failed to write SBOM output file: %w", err)
+	}
+	return nil
+}
+
 func handleFailOnIssues(healthReport *report.Report) {
 	if failOnIssues && len(healthReport.Issues) > 0 {
 		filteredIssues := filterIssuesBySeverity(healthReport.Issues, severityThreshold)
@@ -215,74 +670,300 @@ func handleFailOnIssues(healthReport *report.Report) {
 }
 
 func anyAnalysisEnabled() bool {
-	return enableSecurity || enablePerformance || enableQuality || enableMaintenance || enableWorkflow || enableDependencies || enableGoWarnings
+	return enableSecurity || enablePerformance || enableQuality || enableMaintenance || enableWorkflow || enableDependencies || enableLinters
 }
 
+// analysisRunner wraps one enabled/disabled analyzer. config holds the
+// analyzer's configuration subset (or nil) purely so execute can derive a
+// cache.Fingerprint for it; it isn't otherwise used. relevantFiles narrows
+// the file hashes that go into that fingerprint to the ones this analyzer
+// actually reads, so e.g. editing a CSS asset doesn't bust the dependency
+// analyzer's cache entry; nil means "every scanned file is relevant",
+// which is the right default for analyzers like security that walk the
+// whole tree.
 type analysisRunner struct {
-	name    string
-	enabled bool
-	runner  func() error
+	name          string
+	enabled       bool
+	config        any
+	relevantFiles func(path string) bool
+	runner        func() error
 }
 
-func (a *analysisRunner) execute(verbose bool) error {
-	if !a.enabled {
-		return nil
+// isDependencyRelevantFile reports whether path is one of the inputs the
+// dependency analyzer actually reads: Go module files, Go source (for its
+// import-based unused-dependency scan), and the Node.js manifest/lockfiles.
+func isDependencyRelevantFile(path string) bool {
+	base := filepath.Base(path)
+	switch base {
+	case goModFile, goSumFile, goWorkFile, "package.json", "package-lock.json", "yarn.lock", "pnpm-lock.yaml", "requirements.txt", "poetry.lock", "Cargo.lock":
+		return true
+	}
+	return strings.HasSuffix(path, ".go")
+}
+
+const (
+	goModFile  = "go.mod"
+	goSumFile  = "go.sum"
+	goWorkFile = "go.work"
+)
+
+// execute runs the analyzer unless a cached result already exists for the
+// current fingerprint (analyzer name + config + file content hashes + tool
+// version), in which case the cached issues are appended and the runner is
+// skipped entirely. A cache miss runs the analyzer normally and stores its
+// newly appended issues under the fingerprint for next time. It reports
+// whether the fingerprint was a cache hit, for the caller to tally; the
+// returned error is purely informational for the caller to aggregate: a
+// failing analyzer never stops its siblings from running.
+func (a *analysisRunner) execute(verbose bool, healthReport *report.Report, analysisCache *cache.Cache, fileHashes map[string]string, toolVersion string, skipCache bool) (bool, error) {
+	if !a.enabled || a.runner == nil {
+		return false, nil
 	}
 
 	if verbose {
-		fmt.Printf("  - Running %s analysis...\n", a.name)
+		logger.Info(fmt.Sprintf("  - Running %s analysis...", a.name), "phase", "analyze", "analyzer", a.name)
 	}
 
-	if a.runner != nil {
-		if err := a.runner(); err != nil {
-			return fmt.Errorf("%s analysis failed: %w", a.name, err)
+	if analysisCache != nil && !skipCache {
+		scopedHashes := fileHashes
+		if a.relevantFiles != nil {
+			scopedHashes = make(map[string]string, len(fileHashes))
+			for path, hash := range fileHashes {
+				if a.relevantFiles(path) {
+					scopedHashes[path] = hash
+				}
+			}
+		}
+
+		if fingerprint, err := cache.Fingerprint(a.name, a.config, scopedHashes, toolVersion); err == nil {
+			if cached, hit, err := analysisCache.Get(fingerprint); err == nil && hit {
+				healthReport.Issues = append(healthReport.Issues, cached...)
+				if verbose {
+					logger.Info(fmt.Sprintf("    (%d cached issue(s), skipped re-analysis)", len(cached)), "phase", "analyze", "analyzer", a.name, "cached_issues", len(cached))
+				}
+				return true, nil
+			}
+
+			before := len(healthReport.Issues)
+			if err := a.runner(); err != nil {
+				return false, fmt.Errorf("%s analysis failed: %w", a.name, err)
+			}
+
+			fresh := append([]report.Issue{}, healthReport.Issues[before:]...)
+			if err := analysisCache.Set(fingerprint, fresh); err != nil && verbose {
+				logger.Warn(fmt.Sprintf("    warning: failed to cache %s results: %v", a.name, err), "phase", "analyze", "analyzer", a.name)
+			}
+			return false, nil
 		}
 	}
 
-	return nil
+	if err := a.runner(); err != nil {
+		return false, fmt.Errorf("%s analysis failed: %w", a.name, err)
+	}
+
+	return false, nil
 }
 
-func runAnalyses(repo *git.Repository, cfg *config.Config, healthReport *report.Report, verbose bool) error {
+func runAnalyses(ctx stdctx.Context, repo *git.Repository, cfg *config.Config, healthReport *report.Report, verbose bool) error {
+	analysisCache, fileHashes := setupAnalysisCache(ctx, repo, verbose)
+
 	analyses := []analysisRunner{
-		{name: "security", enabled: enableSecurity, runner: func() error {
-			return runSecurityAnalysis(repo, cfg, healthReport)
+		{name: "security", enabled: enableSecurity, config: cfg.Security, runner: func() error {
+			return runSecurityAnalysis(repo, cfg, healthReport, verbose)
 		}},
-		{name: "performance", enabled: enablePerformance, runner: func() error {
+		{name: "history-secrets", enabled: scanHistory, config: cfg.Security, runner: func() error {
+			return runHistorySecretAnalysis(ctx, repo, cfg, healthReport)
+		}},
+		{name: "performance", enabled: enablePerformance, config: cfg.Performance, runner: func() error {
 			return runPerformanceAnalysis(repo, cfg, healthReport)
 		}},
-		{name: "quality", enabled: enableQuality, runner: func() error {
+		{name: "quality", enabled: enableQuality, config: cfg.Quality, runner: func() error {
 			return runQualityAnalysis(repo, cfg, healthReport)
 		}},
-		{name: "maintenance", enabled: enableMaintenance, runner: func() error {
+		{name: "maintenance", enabled: enableMaintenance, config: cfg.Maintenance, runner: func() error {
 			return runMaintenanceAnalysis(repo, cfg, healthReport)
 		}},
-		{name: "workflow", enabled: enableWorkflow, runner: func() error {
-			return runWorkflowAnalysis(repo, cfg, healthReport)
+		{name: "workflow", enabled: enableWorkflow, config: cfg.Workflow, runner: func() error {
+			return runWorkflowAnalysis(ctx, repo, cfg, healthReport)
 		}},
-		{name: "dependencies", enabled: enableDependencies, runner: func() error {
+		{name: "dependencies", enabled: enableDependencies, config: cfg.Dependencies, relevantFiles: isDependencyRelevantFile, runner: func() error {
 			return runDependencyAnalysis(repo, cfg, healthReport)
 		}},
-		{name: "go-warnings", enabled: enableGoWarnings, runner: func() error {
-			return runGoWarningsAnalysis(repo, cfg, healthReport)
+		{name: "linters", enabled: enableLinters, config: cfg.Linters, runner: func() error {
+			return runLintersAnalysis(repo, cfg, healthReport)
 		}},
 	}
 
+	progressEvents := make(chan progress.AnalyzerProgress)
+	renderer := progress.NewMultiRenderer(resolveProgressMode(), os.Stderr)
+	rendered := make(chan struct{})
+	go func() {
+		renderer.Render(progressEvents)
+		close(rendered)
+	}()
+
+	totalFiles := len(fileHashes)
+
+	var errs []error
+	var cacheHits, cacheMisses int
 	for _, analysis := range analyses {
-		if err := analysis.execute(verbose); err != nil {
-			return err
+		if !analysis.enabled || analysis.runner == nil {
+			continue
+		}
+
+		sample := progress.StartSample()
+		progressEvents <- progress.AnalyzerProgress{Name: analysis.name, TotalFiles: totalFiles}
+
+		hit, err := analysis.execute(verbose, healthReport, analysisCache, fileHashes, Version, noCache)
+		if analysisCache != nil && !noCache {
+			if hit {
+				cacheHits++
+			} else {
+				cacheMisses++
+			}
+		}
+
+		cpuMillis, peakAllocBytes, elapsed := sample.Finish()
+		progressEvents <- progress.AnalyzerProgress{Name: analysis.name, FilesScanned: totalFiles, TotalFiles: totalFiles, Elapsed: elapsed}
+		healthReport.AnalyzerStats = append(healthReport.AnalyzerStats, report.AnalyzerStats{
+			Name:           analysis.name,
+			CPUMillis:      cpuMillis,
+			PeakAllocBytes: peakAllocBytes,
+			ElapsedMillis:  elapsed.Milliseconds(),
+		})
+
+		if err != nil {
+			errs = append(errs, err)
+			healthReport.Issues = append(healthReport.Issues, analyzerErrorIssue(analysis.name, err))
 		}
 	}
+	close(progressEvents)
+	<-rendered
 
-	return nil
+	if verbose && (cacheHits > 0 || cacheMisses > 0) {
+		logger.Info(fmt.Sprintf("  - analyzer cache: %d hit(s), %d miss(es)", cacheHits, cacheMisses), "phase", "analyze", "cache_hits", cacheHits, "cache_misses", cacheMisses)
+	}
+
+	return errors.Join(errs...)
 }
 
-func runSecurityAnalysis(repo *git.Repository, cfg *config.Config, healthReport *report.Report) error {
-	fileScanner, err := scanner.NewFileScanner(repo.GetPath())
+// analyzerErrorIssue records that an analyzer itself failed to run as a
+// low-severity, non-masking finding, so the failure is visible in the
+// report instead of only in the aggregated error `runCheck` sees.
+func analyzerErrorIssue(analyzerName string, err error) report.Issue {
+	return report.Issue{
+		ID:          fmt.Sprintf("analyzer-error-%s", analyzerName),
+		Title:       fmt.Sprintf("%s analyzer failed", analyzerName),
+		Description: err.Error(),
+		Category:    report.CategoryAnalyzerError,
+		Severity:    report.SeverityLow,
+		Rule:        "analyzer-error",
+		Fix:         fmt.Sprintf("Investigate why the %s analyzer failed and re-run `check`", analyzerName),
+		CreatedAt:   time.Now(),
+	}
+}
+
+// setupAnalysisCache opens the on-disk analyzer cache and fingerprints the
+// repository's current files, unless --no-cache was passed. Any failure to
+// do either (e.g. an unwritable cache directory) degrades to running
+// without a cache rather than failing the whole check. ctx lets the
+// repository-wide file scan this requires be interrupted (Ctrl-C or
+// --timeout) without waiting for a large tree to finish walking.
+func setupAnalysisCache(ctx stdctx.Context, repo *git.Repository, verbose bool) (*cache.Cache, map[string]string) {
+	if noCache {
+		return nil, nil
+	}
+
+	analysisCache, err := cache.New()
+	if err != nil {
+		if verbose {
+			logger.Warn(fmt.Sprintf("  - analyzer cache unavailable, running without it: %v", err), "phase", "analyze")
+		}
+		return nil, nil
+	}
+
+	fileHashes, err := computeFileHashes(ctx, repo)
+	if err != nil {
+		if verbose {
+			logger.Warn(fmt.Sprintf("  - failed to fingerprint repository files, running without cache: %v", err), "phase", "analyze")
+		}
+		return nil, nil
+	}
+
+	return analysisCache, fileHashes
+}
+
+// computeFileHashes returns a content hash (or, for files too large to keep
+// in memory, a size+mtime fingerprint) per scanned file, for
+// cache.Fingerprint. The scan's progress (files seen, bytes read) is
+// streamed to a ScanReporter on os.Stderr the same way runAnalyses streams
+// per-analyzer progress to a MultiRenderer, so a large repository's initial
+// fingerprinting pass doesn't look hung before the per-analyzer bars appear.
+func computeFileHashes(ctx stdctx.Context, repo *git.Repository) (map[string]string, error) {
+	fileScanner, err := newFileScanner(repo)
+	if err != nil {
+		return nil, fmt.Errorf(failedToCreateScannerError, err)
+	}
+
+	progressCh := make(chan scanner.ScanProgress)
+	watched := make(chan struct{})
+	go func() {
+		progress.NewScanReporter(resolveProgressMode(), os.Stderr).Watch(progressCh)
+		close(watched)
+	}()
+
+	files, err := fileScanner.ScanAllFilesCtx(ctx, progressCh)
+	close(progressCh)
+	<-watched
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan repository files: %w", err)
+	}
+
+	hashes := make(map[string]string, len(files))
+	for path, info := range files {
+		if info.Content != nil {
+			sum := sha256.Sum256(info.Content)
+			hashes[path] = hex.EncodeToString(sum[:])
+			continue
+		}
+		hashes[path] = fmt.Sprintf("%d-%d", info.Size, info.ModTime)
+	}
+
+	return hashes, nil
+}
+
+func runSecurityAnalysis(repo *git.Repository, cfg *config.Config, healthReport *report.Report, verbose bool) error {
+	if strictSuppressions {
+		cfg.Security.StrictSuppressions = true
+	}
+
+	if rulesFile != "" {
+		rules, err := config.LoadSecretRules(rulesFile)
+		if err != nil {
+			return fmt.Errorf("failed to load rules file: %w", err)
+		}
+		cfg.Security.Rules = rules
+	}
+
+	if _, err := verifier.ParseMode(verifyMode); err != nil {
+		return err
+	}
+	cfg.Security.VerifyMode = verifyMode
+
+	fileScanner, err := newFileScanner(repo)
 	if err != nil {
 		return fmt.Errorf(failedToCreateScannerError, err)
 	}
 
-	securityAnalyzer := analyzer.NewSecurityAnalyzer(&cfg.Security, fileScanner)
+	fileKinds, err := resolveFileKinds(cfg)
+	if err != nil {
+		return err
+	}
+
+	securityAnalyzer := analyzer.NewSecurityAnalyzer(&cfg.Security, fileScanner, fileKinds)
+	if verbose {
+		securityAnalyzer.SetReporter(progress.NewReporter(logFormat, os.Stderr))
+	}
 
 	issues, err := securityAnalyzer.Analyze()
 	if err != nil {
@@ -293,24 +974,96 @@ func runSecurityAnalysis(repo *git.Repository, cfg *config.Config, healthReport
 	return nil
 }
 
+// runHistorySecretAnalysis walks git history for secrets (see
+// analyzer.HistorySecretAnalyzer), then suppresses anything already accepted
+// into the --history-baseline-file baseline.
+func runHistorySecretAnalysis(ctx stdctx.Context, repo *git.Repository, cfg *config.Config, healthReport *report.Report) error {
+	historyAnalyzer := analyzer.NewHistorySecretAnalyzer(&cfg.Security, repo)
+
+	issues, err := historyAnalyzer.Analyze(ctx, historySince)
+	if err != nil {
+		return fmt.Errorf("history secret analysis failed: %w", err)
+	}
+
+	issues, err = applyHistoryBaseline(repo.GetPath(), issues)
+	if err != nil {
+		return err
+	}
+
+	healthReport.Issues = append(healthReport.Issues, issues...)
+	return nil
+}
+
+// applyHistoryBaseline loads the --history-baseline-file baseline and
+// either rewrites it with the current --scan-history findings
+// (--update-history-baseline) or filters issues down to the ones not
+// already accepted, mirroring applyBaseline's lockfile workflow for the
+// separate .healthcheck.lock baseline.
+func applyHistoryBaseline(repoPath string, issues []report.Issue) ([]report.Issue, error) {
+	lockPath := historyBaselineFile
+	if !filepath.IsAbs(lockPath) {
+		lockPath = filepath.Join(repoPath, lockPath)
+	}
+
+	lock, err := baseline.LoadHistory(lockPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if updateHistoryBaseline {
+		baseline.RebuildHistory(lock, issues)
+		if err := lock.Save(lockPath); err != nil {
+			return nil, err
+		}
+		logger.Info(fmt.Sprintf("📌 Accepted %d history finding(s) into baseline: %s", len(lock.Findings), lockPath),
+			"phase", "history-baseline", "action", "update", "findings", len(lock.Findings), "path", lockPath)
+		return issues, nil
+	}
+
+	fresh := baseline.FilterNewHistory(issues, lock)
+	logger.Info(fmt.Sprintf("📌 History baseline suppressed %d known finding(s)", len(issues)-len(fresh)),
+		"phase", "history-baseline", "action", "filter", "suppressed", len(issues)-len(fresh), "path", lockPath)
+
+	return fresh, nil
+}
+
 func runPerformanceAnalysis(repo *git.Repository, cfg *config.Config, healthReport *report.Report) error {
+	lfsPatterns, err := repo.ParseGitAttributesLFS()
+	if err != nil {
+		return err
+	}
+	lfsGlobs := compileLFSPatterns(lfsPatterns)
+
 	largeFiles, err := repo.GetLargeFiles(int64(cfg.Performance.LargeFileSizeMB * 1024 * 1024))
 	if err != nil {
 		return err
 	}
 
 	for _, file := range largeFiles {
-		if isBinaryFile(file, cfg.Performance.BinaryExtensions) {
+		// A real blob backing an LFS-tracked pattern means LFS wasn't used
+		// when the file was added; a pointer file is never "large" in the
+		// sense this check cares about, so both are handled before the
+		// generic large-source-file rule gets a chance to fire.
+		if file.IsLFSPointer {
+			continue
+		}
+
+		if matchesAnyGlob(file.Path, lfsGlobs) {
+			healthReport.Issues = append(healthReport.Issues, lfsMissingPointerIssue(file.Path, cfg.Performance.LargeFileSizeMB))
+			continue
+		}
+
+		if isBinaryFile(file.Path, cfg.Performance.BinaryExtensions) {
 			continue
 		}
 
 		issue := report.Issue{
-			ID:          fmt.Sprintf("large-file-%s", file),
+			ID:          fmt.Sprintf("large-file-%s", file.Path),
 			Title:       "Large source file detected",
-			Description: fmt.Sprintf("Source file %s is larger than %dMB threshold", file, cfg.Performance.LargeFileSizeMB),
+			Description: fmt.Sprintf("Source file %s is larger than %dMB threshold", file.Path, cfg.Performance.LargeFileSizeMB),
 			Category:    report.CategoryPerformance,
 			Severity:    report.SeverityMedium,
-			File:        file,
+			File:        file.Path,
 			Rule:        "large-file-check",
 			Fix:         "Consider refactoring large source files or splitting into smaller modules",
 			CreatedAt:   time.Now(),
@@ -318,16 +1071,108 @@ func runPerformanceAnalysis(repo *git.Repository, cfg *config.Config, healthRepo
 		healthReport.Issues = append(healthReport.Issues, issue)
 	}
 
+	untrackedLargeBinaries, err := repo.GetLargeFiles(int64(cfg.Performance.LFSRequiredSizeMB * 1024 * 1024))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range untrackedLargeBinaries {
+		if file.IsLFSPointer || matchesAnyGlob(file.Path, lfsGlobs) {
+			continue
+		}
+		if !hasAnyExtension(file.Path, cfg.Performance.LFSRequiredExtensions) {
+			continue
+		}
+
+		healthReport.Issues = append(healthReport.Issues, report.Issue{
+			ID:          fmt.Sprintf("lfs-untracked-%s", file.Path),
+			Title:       "Large binary not tracked by Git LFS",
+			Description: fmt.Sprintf("File %s is larger than %dMB and should be tracked with Git LFS", file.Path, cfg.Performance.LFSRequiredSizeMB),
+			Category:    report.CategoryPerformance,
+			Severity:    report.SeverityMedium,
+			File:        file.Path,
+			Rule:        "lfs-untracked-large-binary",
+			Fix:         fmt.Sprintf("Run 'git lfs track \"%s\"' (or a matching pattern in .gitattributes) and re-commit the file", file.Path),
+			CreatedAt:   time.Now(),
+		})
+	}
+
 	return nil
 }
 
+// lfsMissingPointerIssue reports a file whose path matches an LFS-tracked
+// .gitattributes pattern but whose HEAD blob is the raw content instead of
+// an LFS pointer - meaning the file was added without LFS actually filtering
+// it, so the repository carries the full binary anyway.
+func lfsMissingPointerIssue(filePath string, largeFileSizeMB int) report.Issue {
+	return report.Issue{
+		ID:          fmt.Sprintf("lfs-missing-pointer-%s", filePath),
+		Title:       "LFS-tracked file stored as a raw blob",
+		Description: fmt.Sprintf("File %s matches an LFS pattern in .gitattributes but is larger than %dMB and committed as a raw blob, not an LFS pointer", filePath, largeFileSizeMB),
+		Category:    report.CategoryPerformance,
+		Severity:    report.SeverityMedium,
+		File:        filePath,
+		Rule:        "lfs-missing-pointer",
+		Fix:         "Re-add the file after running 'git lfs track' so it's committed as an LFS pointer instead of raw content",
+		CreatedAt:   time.Now(),
+	}
+}
+
+// compileLFSPatterns compiles each .gitattributes pattern marked
+// filter=lfs with gobwas/glob, the same "**"-aware matcher the security
+// analyzer's suspicious-file rules use, so a pattern like "*.psd" or
+// "assets/**/*.mp4" matches the way Git itself applies it. A pattern that
+// fails to compile is skipped rather than failing the whole scan.
+func compileLFSPatterns(patterns map[string]bool) []glob.Glob {
+	compiled := make([]glob.Glob, 0, len(patterns))
+	for pattern := range patterns {
+		g, err := glob.Compile(pattern, '/')
+		if err != nil {
+			continue
+		}
+		compiled = append(compiled, g)
+	}
+	return compiled
+}
+
+// matchesAnyGlob reports whether filePath, or just its base name, matches
+// any of globs.
+func matchesAnyGlob(filePath string, globs []glob.Glob) bool {
+	base := filepath.Base(filePath)
+	for _, g := range globs {
+		if g.Match(filePath) || g.Match(base) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAnyExtension reports whether filePath's extension matches one of
+// extensions, case-insensitively - the same comparison isBinaryFile uses for
+// its extension check, without isBinaryFile's additional common-binary-name
+// heuristics.
+func hasAnyExtension(filePath string, extensions []string) bool {
+	ext := strings.ToLower(filepath.Ext(filePath))
+	for _, extension := range extensions {
+		if ext == strings.ToLower(extension) {
+			return true
+		}
+	}
+	return false
+}
+
 func runQualityAnalysis(repo *git.Repository, cfg *config.Config, healthReport *report.Report) error {
-	fileScanner, err := scanner.NewFileScanner(repo.GetPath())
+	fileScanner, err := newFileScanner(repo)
 	if err != nil {
 		return fmt.Errorf(failedToCreateScannerError, err)
 	}
 
-	qualityAnalyzer := analyzer.NewQualityAnalyzer(&cfg.Quality, fileScanner)
+	fileKinds, err := resolveFileKinds(cfg)
+	if err != nil {
+		return err
+	}
+
+	qualityAnalyzer := analyzer.NewQualityAnalyzer(&cfg.Quality, fileScanner, fileKinds)
 
 	issues, err := qualityAnalyzer.Analyze()
 	if err != nil {
@@ -338,10 +1183,10 @@ func runQualityAnalysis(repo *git.Repository, cfg *config.Config, healthReport *
 	return nil
 }
 
-func runWorkflowAnalysis(repo *git.Repository, cfg *config.Config, healthReport *report.Report) error {
-	workflowAnalyzer := analyzer.NewWorkflowAnalyzer(&cfg.Workflow, repo)
+func runWorkflowAnalysis(ctx stdctx.Context, repo *git.Repository, cfg *config.Config, healthReport *report.Report) error {
+	workflowAnalyzer := analyzer.NewWorkflowAnalyzer(&cfg.Workflow, &cfg.Maintenance, repo)
 
-	issues, err := workflowAnalyzer.Analyze()
+	issues, err := workflowAnalyzer.AnalyzeCtx(ctx)
 	if err != nil {
 		return fmt.Errorf("workflow analysis failed: %w", err)
 	}
@@ -351,6 +1196,22 @@ func runWorkflowAnalysis(repo *git.Repository, cfg *config.Config, healthReport
 }
 
 func runDependencyAnalysis(repo *git.Repository, cfg *config.Config, healthReport *report.Report) error {
+	if osvDBPath != "" {
+		cfg.Dependencies.OSVDatabasePath = osvDBPath
+	}
+	if osvSymbols {
+		cfg.Dependencies.OSVSymbolFiltering = true
+	}
+	if vulnerabilitySource != "" {
+		cfg.Dependencies.VulnerabilitySource = vulnerabilitySource
+	}
+	if vulnerabilityCacheTTL > 0 {
+		cfg.Dependencies.VulnerabilityCacheTTL = vulnerabilityCacheTTL
+	}
+	if len(buildTags) > 0 {
+		cfg.Dependencies.BuildTags = buildTags
+	}
+
 	dependencyAnalyzer := analyzer.NewDependencyAnalyzer(&cfg.Dependencies, repo.GetPath())
 
 	issues, err := dependencyAnalyzer.Analyze()
@@ -362,24 +1223,36 @@ func runDependencyAnalysis(repo *git.Repository, cfg *config.Config, healthRepor
 	return nil
 }
 
-func runGoWarningsAnalysis(repo *git.Repository, cfg *config.Config, healthReport *report.Report) error {
-	if !cfg.GoWarnings.Enabled {
+// runLintersAnalysis runs LintersAnalyzer against the languages
+// runCodeStatsAnalysis already found present in the repository, so a linter
+// configured for a language the repo doesn't contain is never invoked.
+func runLintersAnalysis(repo *git.Repository, cfg *config.Config, healthReport *report.Report) error {
+	if !cfg.Linters.Enabled {
 		return nil
 	}
 
-	goWarningsAnalyzer := analyzer.NewGoWarningsAnalyzer(repo.GetPath())
-	issues, err := goWarningsAnalyzer.Analyze()
+	languages := make([]string, 0, len(healthReport.CodeStats.LanguageBreakdown))
+	for language := range healthReport.CodeStats.LanguageBreakdown {
+		languages = append(languages, language)
+	}
+
+	lintersAnalyzer := analyzer.NewLintersAnalyzer(&cfg.Linters, repo.GetPath(), languages)
+	issues, err := lintersAnalyzer.Analyze()
 	if err != nil {
-		return fmt.Errorf("go warnings analysis failed: %w", err)
+		return fmt.Errorf("linters analysis failed: %w", err)
 	}
 
-	// Filter issues based on ignore patterns
-	filteredIssues := filterGoWarningsByPatterns(issues, cfg.GoWarnings.IgnorePatterns)
-	healthReport.Issues = append(healthReport.Issues, filteredIssues...)
+	issues = filterLinterIssuesByPatterns(issues, cfg.Linters.IgnorePatterns)
+	issues, err = filterIssuesByScope(issues)
+	if err != nil {
+		return err
+	}
+
+	healthReport.Issues = append(healthReport.Issues, issues...)
 	return nil
 }
 
-func filterGoWarningsByPatterns(issues []report.Issue, ignorePatterns []string) []report.Issue {
+func filterLinterIssuesByPatterns(issues []report.Issue, ignorePatterns []string) []report.Issue {
 	if len(ignorePatterns) == 0 {
 		return issues
 	}
@@ -400,19 +1273,22 @@ func filterGoWarningsByPatterns(issues []report.Issue, ignorePatterns []string)
 	return filtered
 }
 
-func runCodeStatsAnalysis(repo *git.Repository, healthReport *report.Report, verbose bool) error {
+func runCodeStatsAnalysis(ctx stdctx.Context, repo *git.Repository, healthReport *report.Report, verbose bool) error {
 	if verbose {
-		fmt.Println("  - Analyzing code statistics...")
+		logger.Info("  - Analyzing code statistics...", "phase", "analyze", "analyzer", "code_stats")
 	}
 
-	fileScanner, err := scanner.NewFileScanner(repo.GetPath())
+	fileScanner, err := newFileScanner(repo)
 	if err != nil {
 		return fmt.Errorf(failedToCreateScannerError, err)
 	}
 
 	codeStatsAnalyzer := analyzer.NewCodeStatsAnalyzer(fileScanner)
+	if verbose {
+		codeStatsAnalyzer.SetReporter(progress.NewReporter(logFormat, os.Stderr))
+	}
 
-	stats, err := codeStatsAnalyzer.Analyze()
+	stats, err := codeStatsAnalyzer.AnalyzeCtx(ctx)
 	if err != nil {
 		return fmt.Errorf("code stats analysis failed: %w", err)
 	}
@@ -422,41 +1298,76 @@ func runCodeStatsAnalysis(repo *git.Repository, healthReport *report.Report, ver
 }
 
 func runMaintenanceAnalysis(repo *git.Repository, cfg *config.Config, healthReport *report.Report) error {
-	repoPath := repo.GetPath()
+	healthReport.Issues = append(healthReport.Issues, missingRequiredFileIssues(cfg, repo.GetPath())...)
+	return nil
+}
+
+// defaultGitignoreTemplate is the starting content suggested.Edits offers
+// for a missing .gitignore; it covers the build artifacts this project
+// itself already excludes.
+const defaultGitignoreTemplate = `*.exe
+*.dll
+*.so
+*.dylib
+*.test
+*.out
+dist/
+build/
+.env
+`
+
+// missingRequiredFileIssues reports one issue per file in
+// cfg.Maintenance.RequiredFiles that doesn't exist under repoPath. A
+// missing .gitignore also gets a SuggestedFix that creates the file with
+// defaultGitignoreTemplate, since that's a safe, structured edit; go.mod
+// needs a module name only the user can supply, so it keeps the free-text
+// Fix only.
+func missingRequiredFileIssues(cfg *config.Config, repoPath string) []report.Issue {
+	var issues []report.Issue
 
 	for _, requiredFile := range cfg.Maintenance.RequiredFiles {
 		filePath := filepath.Join(repoPath, requiredFile)
-		if _, err := os.Stat(filePath); os.IsNotExist(err) {
-			severity := report.SeverityLow
-			description := fmt.Sprintf("Required file %s is missing from repository", requiredFile)
-			fix := fmt.Sprintf("Add %s file to repository root", requiredFile)
-
-			if requiredFile == "go.mod" {
-				severity = report.SeverityHigh
-				description = "Go module file (go.mod) is missing - this is required for Go projects"
-				fix = "Run 'go mod init <module-name>' to initialize Go module"
-			} else if requiredFile == ".gitignore" {
-				severity = report.SeverityMedium
-				description = ".gitignore file is missing - important for excluding build artifacts and sensitive files"
-				fix = "Create .gitignore file with appropriate patterns for your programming language"
-			}
+		if _, err := os.Stat(filePath); !os.IsNotExist(err) {
+			continue
+		}
 
-			issue := report.Issue{
-				ID:          fmt.Sprintf("missing-file-%s", strings.ReplaceAll(requiredFile, "/", "-")),
-				Title:       "Missing required file",
-				Description: description,
-				Category:    report.CategoryMaintenance,
-				Severity:    severity,
-				File:        requiredFile,
-				Rule:        "required-files-check",
-				Fix:         fix,
-				CreatedAt:   time.Now(),
-			}
-			healthReport.Issues = append(healthReport.Issues, issue)
+		severity := report.SeverityLow
+		description := fmt.Sprintf("Required file %s is missing from repository", requiredFile)
+		fix := fmt.Sprintf("Add %s file to repository root", requiredFile)
+		var suggestedFixes []report.SuggestedFix
+
+		switch requiredFile {
+		case "go.mod":
+			severity = report.SeverityHigh
+			description = "Go module file (go.mod) is missing - this is required for Go projects"
+			fix = "Run 'go mod init <module-name>' to initialize Go module"
+		case ".gitignore":
+			severity = report.SeverityMedium
+			description = ".gitignore file is missing - important for excluding build artifacts and sensitive files"
+			fix = "Create .gitignore file with appropriate patterns for your programming language"
+			suggestedFixes = []report.SuggestedFix{{
+				Title: "Create .gitignore with common Go ignore patterns",
+				Edits: map[string][]report.TextEdit{
+					requiredFile: {{Range: report.Range{StartLine: 1, EndLine: 1}, NewText: defaultGitignoreTemplate}},
+				},
+			}}
 		}
+
+		issues = append(issues, report.Issue{
+			ID:             fmt.Sprintf("missing-file-%s", strings.ReplaceAll(requiredFile, "/", "-")),
+			Title:          "Missing required file",
+			Description:    description,
+			Category:       report.CategoryMaintenance,
+			Severity:       severity,
+			File:           requiredFile,
+			Rule:           "required-files-check",
+			Fix:            fix,
+			SuggestedFixes: suggestedFixes,
+			CreatedAt:      time.Now(),
+		})
 	}
 
-	return nil
+	return issues
 }
 
 func calculateSummary(issues []report.Issue) report.Summary {
@@ -573,6 +1484,6 @@ func suggestFixCommand(healthReport *report.Report) {
 	}
 
 	if hasDependencyIssues {
-		fmt.Println("\nðŸ’¡ Found dependency issues? Run 'githealthchecker fix .' to automatically resolve them.")
+		logger.Info("\nðŸ’¡ Found dependency issues? Run 'githealthchecker fix .' to automatically resolve them.", "phase", "summary")
 	}
 }
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/githealthchecker/git-health-checker/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect the resolved configuration",
+}
+
+var configExplainCmd = &cobra.Command{
+	Use:   "explain",
+	Short: "Print every resolved config value and which file or profile set it",
+	Long: `Resolve the active config's "extends" chain exactly like 'check' does,
+then print each final value alongside the config file or built-in profile
+that contributed it, so an "extends: [strict]" chain isn't a black box.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		configPath, _ := cmd.Flags().GetString("config")
+
+		cfg, prov, err := config.ExplainCtx(cmd.Context(), configPath)
+		if err != nil {
+			return fmt.Errorf("failed to load configuration: %w", err)
+		}
+
+		if err := cfg.Validate(); err != nil {
+			return fmt.Errorf("invalid configuration: %w", err)
+		}
+
+		if len(prov) == 0 {
+			fmt.Println("No config file found; every value is a built-in default.")
+			return nil
+		}
+
+		keys := make([]string, 0, len(prov))
+		for k := range prov {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			fmt.Printf("%s <- %s\n", k, prov[k])
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configExplainCmd)
+}
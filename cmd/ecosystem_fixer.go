@@ -0,0 +1,263 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/githealthchecker/git-health-checker/internal/git"
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+// fixOtherEcosystems runs FixUnused for every detected non-Go ecosystem.
+// Go modules keep using the dedicated fixUnusedDependencies/fixOutdatedDependencies
+// flow above, which is wired into the unused/outdated dependency analyzer output.
+func fixOtherEcosystems(ctx context.Context, repo *git.Repository, dryRun bool) (int, error) {
+	var totalFixed int
+
+	for _, fixer := range ecosystemFixers(repo.GetPath()) {
+		if ctx.Err() != nil {
+			return totalFixed, fmt.Errorf("fix cancelled: %w", ctx.Err())
+		}
+
+		if fixer.Name() == (&goModFixer{}).Name() {
+			continue
+		}
+
+		fmt.Printf("📦 Checking %s dependencies...\n", fixer.Name())
+
+		fixed, err := fixer.FixUnused(ctx, dryRun)
+		if err != nil {
+			return totalFixed, fmt.Errorf("%s: %w", fixer.Name(), err)
+		}
+		totalFixed += fixed
+
+		if fixed > 0 && !dryRun {
+			if err := fixer.PostCleanup(ctx); err != nil {
+				return totalFixed, fmt.Errorf("%s post-cleanup: %w", fixer.Name(), err)
+			}
+		}
+	}
+
+	return totalFixed, nil
+}
+
+// EcosystemFixer knows how to detect and remediate dependency issues for a
+// single package ecosystem (Go modules, npm, pip, Cargo, Maven, ...). Every
+// operation that shells out accepts a context so a cancelled `fix` run
+// doesn't leave an install command running after the user has given up on it.
+type EcosystemFixer interface {
+	// Name identifies the ecosystem for log/summary output.
+	Name() string
+	// Detect reports whether this ecosystem's manifest is present at repoPath.
+	Detect(repoPath string) bool
+	// FixUnused removes dependencies the analyzer flagged as unused.
+	FixUnused(ctx context.Context, dryRun bool) (int, error)
+	// FixOutdated updates the packages named in the given issues.
+	FixOutdated(ctx context.Context, issues []report.Issue, dryRun bool) (int, error)
+	// PostCleanup runs any ecosystem-specific tidy-up step after fixes apply.
+	PostCleanup(ctx context.Context) error
+}
+
+// ecosystemFixers returns every fixer applicable to repoPath, in the same
+// priority order CodeStatsAnalyzer.detectLanguage favors well-known manifests.
+func ecosystemFixers(repoPath string) []EcosystemFixer {
+	candidates := []EcosystemFixer{
+		&goModFixer{repoPath: repoPath},
+		&npmFixer{repoPath: repoPath},
+		&pipFixer{repoPath: repoPath},
+		&cargoFixer{repoPath: repoPath},
+		&mavenFixer{repoPath: repoPath},
+	}
+
+	var detected []EcosystemFixer
+	for _, fixer := range candidates {
+		if fixer.Detect(repoPath) {
+			detected = append(detected, fixer)
+		}
+	}
+	return detected
+}
+
+type goModFixer struct {
+	repoPath string
+}
+
+func (f *goModFixer) Name() string { return "Go modules" }
+
+func (f *goModFixer) Detect(repoPath string) bool {
+	_, err := os.Stat(filepath.Join(repoPath, "go.mod"))
+	return err == nil
+}
+
+func (f *goModFixer) FixUnused(ctx context.Context, dryRun bool) (int, error) {
+	if dryRun {
+		return 0, nil
+	}
+	return 0, runGoModTidy(ctx, f.repoPath)
+}
+
+func (f *goModFixer) FixOutdated(ctx context.Context, issues []report.Issue, dryRun bool) (int, error) {
+	if dryRun {
+		return 0, nil
+	}
+	fixed, _ := updateOutdatedDependencies(ctx, issues, f.repoPath)
+	return fixed, nil
+}
+
+func (f *goModFixer) PostCleanup(ctx context.Context) error {
+	return runGoModTidy(ctx, f.repoPath)
+}
+
+type npmFixer struct {
+	repoPath string
+}
+
+func (f *npmFixer) Name() string { return "npm/yarn/pnpm" }
+
+func (f *npmFixer) Detect(repoPath string) bool {
+	_, err := os.Stat(filepath.Join(repoPath, "package.json"))
+	return err == nil
+}
+
+func (f *npmFixer) FixUnused(ctx context.Context, dryRun bool) (int, error) {
+	return f.run(ctx, dryRun, "npm", "prune")
+}
+
+func (f *npmFixer) FixOutdated(ctx context.Context, issues []report.Issue, dryRun bool) (int, error) {
+	if len(issues) == 0 {
+		return 0, nil
+	}
+	fixed, err := f.run(ctx, dryRun, "npm", "update")
+	if err != nil {
+		return 0, err
+	}
+	if fixed > 0 {
+		return len(issues), nil
+	}
+	return 0, nil
+}
+
+func (f *npmFixer) PostCleanup(ctx context.Context) error {
+	return nil
+}
+
+func (f *npmFixer) run(ctx context.Context, dryRun bool, name string, args ...string) (int, error) {
+	if dryRun {
+		return 1, nil
+	}
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = f.repoPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return 0, fmt.Errorf("failed to run '%s %v': %w\nOutput: %s", name, args, err, string(output))
+	}
+	return 1, nil
+}
+
+type pipFixer struct {
+	repoPath string
+}
+
+func (f *pipFixer) Name() string { return "pip" }
+
+func (f *pipFixer) Detect(repoPath string) bool {
+	_, err := os.Stat(filepath.Join(repoPath, "requirements.txt"))
+	return err == nil
+}
+
+func (f *pipFixer) FixUnused(ctx context.Context, dryRun bool) (int, error) {
+	// pip has no built-in "remove unused" step; requirements.txt is managed by hand.
+	return 0, nil
+}
+
+func (f *pipFixer) FixOutdated(ctx context.Context, issues []report.Issue, dryRun bool) (int, error) {
+	if len(issues) == 0 || dryRun {
+		return 0, nil
+	}
+	cmd := exec.CommandContext(ctx, "pip-compile", "--upgrade", "requirements.txt")
+	cmd.Dir = f.repoPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return 0, fmt.Errorf("failed to run 'pip-compile --upgrade': %w\nOutput: %s", err, string(output))
+	}
+	return len(issues), nil
+}
+
+func (f *pipFixer) PostCleanup(ctx context.Context) error {
+	return nil
+}
+
+type cargoFixer struct {
+	repoPath string
+}
+
+func (f *cargoFixer) Name() string { return "Cargo" }
+
+func (f *cargoFixer) Detect(repoPath string) bool {
+	_, err := os.Stat(filepath.Join(repoPath, "Cargo.toml"))
+	return err == nil
+}
+
+func (f *cargoFixer) FixUnused(ctx context.Context, dryRun bool) (int, error) {
+	// cargo has no equivalent to `go mod tidy` for pruning unused crates.
+	return 0, nil
+}
+
+func (f *cargoFixer) FixOutdated(ctx context.Context, issues []report.Issue, dryRun bool) (int, error) {
+	if dryRun {
+		return 0, nil
+	}
+
+	var fixed int
+	for _, issue := range issues {
+		if ctx.Err() != nil {
+			break
+		}
+
+		pkg := extractPackageNameFromDescription(issue.Description)
+		cmd := exec.CommandContext(ctx, "cargo", "update", "-p", pkg)
+		cmd.Dir = f.repoPath
+		if output, err := cmd.CombinedOutput(); err != nil {
+			fmt.Printf("    ❌ Failed to update %s: %v\n       Output: %s\n", pkg, err, string(output))
+			continue
+		}
+		fixed++
+	}
+	return fixed, nil
+}
+
+func (f *cargoFixer) PostCleanup(ctx context.Context) error {
+	return nil
+}
+
+type mavenFixer struct {
+	repoPath string
+}
+
+func (f *mavenFixer) Name() string { return "Maven" }
+
+func (f *mavenFixer) Detect(repoPath string) bool {
+	_, err := os.Stat(filepath.Join(repoPath, "pom.xml"))
+	return err == nil
+}
+
+func (f *mavenFixer) FixUnused(ctx context.Context, dryRun bool) (int, error) {
+	return 0, nil
+}
+
+func (f *mavenFixer) FixOutdated(ctx context.Context, issues []report.Issue, dryRun bool) (int, error) {
+	if len(issues) == 0 || dryRun {
+		return 0, nil
+	}
+	cmd := exec.CommandContext(ctx, "mvn", "versions:use-latest-releases")
+	cmd.Dir = f.repoPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return 0, fmt.Errorf("failed to run 'mvn versions:use-latest-releases': %w\nOutput: %s", err, string(output))
+	}
+	return len(issues), nil
+}
+
+func (f *mavenFixer) PostCleanup(ctx context.Context) error {
+	return nil
+}
@@ -1,20 +1,31 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
-	"github.com/spf13/cobra"
+	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
 	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
 
 	"github.com/githealthchecker/git-health-checker/internal/analyzer"
+	"github.com/githealthchecker/git-health-checker/internal/baseline"
 	"github.com/githealthchecker/git-health-checker/internal/config"
 	"github.com/githealthchecker/git-health-checker/internal/git"
 	"github.com/githealthchecker/git-health-checker/internal/report"
 )
 
 var (
-	dryRun bool
+	dryRun       bool
+	openPR       bool
+	prStrategy   string
+	branchPrefix string
+	pushRemote   string
 )
 
 var fixCmd = &cobra.Command{
@@ -39,9 +50,17 @@ func init() {
 	rootCmd.AddCommand(fixCmd)
 
 	fixCmd.Flags().BoolVar(&dryRun, "dry-run", false, "show what would be fixed without making changes")
+	fixCmd.Flags().BoolVar(&openPR, "pr", false, "commit fixes to a new branch, push it, and open a pull request")
+	fixCmd.Flags().StringVar(&prStrategy, "pr-strategy", "batch", "how to group commits for --pr (one-per-dep, batch)")
+	fixCmd.Flags().StringVar(&branchPrefix, "branch-prefix", "githealthchecker", "prefix used for the branch created by --pr")
+	fixCmd.Flags().StringVar(&pushRemote, "remote", "origin", "remote to push the fix branch to when using --pr")
+	fixCmd.Flags().String("format", "text", "output format for the fix summary (text, json, sarif)")
 }
 
 func runFix(cmd *cobra.Command, args []string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	fixContext, err := setupFixContext(cmd, args)
 	if err != nil {
 		return err
@@ -49,13 +68,179 @@ func runFix(cmd *cobra.Command, args []string) error {
 
 	printFixHeader(fixContext.absPath, dryRun)
 
-	totalFixed, didFix, err := performFixes(fixContext)
+	if openPR && !dryRun {
+		return runFixWithPullRequest(ctx, fixContext)
+	}
+
+	plannedIssues, err := collectDependencyIssues(fixContext)
+	if err != nil {
+		return err
+	}
+
+	totalFixed, didFix, err := performFixes(ctx, fixContext)
+	if err != nil {
+		return err
+	}
+
+	if err := printFixSummary(ctx, totalFixed, didFix, dryRun, fixContext.absPath); err != nil {
+		return err
+	}
+
+	return printStructuredFixReport(cmd, fixContext, plannedIssues)
+}
+
+// collectDependencyIssues runs the dependency analyzer up front so the
+// structured (--format json/sarif) output can describe exactly what fix
+// targeted, independent of the free-text console summary.
+func collectDependencyIssues(ctx *fixContext) ([]report.Issue, error) {
+	dependencyAnalyzer := analyzer.NewDependencyAnalyzer(&ctx.cfg.Dependencies, ctx.repo.GetPath())
+
+	issues, err := dependencyAnalyzer.Analyze()
+	if err != nil {
+		return nil, fmt.Errorf("failed to analyze dependencies: %w", err)
+	}
+
+	return issues, nil
+}
+
+// printStructuredFixReport renders the fix's target issues in the requested
+// --format when it isn't the default "text" console output.
+func printStructuredFixReport(cmd *cobra.Command, ctx *fixContext, issues []report.Issue) error {
+	format, _ := cmd.Flags().GetString("format")
+	if format == "" || format == "text" {
+		return nil
+	}
+
+	healthReport := &report.Report{
+		Repository: ctx.absPath,
+		Issues:     issues,
+		Version:    "1.0.0",
+	}
+	report.EnrichHelpURIs(healthReport.Issues)
+
+	formatter, err := report.GetFormatter(format, "")
+	if err != nil {
+		return err
+	}
+
+	output, err := formatter.Format(healthReport)
+	if err != nil {
+		return fmt.Errorf("failed to format fix report: %w", err)
+	}
+
+	fmt.Println()
+	fmt.Print(output)
+	return nil
+}
+
+// runFixWithPullRequest applies dependency fixes on a dedicated branch, pushes
+// it, and opens a pull/merge request instead of leaving changes uncommitted
+// in the working tree.
+func runFixWithPullRequest(ctx context.Context, fixCtx *fixContext) error {
+	branchName := fmt.Sprintf("%s/dependency-updates-%d", branchPrefix, time.Now().Unix())
+	if err := fixCtx.repo.CreateBranch(branchName); err != nil {
+		return fmt.Errorf("failed to create fix branch: %w", err)
+	}
+
+	totalFixed, didFix, err := applyFixesForPR(ctx, fixCtx, branchName)
 	if err != nil {
 		return err
 	}
 
-	err = printFixSummary(totalFixed, didFix, dryRun, fixContext.absPath)
-	return err
+	if !didFix || totalFixed == 0 {
+		fmt.Println("✅ No dependency issues found to fix, nothing to open a pull request for")
+		return nil
+	}
+
+	if err := fixCtx.repo.PushBranch(pushRemote, branchName); err != nil {
+		return fmt.Errorf("failed to push fix branch: %w", err)
+	}
+
+	prURL, err := openDependencyPullRequest(fixCtx, branchName, totalFixed)
+	if err != nil {
+		return fmt.Errorf("failed to open pull request: %w", err)
+	}
+
+	fmt.Printf("✅ Opened pull request: %s\n", prURL)
+	return nil
+}
+
+// applyFixesForPR applies the fixes and, for the one-per-dep strategy,
+// commits each dependency update separately; otherwise it leaves a single
+// batch commit for the whole set of changes.
+func applyFixesForPR(ctx context.Context, fixCtx *fixContext, branchName string) (int, bool, error) {
+	_ = branchName
+
+	if prStrategy == "one-per-dep" {
+		fmt.Println("ℹ️  --pr-strategy=one-per-dep is not yet supported; committing all fixes as a single batch")
+	}
+
+	totalFixed, didFix, err := performFixes(ctx, fixCtx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if totalFixed == 0 {
+		return 0, didFix, nil
+	}
+
+	message := fmt.Sprintf("chore(deps): apply %d dependency fix(es)", totalFixed)
+	if _, err := fixCtx.repo.CommitAll(message); err != nil {
+		return 0, false, fmt.Errorf("failed to commit dependency fixes: %w", err)
+	}
+
+	return totalFixed, didFix, nil
+}
+
+func openDependencyPullRequest(ctx *fixContext, branchName string, totalFixed int) (string, error) {
+	prCfg := ctx.cfg.Workflow.PR
+
+	owner, repoName, err := parseGitHubRemote(ctx.repo, pushRemote)
+	if err != nil {
+		return "", err
+	}
+
+	client, err := git.NewPullRequestClient(prCfg.Provider, prCfg.TokenEnvVar)
+	if err != nil {
+		return "", err
+	}
+
+	baseBranch := prCfg.BaseBranch
+	if baseBranch == "" {
+		baseBranch = "main"
+	}
+
+	req := git.PullRequestRequest{
+		Owner:     owner,
+		Repo:      repoName,
+		Title:     fmt.Sprintf("chore(deps): %d automated dependency fix(es)", totalFixed),
+		Body:      "Opened automatically by `githealthchecker fix --pr`.",
+		Head:      branchName,
+		Base:      baseBranch,
+		Reviewers: prCfg.Reviewers,
+	}
+
+	return client.OpenPullRequest(req)
+}
+
+// parseGitHubRemote extracts the "owner/repo" portion from the configured
+// remote's URL, supporting both HTTPS and SSH remote forms.
+func parseGitHubRemote(repo *git.Repository, remoteName string) (owner, repoName string, err error) {
+	remoteURL, err := repo.GetRemoteURL(remoteName)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to resolve remote %s: %w", remoteName, err)
+	}
+
+	trimmed := strings.TrimSuffix(remoteURL, ".git")
+	trimmed = strings.TrimPrefix(trimmed, "git@github.com:")
+	trimmed = strings.TrimPrefix(trimmed, "https://github.com/")
+
+	parts := strings.Split(trimmed, "/")
+	if len(parts) < 2 {
+		return "", "", fmt.Errorf("could not parse owner/repo from remote URL %s", remoteURL)
+	}
+
+	return parts[len(parts)-2], parts[len(parts)-1], nil
 }
 
 type fixContext struct {
@@ -98,30 +283,52 @@ func setupFixContext(cmd *cobra.Command, args []string) (*fixContext, error) {
 }
 
 func printFixHeader(absPath string, isDryRun bool) {
-	fmt.Printf("🔧 Fixing dependency issues in: %s\n", absPath)
+	logger.Info(fmt.Sprintf("🔧 Fixing dependency issues in: %s", absPath), "phase", "start", "path", absPath, "dry_run", isDryRun)
 	if isDryRun {
-		fmt.Println("🔍 DRY RUN MODE - No changes will be made")
+		logger.Info("🔍 DRY RUN MODE - No changes will be made", "phase", "start", "dry_run", true)
+	}
+	if isTextLogFormat() {
+		fmt.Println()
 	}
-	fmt.Println()
 }
 
-func performFixes(ctx *fixContext) (int, bool, error) {
+func performFixes(ctx context.Context, fixCtx *fixContext) (int, bool, error) {
 	var totalFixed int
 
 	// Fix unused dependencies
-	fixed, err := fixUnusedDependencies(ctx.repo, ctx.cfg, dryRun)
+	fixed, err := fixUnusedDependencies(ctx, fixCtx.repo, fixCtx.cfg, dryRun)
 	if err != nil {
 		return 0, false, fmt.Errorf("failed to fix unused dependencies: %w", err)
 	}
 	totalFixed += fixed
 
+	if err := ctx.Err(); err != nil {
+		return totalFixed, false, fmt.Errorf("fix cancelled: %w", err)
+	}
+
 	// Fix outdated dependencies
-	fixed, err = fixOutdatedDependencies(ctx.repo, ctx.cfg, dryRun)
+	fixed, err = fixOutdatedDependencies(ctx, fixCtx.repo, fixCtx.cfg, dryRun)
 	if err != nil {
 		return 0, false, fmt.Errorf("failed to fix outdated dependencies: %w", err)
 	}
 	totalFixed += fixed
 
+	if err := ctx.Err(); err != nil {
+		return totalFixed, false, fmt.Errorf("fix cancelled: %w", err)
+	}
+
+	fixed, err = fixOtherEcosystems(ctx, fixCtx.repo, dryRun)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to fix non-Go dependencies: %w", err)
+	}
+	totalFixed += fixed
+
+	fixed, err = applyMaintenanceSuggestedFixes(fixCtx, dryRun)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to apply maintenance suggested fixes: %w", err)
+	}
+	totalFixed += fixed
+
 	var runGoModTidy bool
 	if totalFixed == 0 {
 		runGoModTidy = true
@@ -130,48 +337,53 @@ func performFixes(ctx *fixContext) (int, bool, error) {
 	return totalFixed, runGoModTidy, nil
 }
 
-func printFixSummary(totalFixed int, didFix, isDryRun bool, repoPath string) error {
-	fmt.Println()
+func printFixSummary(ctx context.Context, totalFixed int, didFix, isDryRun bool, repoPath string) error {
+	if isTextLogFormat() {
+		fmt.Println()
+	}
 	if isDryRun {
-		fmt.Printf("✨ Would fix %d dependency issues\n", totalFixed)
-		fmt.Println("Run without --dry-run to apply the fixes")
+		logger.Info(fmt.Sprintf("✨ Would fix %d dependency issues", totalFixed), "phase", "summary", "dry_run", true, "fixed", totalFixed)
+		logger.Info("Run without --dry-run to apply the fixes", "phase", "summary")
 		if totalFixed > 0 {
-			fmt.Println("💡 Will run 'go mod tidy' after applying fixes")
+			logger.Info("💡 Will run 'go mod tidy' after applying fixes", "phase", "summary")
 		}
 		if !didFix {
-			fmt.Println("✅ No dependency issues found to fix")
+			logger.Info("✅ No dependency issues found to fix", "phase", "summary")
 		}
 	} else {
-		fmt.Printf("✅ Fixed %d dependency issues successfully!\n", totalFixed)
+		logger.Info(fmt.Sprintf("✅ Fixed %d dependency issues successfully!", totalFixed), "phase", "summary", "dry_run", false, "fixed", totalFixed)
 		if totalFixed > 0 {
-			fmt.Println()
-			fmt.Println("🧹 Running final cleanup...")
-			if err := runFinalGoModTidy(repoPath); err != nil {
+			if isTextLogFormat() {
+				fmt.Println()
+			}
+			logger.Info("🧹 Running final cleanup...", "phase", "cleanup")
+			if err := runFinalGoModTidy(ctx, repoPath); err != nil {
 				return fmt.Errorf("failed to run final 'go mod tidy': %w", err)
 			}
-			fmt.Println("✅ All dependency fixes completed successfully!")
-			fmt.Println("💡 Please test your application to ensure everything works correctly")
+			logger.Info("✅ All dependency fixes completed successfully!", "phase", "cleanup")
+			logger.Info("💡 Please test your application to ensure everything works correctly", "phase", "cleanup")
 		}
 	}
 	return nil
 }
 
-func runFinalGoModTidy(repoPath string) error {
-	cmd := exec.Command("go", "mod", "tidy")
+func runFinalGoModTidy(ctx context.Context, repoPath string) error {
+	start := time.Now()
+	cmd := exec.CommandContext(ctx, "go", "mod", "tidy")
 	cmd.Dir = repoPath
 
 	if output, err := cmd.CombinedOutput(); err != nil {
 		return fmt.Errorf("failed to run 'go mod tidy': %w\nOutput: %s", err, string(output))
 	}
 
-	fmt.Println("  ✅ Ran final 'go mod tidy' to clean up go.mod and go.sum")
+	logger.Info("  ✅ Ran final 'go mod tidy' to clean up go.mod and go.sum", "phase", "cleanup", "duration_ms", time.Since(start).Milliseconds())
 	return nil
 }
 
-func fixUnusedDependencies(repo *git.Repository, cfg *config.Config, dryRun bool) (int, error) {
+func fixUnusedDependencies(ctx context.Context, repo *git.Repository, cfg *config.Config, dryRun bool) (int, error) {
 	fmt.Println("🧹 Checking for unused dependencies...")
 
-	unusedIssues, err := getUnusedDependencyIssues(repo, cfg)
+	unusedIssues, err := getUnusedDependencyIssues(ctx, repo, cfg)
 	if err != nil {
 		return 0, err
 	}
@@ -184,7 +396,7 @@ func fixUnusedDependencies(repo *git.Repository, cfg *config.Config, dryRun bool
 	printUnusedDependencies(unusedIssues, dryRun)
 
 	if !dryRun {
-		if err := runGoModTidy(repo.GetPath()); err != nil {
+		if err := runGoModTidy(ctx, repo.GetPath()); err != nil {
 			return 0, err
 		}
 	}
@@ -192,10 +404,14 @@ func fixUnusedDependencies(repo *git.Repository, cfg *config.Config, dryRun bool
 	return len(unusedIssues), nil
 }
 
-func getUnusedDependencyIssues(repo *git.Repository, cfg *config.Config) ([]report.Issue, error) {
+func getUnusedDependencyIssues(ctx context.Context, repo *git.Repository, cfg *config.Config) ([]report.Issue, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	dependencyAnalyzer := analyzer.NewDependencyAnalyzer(&cfg.Dependencies, repo.GetPath())
 
-	issues, err := dependencyAnalyzer.Analyze()
+	issues, err := dependencyAnalyzer.AnalyzeCtx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to analyze dependencies: %w", err)
 	}
@@ -211,19 +427,19 @@ func getUnusedDependencyIssues(repo *git.Repository, cfg *config.Config) ([]repo
 }
 
 func printUnusedDependencies(unusedIssues []report.Issue, dryRun bool) {
-	fmt.Printf("  📦 Found %d unused dependencies\n", len(unusedIssues))
+	logger.Info(fmt.Sprintf("  📦 Found %d unused dependencies", len(unusedIssues)), "phase", "unused_dependencies", "count", len(unusedIssues), "dry_run", dryRun)
 	for _, issue := range unusedIssues {
 		packageName := extractPackageNameFromDescription(issue.Description)
 		if dryRun {
-			fmt.Printf("    ℹ️  Would remove: %s\n", packageName)
+			logger.Info(fmt.Sprintf("    ℹ️  Would remove: %s", packageName), "phase", "unused_dependencies", "package", packageName, "action", "would_remove", "dry_run", true)
 		} else {
-			fmt.Printf("    🗑️  Removing: %s\n", packageName)
+			logger.Info(fmt.Sprintf("    🗑️  Removing: %s", packageName), "phase", "unused_dependencies", "package", packageName, "action", "remove", "dry_run", false)
 		}
 	}
 }
 
-func runGoModTidy(repoPath string) error {
-	cmd := exec.Command("go", "mod", "tidy")
+func runGoModTidy(ctx context.Context, repoPath string) error {
+	cmd := exec.CommandContext(ctx, "go", "mod", "tidy")
 	cmd.Dir = repoPath
 
 	if output, err := cmd.CombinedOutput(); err != nil {
@@ -234,10 +450,10 @@ func runGoModTidy(repoPath string) error {
 	return nil
 }
 
-func fixOutdatedDependencies(repo *git.Repository, cfg *config.Config, dryRun bool) (int, error) {
+func fixOutdatedDependencies(ctx context.Context, repo *git.Repository, cfg *config.Config, dryRun bool) (int, error) {
 	fmt.Println("📈 Checking for outdated dependencies...")
 
-	outdatedIssues, err := getOutdatedDependencyIssues(repo, cfg)
+	outdatedIssues, err := getOutdatedDependencyIssues(ctx, repo, cfg)
 	if err != nil {
 		return 0, err
 	}
@@ -253,19 +469,60 @@ func fixOutdatedDependencies(repo *git.Repository, cfg *config.Config, dryRun bo
 		return showOutdatedUpdates(outdatedIssues), nil
 	}
 
-	fixedCount := updateOutdatedDependencies(outdatedIssues, repo.GetPath())
+	fixedCount, updated := updateOutdatedDependencies(ctx, outdatedIssues, repo.GetPath())
 
 	if fixedCount > 0 {
-		runPostUpdateCleanup(repo.GetPath())
+		runPostUpdateCleanup(ctx, repo.GetPath())
+		recordDependencyUpdates(repo, updated)
 	}
 
 	return fixedCount, nil
 }
 
-func getOutdatedDependencyIssues(repo *git.Repository, cfg *config.Config) ([]report.Issue, error) {
+// recordDependencyUpdates appends the packages fix just bumped to the
+// .healthcheck.lock lockfile, alongside whatever findings baseline already
+// lives there, so `fix` leaves a durable audit trail of what changed and
+// when independent of the console output.
+func recordDependencyUpdates(repo *git.Repository, updated []report.Issue) {
+	if len(updated) == 0 {
+		return
+	}
+
+	lockPath := filepath.Join(repo.GetPath(), ".healthcheck.lock")
+	lock, err := baseline.Load(lockPath)
+	if err != nil {
+		logger.Warn(fmt.Sprintf("failed to load baseline lockfile for audit trail: %v", err), "phase", "cleanup")
+		return
+	}
+
+	commitSHA, _ := repo.GetCurrentCommit()
+
+	for _, issue := range updated {
+		packageName := extractPackageNameFromDescription(issue.Description)
+		fromVersion := extractCurrentVersionFromDescription(issue.Description)
+		toVersion := extractLatestVersionFromDescription(issue.Description)
+		if issue.Remediation != nil {
+			packageName = issue.Remediation.Package
+			fromVersion = issue.Remediation.FromVersion
+			toVersion = issue.Remediation.ToVersion
+		}
+
+		lock.RecordDependencyUpdate(packageName, fromVersion, toVersion, commitSHA)
+	}
+
+	if err := lock.Save(lockPath); err != nil {
+		logger.Warn(fmt.Sprintf("failed to save baseline lockfile for audit trail: %v", err), "phase", "cleanup")
+	}
+}
+
+func getOutdatedDependencyIssues(ctx context.Context, repo *git.Repository, cfg *config.Config) ([]report.Issue, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	dependencyAnalyzer := analyzer.NewDependencyAnalyzer(&cfg.Dependencies, repo.GetPath())
 
-	issues, err := dependencyAnalyzer.Analyze()
+	issues, err := dependencyAnalyzer.AnalyzeCtx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to analyze dependencies: %w", err)
 	}
@@ -289,24 +546,33 @@ func showOutdatedUpdates(outdatedIssues []report.Issue) int {
 	return len(outdatedIssues)
 }
 
-func updateOutdatedDependencies(outdatedIssues []report.Issue, repoPath string) int {
+func updateOutdatedDependencies(ctx context.Context, outdatedIssues []report.Issue, repoPath string) (int, []report.Issue) {
 	var fixedCount int
+	var updated []report.Issue
 	for _, issue := range outdatedIssues {
+		if ctx.Err() != nil {
+			break
+		}
+
 		packageName := extractPackageNameFromDescription(issue.Description)
 		latestVersion := extractLatestVersionFromDescription(issue.Description)
 
-		fmt.Printf("    ⬆️  Updating: %s to %s\n", packageName, latestVersion)
+		start := time.Now()
+		logger.Info(fmt.Sprintf("    ⬆️  Updating: %s to %s", packageName, latestVersion), "phase", "outdated_dependencies", "package", packageName, "to_version", latestVersion)
 
-		if updateSinglePackage(packageName, latestVersion, repoPath) {
+		if updateSinglePackage(ctx, packageName, latestVersion, repoPath) {
 			fixedCount++
+			updated = append(updated, issue)
+			logger.Info(fmt.Sprintf("    ✅ Updated: %s to %s", packageName, latestVersion),
+				"phase", "outdated_dependencies", "package", packageName, "to_version", latestVersion, "duration_ms", time.Since(start).Milliseconds())
 		}
 	}
-	return fixedCount
+	return fixedCount, updated
 }
 
-func updateSinglePackage(packageName, latestVersion, repoPath string) bool {
+func updateSinglePackage(ctx context.Context, packageName, latestVersion, repoPath string) bool {
 	updateCmd := fmt.Sprintf("%s@%s", packageName, latestVersion)
-	cmd := exec.Command("go", "get", updateCmd)
+	cmd := exec.CommandContext(ctx, "go", "get", updateCmd)
 	cmd.Dir = repoPath
 
 	if output, err := cmd.CombinedOutput(); err != nil {
@@ -318,8 +584,8 @@ func updateSinglePackage(packageName, latestVersion, repoPath string) bool {
 	return true
 }
 
-func runPostUpdateCleanup(repoPath string) {
-	cmd := exec.Command("go", "mod", "tidy")
+func runPostUpdateCleanup(ctx context.Context, repoPath string) {
+	cmd := exec.CommandContext(ctx, "go", "mod", "tidy")
 	cmd.Dir = repoPath
 
 	if output, err := cmd.CombinedOutput(); err != nil {
@@ -354,3 +620,15 @@ func extractLatestVersionFromDescription(description string) string {
 	}
 	return "latest"
 }
+
+func extractCurrentVersionFromDescription(description string) string {
+	// Extract current version from description like "...(current: v1.0.0, latest: v1.2.3)"
+	if strings.Contains(description, "current: ") {
+		parts := strings.Split(description, "current: ")
+		if len(parts) > 1 {
+			version := strings.SplitN(parts[1], ",", 2)[0]
+			return strings.TrimSpace(version)
+		}
+	}
+	return "unknown"
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+)
+
+// logger is the package-level structured logger used by the fix and check
+// commands. It is initialized by initLogger from the --log-format/--log-level
+// persistent flags before any command's RunE runs.
+var logger *slog.Logger
+
+// logFormat tracks the active --log-format so call sites that also print
+// cosmetic blank lines (for the text renderer) can skip them in json mode.
+var logFormat = "text"
+
+// prettyHandler renders log records as the plain emoji-decorated lines the
+// CLI has always printed, ignoring structured attributes. It backs the
+// "text" --log-format.
+type prettyHandler struct {
+	out   io.Writer
+	level slog.Level
+}
+
+func newPrettyHandler(out io.Writer, level slog.Level) *prettyHandler {
+	return &prettyHandler{out: out, level: level}
+}
+
+func (h *prettyHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level
+}
+
+func (h *prettyHandler) Handle(_ context.Context, r slog.Record) error {
+	_, err := fmt.Fprintln(h.out, r.Message)
+	return err
+}
+
+func (h *prettyHandler) WithAttrs(_ []slog.Attr) slog.Handler { return h }
+func (h *prettyHandler) WithGroup(_ string) slog.Handler      { return h }
+
+// initLogger configures the package-level logger from the --log-format and
+// --log-level flag values. format "json" emits one structured event per line
+// (phase, package, from_version, to_version, dry_run, duration_ms, ...);
+// anything else keeps the existing pretty-printed console output.
+func initLogger(format, level string) {
+	logFormat = format
+
+	opts := &slog.HandlerOptions{Level: parseLogLevel(level)}
+
+	var handler slog.Handler
+	if format == "json" {
+		handler = slog.NewJSONHandler(os.Stdout, opts)
+	} else {
+		handler = newPrettyHandler(os.Stdout, opts.Level.Level())
+	}
+
+	logger = slog.New(handler)
+}
+
+func parseLogLevel(level string) slog.Level {
+	switch level {
+	case "debug":
+		return slog.LevelDebug
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// isTextLogFormat reports whether the active --log-format is the pretty,
+// human-oriented renderer, for call sites that also print cosmetic spacing
+// that would otherwise corrupt a machine-readable log stream.
+func isTextLogFormat() bool {
+	return logFormat != "json"
+}
+
+func init() {
+	// Ensure logger is always usable, even for code paths (or future tests)
+	// that run before the root command's PersistentPreRunE fires.
+	initLogger("text", "info")
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	stdctx "context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/githealthchecker/git-health-checker/internal/config"
+	"github.com/githealthchecker/git-health-checker/internal/git"
+	"github.com/githealthchecker/git-health-checker/internal/lsp"
+	"github.com/githealthchecker/git-health-checker/internal/report"
+	"github.com/spf13/cobra"
+)
+
+var lspCmd = &cobra.Command{
+	Use:   "lsp",
+	Short: "Start a Language Server Protocol server over stdio",
+	Long: `Start githealthchecker as a Language Server Protocol server communicating
+over stdin/stdout, so editors can surface health-check issues as diagnostics
+as files are opened and saved, and offer their Fix text as quick fixes.`,
+	Args: cobra.NoArgs,
+	RunE: runLSP,
+}
+
+func init() {
+	rootCmd.AddCommand(lspCmd)
+}
+
+func runLSP(cmd *cobra.Command, args []string) error {
+	enableAllAnalysisIfNoneSelected()
+
+	configPath, _ := cmd.Flags().GetString("config")
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	server := lsp.NewServer(os.Stdin, os.Stdout, func(rootPath string) (*report.Report, error) {
+		return analyzeForLSP(cmd.Context(), cfg, rootPath)
+	})
+
+	return server.Serve()
+}
+
+// analyzeForLSP runs the same analyzer pipeline `check` uses against the
+// server's current workspace root, re-opening the repository each time so a
+// didSave always reflects what's on disk.
+func analyzeForLSP(ctx stdctx.Context, cfg *config.Config, rootPath string) (*report.Report, error) {
+	if rootPath == "" {
+		abs, err := filepath.Abs(".")
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve workspace root: %w", err)
+		}
+		rootPath = abs
+	}
+
+	if !git.IsGitRepository(rootPath) {
+		return nil, fmt.Errorf("workspace %s is not a Git repository", rootPath)
+	}
+
+	repo, err := git.OpenRepository(rootPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open repository: %w", err)
+	}
+
+	startTime := time.Now()
+	healthReport := &report.Report{
+		Repository: rootPath,
+		Issues:     []report.Issue{},
+		Timestamp:  startTime,
+		Version:    Version,
+	}
+
+	if err := runAnalyses(ctx, repo, cfg, healthReport, false); err != nil {
+		return nil, fmt.Errorf("analysis failed: %w", err)
+	}
+
+	healthReport.Duration = time.Since(startTime).String()
+	healthReport.Summary = calculateSummary(healthReport.Issues)
+
+	return healthReport, nil
+}
@@ -12,11 +12,17 @@ var Version = "dev"
 var rootCmd = &cobra.Command{
 	Use:   "githealthchecker",
 	Short: "A comprehensive CLI tool for analyzing Git repository health",
-	Long: `Git Health Checker is a comprehensive CLI tool that analyzes Git repositories 
+	Long: `Git Health Checker is a comprehensive CLI tool that analyzes Git repositories
 for common issues, security vulnerabilities, and maintenance problems.
 
-It provides detailed health reports covering security, performance, 
+It provides detailed health reports covering security, performance,
 code quality, and maintenance aspects.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		format, _ := cmd.Flags().GetString("log-format")
+		level, _ := cmd.Flags().GetString("log-level")
+		initLogger(format, level)
+		return nil
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		// Check for --version flag
 		version, _ := cmd.Flags().GetBool("version")
@@ -34,10 +40,13 @@ func Execute() error {
 
 func init() {
 	rootCmd.PersistentFlags().StringP("config", "c", "", "config file (default is .healthcheck.yaml)")
-	rootCmd.PersistentFlags().StringP("format", "f", "table", "output format (table, json, markdown)")
+	rootCmd.PersistentFlags().StringP("format", "f", "table", "output format (table, friendly, json, markdown, sarif, junit, template)")
+	rootCmd.PersistentFlags().String("format-template", "", "path to a text/template file to render the report with (implies --format template)")
 	rootCmd.PersistentFlags().StringP("output", "o", "", "output file path")
 	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "verbose output")
 	rootCmd.PersistentFlags().BoolP("version", "", false, "show version information")
+	rootCmd.PersistentFlags().String("log-level", "info", "log level (debug, info, warn, error)")
+	rootCmd.PersistentFlags().String("log-format", "text", "log output format (text, json)")
 
 	// Add version command
 	rootCmd.AddCommand(&cobra.Command{
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+// applyMaintenanceSuggestedFixes creates any missing required file for
+// which missingRequiredFileIssues attached a SuggestedFix (currently just
+// .gitignore), printing a diff preview for every file before writing it.
+// With dryRun it only prints the preview.
+func applyMaintenanceSuggestedFixes(fixCtx *fixContext, dryRun bool) (int, error) {
+	issues := missingRequiredFileIssues(fixCtx.cfg, fixCtx.absPath)
+	return applySuggestedFixes(fixCtx.absPath, issues, dryRun)
+}
+
+// applySuggestedFixes applies every TextEdit-based SuggestedFix attached to
+// issues, relative to repoPath, printing a diff preview for each changed
+// file. Command-based fixes are left for the existing dependency-fix flow,
+// which already runs the equivalent `go get`/`go mod tidy` commands.
+func applySuggestedFixes(repoPath string, issues []report.Issue, dryRun bool) (int, error) {
+	applied := 0
+
+	for _, issue := range issues {
+		for _, suggestedFix := range issue.SuggestedFixes {
+			for relPath, edits := range suggestedFix.Edits {
+				changed, err := applyFileEdits(repoPath, relPath, edits, dryRun)
+				if err != nil {
+					return applied, err
+				}
+				if changed {
+					applied++
+				}
+			}
+		}
+	}
+
+	return applied, nil
+}
+
+func applyFileEdits(repoPath, relPath string, edits []report.TextEdit, dryRun bool) (bool, error) {
+	fullPath := filepath.Join(repoPath, relPath)
+
+	original := ""
+	if data, err := os.ReadFile(fullPath); err == nil {
+		original = string(data)
+	}
+
+	updated := report.ApplyEdits(original, edits)
+	if updated == original {
+		return false, nil
+	}
+
+	if diff := report.DiffPreview(relPath, original, updated); diff != "" {
+		fmt.Println(diff)
+	}
+
+	if dryRun {
+		return true, nil
+	}
+
+	if dir := filepath.Dir(fullPath); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return false, fmt.Errorf("failed to create directory for %s: %w", relPath, err)
+		}
+	}
+
+	if err := os.WriteFile(fullPath, []byte(updated), 0644); err != nil {
+		return false, fmt.Errorf("failed to apply suggested fix to %s: %w", relPath, err)
+	}
+
+	return true, nil
+}
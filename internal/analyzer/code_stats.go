@@ -2,24 +2,35 @@ package analyzer
 
 import (
 	"bufio"
+	"context"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/githealthchecker/git-health-checker/internal/progress"
 	"github.com/githealthchecker/git-health-checker/internal/report"
 	"github.com/githealthchecker/git-health-checker/internal/scanner"
 )
 
 type CodeStatsAnalyzer struct {
 	fileScanner *scanner.FileScanner
+	reporter    progress.Reporter
 }
 
 func NewCodeStatsAnalyzer(fileScanner *scanner.FileScanner) *CodeStatsAnalyzer {
 	return &CodeStatsAnalyzer{
 		fileScanner: fileScanner,
+		reporter:    progress.NewNoop(),
 	}
 }
 
+// SetReporter wires a progress.Reporter into the file walk so callers driving
+// long scans (e.g. the check command against a large monorepo) can render a
+// progress bar instead of appearing to hang.
+func (c *CodeStatsAnalyzer) SetReporter(reporter progress.Reporter) {
+	c.reporter = reporter
+}
+
 func (c *CodeStatsAnalyzer) Analyze() (report.CodeStats, error) {
 	stats := report.CodeStats{
 		TotalLines:        0,
@@ -39,8 +50,66 @@ func (c *CodeStatsAnalyzer) Analyze() (report.CodeStats, error) {
 	return stats, nil
 }
 
+// AnalyzeCtx is Analyze with cancellation, checked between each file so a
+// code stats pass over a large monorepo can be interrupted instead of
+// running to completion once started.
+func (c *CodeStatsAnalyzer) AnalyzeCtx(ctx context.Context) (report.CodeStats, error) {
+	stats := report.CodeStats{
+		TotalLines:        0,
+		TotalFiles:        0,
+		LanguageBreakdown: make(map[string]int),
+		LanguagePercent:   make(map[string]float64),
+	}
+
+	files, err := c.fileScanner.ScanFiles()
+	if err != nil {
+		return stats, err
+	}
+
+	if err := c.processFilesCtx(ctx, files, &stats); err != nil {
+		return stats, err
+	}
+	c.calculatePercentages(&stats)
+
+	return stats, nil
+}
+
 func (c *CodeStatsAnalyzer) processFiles(files []scanner.FileInfo, stats *report.CodeStats) {
+	c.reporter.Start("code_stats", len(files))
+	defer c.reporter.Finish()
+
+	for _, file := range files {
+		c.reporter.Increment()
+
+		if c.shouldSkipFile(file.RelativePath) {
+			continue
+		}
+
+		language := c.detectLanguage(file.RelativePath)
+		if language == "" {
+			continue
+		}
+
+		lineCount := c.getLineCount(file)
+		if lineCount > 0 {
+			stats.TotalFiles++
+			stats.TotalLines += lineCount
+			stats.LanguageBreakdown[language] += lineCount
+		}
+	}
+}
+
+func (c *CodeStatsAnalyzer) processFilesCtx(ctx context.Context, files []scanner.FileInfo, stats *report.CodeStats) error {
+	c.reporter.Start("code_stats", len(files))
+	defer c.reporter.Finish()
+
 	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		c.reporter.Increment()
+
 		if c.shouldSkipFile(file.RelativePath) {
 			continue
 		}
@@ -57,6 +126,8 @@ func (c *CodeStatsAnalyzer) processFiles(files []scanner.FileInfo, stats *report
 			stats.LanguageBreakdown[language] += lineCount
 		}
 	}
+
+	return nil
 }
 
 func (c *CodeStatsAnalyzer) getLineCount(file scanner.FileInfo) int {
@@ -95,7 +166,14 @@ func (c *CodeStatsAnalyzer) shouldSkipFile(filePath string) bool {
 		return true
 	}
 
-	// Skip common directories
+	// Most vendor/node_modules/dist/build/target trees are also excluded by
+	// c.fileScanner's .gitignore/.gitattributes-aware IgnoreMatcher, but not
+	// every project gitignores these conventional directories (e.g. vendor/
+	// committed on purpose), so keep the hardcoded fallback too.
+	if c.fileScanner.IgnoreMatcher().Match(filePath) {
+		return true
+	}
+
 	pathParts := strings.Split(filePath, string(filepath.Separator))
 	for _, part := range pathParts {
 		lowerPart := strings.ToLower(part)
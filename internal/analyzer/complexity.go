@@ -0,0 +1,362 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strings"
+)
+
+// complexityFunction is one function or closure a complexityPlugin found in
+// a source file, carrying everything checkFunctionComplexity needs to build
+// report.Issues against it.
+type complexityFunction struct {
+	Name      string
+	StartLine int
+	EndLine   int
+	// Complexity is McCabe cyclomatic complexity.
+	Complexity int
+	// Cognitive is the Sonar-style cognitive complexity score. Only
+	// goComplexityPlugin computes a real value for it; regexComplexityPlugin
+	// leaves it at its zero value, since reliably tracking nesting depth and
+	// logical-operator runs needs a real AST, not line-by-line pattern
+	// matching.
+	Cognitive int
+}
+
+// complexityPlugin computes per-function McCabe complexity and line spans
+// for one language. goComplexityPlugin backs it with a real go/ast walk;
+// regexComplexityPlugin is a heuristic fallback for languages this module
+// has no parser for.
+type complexityPlugin interface {
+	Functions(content string) ([]complexityFunction, error)
+}
+
+// complexityPlugins maps a file extension to the plugin that analyzes it.
+// Extensions without an entry are skipped by checkFunctionComplexity, same
+// as before this existed - only now that's an explicit, extensible list
+// rather than a hard-coded ".go" check.
+var complexityPlugins = map[string]complexityPlugin{
+	".go":  goComplexityPlugin{},
+	".py":  regexComplexityPlugin{funcStart: pythonFuncStart, decisions: pythonDecisionPatterns},
+	".js":  regexComplexityPlugin{funcStart: jsFuncStart, decisions: jsDecisionPatterns, skipNames: jsControlKeywords},
+	".jsx": regexComplexityPlugin{funcStart: jsFuncStart, decisions: jsDecisionPatterns, skipNames: jsControlKeywords},
+	".ts":  regexComplexityPlugin{funcStart: jsFuncStart, decisions: jsDecisionPatterns, skipNames: jsControlKeywords},
+	".tsx": regexComplexityPlugin{funcStart: jsFuncStart, decisions: jsDecisionPatterns, skipNames: jsControlKeywords},
+}
+
+// goComplexityPlugin computes McCabe complexity by parsing with go/parser
+// and walking the AST, rather than pattern-matching lines: each *ast.FuncDecl
+// and *ast.FuncLit becomes its own complexityFunction, starting at 1 and
+// adding 1 per IfStmt, ForStmt, RangeStmt, non-default CaseClause, non-default
+// CommClause, and short-circuit (&&/||) BinaryExpr.
+type goComplexityPlugin struct{}
+
+func (goComplexityPlugin) Functions(content string) ([]complexityFunction, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", content, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var functions []complexityFunction
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch fn := n.(type) {
+		case *ast.FuncDecl:
+			if fn.Body != nil {
+				functions = append(functions, complexityFunction{
+					Name:       fn.Name.Name,
+					StartLine:  fset.Position(fn.Pos()).Line,
+					EndLine:    fset.Position(fn.End()).Line,
+					Complexity: goFunctionComplexity(fn.Body),
+					Cognitive:  goCognitiveComplexity(fn.Body),
+				})
+			}
+		case *ast.FuncLit:
+			functions = append(functions, complexityFunction{
+				Name:       "func literal",
+				StartLine:  fset.Position(fn.Pos()).Line,
+				EndLine:    fset.Position(fn.End()).Line,
+				Complexity: goFunctionComplexity(fn.Body),
+				Cognitive:  goCognitiveComplexity(fn.Body),
+			})
+		}
+		return true
+	})
+
+	return functions, nil
+}
+
+// goFunctionComplexity walks a single function body and counts its decision
+// points, starting from a baseline of 1. It stops descending into nested
+// *ast.FuncLit nodes so a closure's complexity is attributed to the closure
+// alone, not folded into the function that declares it - ast.Inspect over
+// the whole file visits the closure separately and scores it on its own.
+func goFunctionComplexity(body *ast.BlockStmt) int {
+	complexity := 1
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			return false // nested closure; scored separately, not folded into this function
+		case *ast.IfStmt:
+			complexity++
+		case *ast.ForStmt:
+			complexity++
+		case *ast.RangeStmt:
+			complexity++
+		case *ast.CaseClause:
+			if node.List != nil {
+				complexity++
+			}
+		case *ast.CommClause:
+			if node.Comm != nil {
+				complexity++
+			}
+		case *ast.BinaryExpr:
+			if node.Op == token.LAND || node.Op == token.LOR {
+				complexity++
+			}
+		}
+		return true
+	})
+
+	return complexity
+}
+
+// goCognitiveComplexity scores body the way SonarSource's cognitive
+// complexity metric does: +1 per control-flow construct, plus one more for
+// every level it's nested inside another one, and +1 per run of
+// same-operator &&/|| in a boolean expression (a chain of the same operator
+// only costs once; switching operator costs again). Unlike cyclomatic
+// complexity it stops at nested *ast.FuncLit the same way
+// goFunctionComplexity does - a closure is scored on its own.
+func goCognitiveComplexity(body *ast.BlockStmt) int {
+	score := 0
+	ast.Walk(&cognitiveVisitor{score: &score}, body)
+	return score
+}
+
+// cognitiveVisitor implements ast.Visitor, threading the current nesting
+// depth through recursive ast.Walk calls so a construct's cost
+// (1 + nesting) reflects how deeply it's nested inside other control flow.
+type cognitiveVisitor struct {
+	score   *int
+	nesting int
+}
+
+func (v *cognitiveVisitor) Visit(n ast.Node) ast.Visitor {
+	switch node := n.(type) {
+	case *ast.FuncLit:
+		return nil // nested closure; scored separately, not folded into this function
+	case *ast.IfStmt:
+		v.visitIfChain(node)
+		return nil
+	case *ast.ForStmt:
+		*v.score += 1 + v.nesting
+		if node.Cond != nil {
+			*v.score += countLogicalOperatorRuns(node.Cond)
+		}
+		ast.Walk(v.nested(), node.Body)
+		return nil
+	case *ast.RangeStmt:
+		*v.score += 1 + v.nesting
+		ast.Walk(v.nested(), node.Body)
+		return nil
+	case *ast.SwitchStmt:
+		*v.score += 1 + v.nesting
+		if node.Tag != nil {
+			*v.score += countLogicalOperatorRuns(node.Tag)
+		}
+		ast.Walk(v.nested(), node.Body)
+		return nil
+	case *ast.TypeSwitchStmt:
+		*v.score += 1 + v.nesting
+		ast.Walk(v.nested(), node.Body)
+		return nil
+	case *ast.SelectStmt:
+		*v.score += 1 + v.nesting
+		ast.Walk(v.nested(), node.Body)
+		return nil
+	case *ast.BinaryExpr:
+		*v.score += countLogicalOperatorRuns(node)
+		return nil // the whole expression tree rooted here has been scored
+	}
+	return v
+}
+
+// nested returns a cognitiveVisitor for a construct's body, one nesting
+// level deeper than v.
+func (v *cognitiveVisitor) nested() *cognitiveVisitor {
+	return &cognitiveVisitor{score: v.score, nesting: v.nesting + 1}
+}
+
+// visitIfChain scores an if/else-if/else chain: the initial if and every
+// chained else-if cost 1 (plus the chain's own nesting depth) each, a
+// trailing bare else costs 1 more, and only each branch's body - not the
+// next condition in the chain - nests one level deeper.
+func (v *cognitiveVisitor) visitIfChain(stmt *ast.IfStmt) {
+	*v.score += 1 + v.nesting
+	if stmt.Cond != nil {
+		*v.score += countLogicalOperatorRuns(stmt.Cond)
+	}
+	ast.Walk(v.nested(), stmt.Body)
+
+	for stmt.Else != nil {
+		switch elseNode := stmt.Else.(type) {
+		case *ast.IfStmt:
+			*v.score++
+			if elseNode.Cond != nil {
+				*v.score += countLogicalOperatorRuns(elseNode.Cond)
+			}
+			ast.Walk(v.nested(), elseNode.Body)
+			stmt = elseNode
+		case *ast.BlockStmt:
+			*v.score++
+			ast.Walk(v.nested(), elseNode)
+			return
+		default:
+			return
+		}
+	}
+}
+
+// countLogicalOperatorRuns walks expr's &&/|| operators and counts one per
+// maximal run of the same operator, the way Sonar's cognitive complexity
+// treats a boolean expression: "a && b && c" costs 1, "a && b || c" costs 2
+// (one for the && run, one for the switch to ||).
+func countLogicalOperatorRuns(expr ast.Expr) int {
+	count := 0
+	walkLogical(expr, &count)
+	return count
+}
+
+// walkLogical returns the logical operator the expression rooted at expr
+// continues a run of (token.ILLEGAL if expr isn't part of one), recursing
+// into operands that might themselves contain nested boolean expressions
+// (inside parens or call arguments) and incrementing count whenever a run
+// ends, as detected by an operator change.
+func walkLogical(expr ast.Expr, count *int) token.Token {
+	switch node := expr.(type) {
+	case *ast.BinaryExpr:
+		if node.Op == token.LAND || node.Op == token.LOR {
+			leftRun := walkLogical(node.X, count)
+			if leftRun != node.Op {
+				*count++
+			}
+			walkLogical(node.Y, count)
+			return node.Op
+		}
+		walkLogical(node.X, count)
+		walkLogical(node.Y, count)
+		return token.ILLEGAL
+	case *ast.ParenExpr:
+		return walkLogical(node.X, count)
+	case *ast.UnaryExpr:
+		walkLogical(node.X, count)
+		return token.ILLEGAL
+	case *ast.CallExpr:
+		for _, arg := range node.Args {
+			walkLogical(arg, count)
+		}
+		return token.ILLEGAL
+	default:
+		return token.ILLEGAL
+	}
+}
+
+var (
+	pythonFuncStart        = regexp.MustCompile(`^\s*(?:async\s+)?def\s+(\w+)\s*\(`)
+	pythonDecisionPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`\bif\b`), regexp.MustCompile(`\belif\b`),
+		regexp.MustCompile(`\bfor\b`), regexp.MustCompile(`\bwhile\b`),
+		regexp.MustCompile(`\bexcept\b`), regexp.MustCompile(`\band\b`), regexp.MustCompile(`\bor\b`),
+	}
+
+	jsFuncStart        = regexp.MustCompile(`(?:\bfunction\s*(\w*)\s*\(|(?:const|let|var)\s+(\w+)\s*=\s*(?:async\s*)?\(?[^=(){}]*\)?\s*=>|(\w+)\s*\([^)]*\)\s*\{)`)
+	jsDecisionPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`\bif\b`), regexp.MustCompile(`\bfor\b`), regexp.MustCompile(`\bwhile\b`),
+		regexp.MustCompile(`\bcase\b`), regexp.MustCompile(`\bcatch\b`), regexp.MustCompile(`&&`), regexp.MustCompile(`\|\|`),
+	}
+
+	// jsControlKeywords holds the words that can precede a "(...) {" the same
+	// way a method name does (if, for, switch, ...). Go's RE2 engine has no
+	// lookbehind to rule these out inside jsFuncStart itself, so they're
+	// filtered out by name after matching instead.
+	jsControlKeywords = map[string]bool{
+		"if": true, "for": true, "while": true, "switch": true,
+		"catch": true, "else": true, "do": true, "return": true,
+	}
+)
+
+// regexComplexityPlugin is a heuristic complexityPlugin for languages this
+// module has no parser for. It finds function boundaries positionally, by
+// matching funcStart against each line and treating the next match (or end
+// of file) as the boundary, then estimates complexity by counting decisions
+// matches in between. Unlike goComplexityPlugin it can't see nested function
+// boundaries, so a closure's complexity is folded into its enclosing
+// function - an approximation, not an exact count.
+type regexComplexityPlugin struct {
+	funcStart *regexp.Regexp
+	decisions []*regexp.Regexp
+	// skipNames holds captured names that aren't really functions (control
+	// keywords funcStart's generic "name(...) {" alternative can't tell
+	// apart from a method declaration). Matches whose captured name is in
+	// here are ignored. May be nil.
+	skipNames map[string]bool
+}
+
+func (p regexComplexityPlugin) Functions(content string) ([]complexityFunction, error) {
+	lines := strings.Split(content, "\n")
+
+	var starts []int
+	for i, line := range lines {
+		if !p.funcStart.MatchString(line) {
+			continue
+		}
+		if name := regexFunctionName(p.funcStart, line); p.skipNames[name] {
+			continue
+		}
+		starts = append(starts, i)
+	}
+
+	functions := make([]complexityFunction, 0, len(starts))
+	for idx, start := range starts {
+		end := len(lines)
+		if idx+1 < len(starts) {
+			end = starts[idx+1]
+		}
+
+		complexity := 1
+		for _, line := range lines[start:end] {
+			for _, pattern := range p.decisions {
+				if pattern.MatchString(line) {
+					complexity++
+				}
+			}
+		}
+
+		functions = append(functions, complexityFunction{
+			Name:       regexFunctionName(p.funcStart, lines[start]),
+			StartLine:  start + 1,
+			EndLine:    end,
+			Complexity: complexity,
+		})
+	}
+
+	return functions, nil
+}
+
+// regexFunctionName returns the first non-empty capture group funcStart
+// found in line, or "anonymous function" if the match didn't capture a name
+// (an arrow function assigned inline, for example).
+func regexFunctionName(funcStart *regexp.Regexp, line string) string {
+	match := funcStart.FindStringSubmatch(line)
+	for _, group := range match[1:] {
+		if group != "" {
+			return group
+		}
+	}
+	return "anonymous function"
+}
@@ -0,0 +1,191 @@
+package analyzer
+
+import "testing"
+
+func TestGoComplexityPlugin_Functions(t *testing.T) {
+	src := `package p
+
+func simple() int {
+	return 1
+}
+
+func branching(a, b int) int {
+	if a > b && b > 0 {
+		return a
+	}
+	for i := 0; i < a; i++ {
+		if i == b || i == a {
+			continue
+		}
+	}
+	return b
+}
+
+func withClosure() int {
+	f := func(x int) int {
+		if x > 0 {
+			return x
+		}
+		return -x
+	}
+	return f(1)
+}
+`
+
+	functions, err := goComplexityPlugin{}.Functions(src)
+	if err != nil {
+		t.Fatalf("Functions failed: %v", err)
+	}
+
+	byName := make(map[string]complexityFunction)
+	for _, fn := range functions {
+		byName[fn.Name] = fn
+	}
+
+	if got := byName["simple"].Complexity; got != 1 {
+		t.Errorf("simple: expected complexity 1, got %d", got)
+	}
+
+	// if (+1, with && adding +1) + for (+1) + nested if (+1, with || adding +1) = baseline 1 + 5
+	if got := byName["branching"].Complexity; got != 6 {
+		t.Errorf("branching: expected complexity 6, got %d", got)
+	}
+
+	// withClosure's own body has no decision points; the closure's "if" must
+	// not be folded into it.
+	if got := byName["withClosure"].Complexity; got != 1 {
+		t.Errorf("withClosure: expected complexity 1, got %d", got)
+	}
+
+	closure, ok := byName["func literal"]
+	if !ok {
+		t.Fatal("expected the closure to be reported as its own function")
+	}
+	if closure.Complexity != 2 {
+		t.Errorf("func literal: expected complexity 2, got %d", closure.Complexity)
+	}
+}
+
+func TestGoComplexityPlugin_FunctionLineSpan(t *testing.T) {
+	src := `package p
+
+func f() {
+	x := 1
+	_ = x
+}
+`
+	functions, err := goComplexityPlugin{}.Functions(src)
+	if err != nil {
+		t.Fatalf("Functions failed: %v", err)
+	}
+	if len(functions) != 1 {
+		t.Fatalf("expected 1 function, got %d", len(functions))
+	}
+
+	fn := functions[0]
+	if fn.StartLine != 3 {
+		t.Errorf("expected start line 3, got %d", fn.StartLine)
+	}
+	if fn.EndLine != 6 {
+		t.Errorf("expected end line 6, got %d", fn.EndLine)
+	}
+}
+
+func TestGoComplexityPlugin_CognitiveComplexity(t *testing.T) {
+	src := `package p
+
+func nested(a, b int) int {
+	if a > 0 {
+		for i := 0; i < a; i++ {
+			if i == b && i != 0 {
+				return i
+			}
+		}
+	}
+	return b
+}
+
+func flat(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+`
+
+	functions, err := goComplexityPlugin{}.Functions(src)
+	if err != nil {
+		t.Fatalf("Functions failed: %v", err)
+	}
+
+	byName := make(map[string]complexityFunction)
+	for _, fn := range functions {
+		byName[fn.Name] = fn
+	}
+
+	// if (+1) + for nested in if (+1+1) + if nested in for (+1+2) + && run (+1) = 7
+	if got := byName["nested"].Cognitive; got != 7 {
+		t.Errorf("nested: expected cognitive complexity 7, got %d", got)
+	}
+
+	// a single, unnested if costs exactly 1, unlike its cyclomatic complexity of 2.
+	if got := byName["flat"].Cognitive; got != 1 {
+		t.Errorf("flat: expected cognitive complexity 1, got %d", got)
+	}
+}
+
+func TestRegexComplexityPlugin_Python(t *testing.T) {
+	src := `def simple():
+    return 1
+
+def branching(a, b):
+    if a > b and b > 0:
+        return a
+    return b
+`
+
+	functions, err := (regexComplexityPlugin{funcStart: pythonFuncStart, decisions: pythonDecisionPatterns}).Functions(src)
+	if err != nil {
+		t.Fatalf("Functions failed: %v", err)
+	}
+	if len(functions) != 2 {
+		t.Fatalf("expected 2 functions, got %d", len(functions))
+	}
+
+	if functions[0].Name != "simple" || functions[0].Complexity != 1 {
+		t.Errorf("simple: got name %q complexity %d", functions[0].Name, functions[0].Complexity)
+	}
+	if functions[1].Name != "branching" || functions[1].Complexity != 3 {
+		t.Errorf("branching: got name %q complexity %d", functions[1].Name, functions[1].Complexity)
+	}
+}
+
+func TestRegexComplexityPlugin_JSIgnoresControlFlowKeywords(t *testing.T) {
+	plugin := regexComplexityPlugin{funcStart: jsFuncStart, decisions: jsDecisionPatterns, skipNames: jsControlKeywords}
+
+	src := `function foo(a, b) {
+  if (a > b && b > 0) {
+    return a;
+  }
+  return b;
+}
+
+const bar = () => {
+  return 1;
+};
+`
+
+	functions, err := plugin.Functions(src)
+	if err != nil {
+		t.Fatalf("Functions failed: %v", err)
+	}
+	if len(functions) != 2 {
+		t.Fatalf("expected 2 functions (the \"if (...)\" line must not count as one), got %d", len(functions))
+	}
+	if functions[0].Name != "foo" || functions[0].Complexity != 3 {
+		t.Errorf("foo: got name %q complexity %d", functions[0].Name, functions[0].Complexity)
+	}
+	if functions[1].Name != "bar" || functions[1].Complexity != 1 {
+		t.Errorf("bar: got name %q complexity %d", functions[1].Name, functions[1].Complexity)
+	}
+}
@@ -0,0 +1,187 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/githealthchecker/git-health-checker/internal/config"
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+// defaultConventionalCommitTypes is the type list Conventional Commits
+// v1.0.0 itself recommends (inherited from the Angular convention it grew
+// out of), used whenever WorkflowConfig.AllowedTypes is left empty.
+var defaultConventionalCommitTypes = []string{
+	"feat", "fix", "docs", "style", "refactor", "perf", "test",
+	"build", "ci", "chore", "revert",
+}
+
+// conventionalHeaderPattern matches a Conventional Commits header line:
+// type, optional (scope), optional "!" breaking marker, then ": " and the
+// description. A commit whose first line doesn't match this shape at all
+// is flagged with the older, coarser "conventional-commits" rule rather
+// than one of the cc-* rules below, which all assume the header parsed.
+var conventionalHeaderPattern = regexp.MustCompile(`^([a-zA-Z][a-zA-Z0-9-]*)(\(([^)]+)\))?(!)?: (.+)$`)
+
+// breakingChangeFooterPattern matches a well-formed BREAKING CHANGE footer.
+var breakingChangeFooterPattern = regexp.MustCompile(`^BREAKING CHANGE: (.+)$`)
+
+// looseBreakingChangeFooterPattern matches anything that was clearly meant
+// to be a BREAKING CHANGE footer (case-insensitive, hyphen allowed) but
+// isn't in the exact form the spec requires - the gap between the two
+// patterns is what cc-breaking-change-footer flags.
+var looseBreakingChangeFooterPattern = regexp.MustCompile(`(?i)^breaking[ -]change:?\s*(.*)$`)
+
+// signoffFooterPattern matches a DCO-style Signed-off-by footer.
+var signoffFooterPattern = regexp.MustCompile(`^Signed-off-by: .+$`)
+
+// CommitMessageAnalysis is checkCommitMessagesCtx's full result: the
+// report.Issues it found, plus every BREAKING CHANGE description it parsed
+// out of a commit footer (or inferred from a bare "!" marker), so release
+// tooling deciding a major vs. minor version bump doesn't need to re-parse
+// commit messages itself.
+type CommitMessageAnalysis struct {
+	Issues          []report.Issue
+	BreakingChanges []string
+}
+
+// allowedCommitTypes returns cfg.AllowedTypes, falling back to
+// defaultConventionalCommitTypes when the config leaves it unset.
+func allowedCommitTypes(cfg *config.WorkflowConfig) []string {
+	if len(cfg.AllowedTypes) > 0 {
+		return cfg.AllowedTypes
+	}
+	return defaultConventionalCommitTypes
+}
+
+// parseConventionalCommit validates message (a commit's full message,
+// header plus body and footers) against Conventional Commits v1.0.0 and
+// cfg's policy, returning every violation found plus any BREAKING CHANGE
+// description the commit carries. hashPrefix (the commit's short hash)
+// namespaces each Issue.ID.
+func parseConventionalCommit(message string, cfg *config.WorkflowConfig, hashPrefix string) ([]report.Issue, []string) {
+	lines := strings.Split(message, "\n")
+	header := lines[0]
+
+	matches := conventionalHeaderPattern.FindStringSubmatch(header)
+	if matches == nil {
+		return []report.Issue{{
+			ID:          fmt.Sprintf("non-conventional-commit-%s", hashPrefix),
+			Title:       "Non-conventional commit message",
+			Description: fmt.Sprintf("Commit message '%s' does not follow conventional commit format", truncateMessage(header, 50)),
+			Category:    report.CategoryWorkflow,
+			Severity:    report.SeverityLow,
+			Rule:        "conventional-commits",
+			Fix:         "Use conventional commit format: type(scope): description",
+			CreatedAt:   time.Now(),
+		}}, nil
+	}
+
+	commitType, scope, breaking, description := matches[1], matches[3], matches[4] == "!", matches[5]
+
+	var issues []report.Issue
+	add := func(rule, title, desc, fix string) {
+		issues = append(issues, report.Issue{
+			ID:          fmt.Sprintf("%s-%s", rule, hashPrefix),
+			Title:       title,
+			Description: desc,
+			Category:    report.CategoryWorkflow,
+			Severity:    report.SeverityLow,
+			Rule:        rule,
+			Fix:         fix,
+			CreatedAt:   time.Now(),
+		})
+	}
+
+	if !containsString(allowedCommitTypes(cfg), commitType) {
+		add("cc-invalid-type", "Invalid conventional commit type",
+			fmt.Sprintf("Commit type '%s' is not in the allowed list (%s)", commitType, strings.Join(allowedCommitTypes(cfg), ", ")),
+			"Use one of the configured commit types")
+	}
+
+	if cfg.RequireScope && scope == "" {
+		add("cc-missing-scope", "Missing commit scope",
+			fmt.Sprintf("Commit message '%s' is missing a required scope", truncateMessage(header, 50)),
+			"Add a scope: type(scope): description")
+	} else if scope != "" && len(cfg.AllowedScopes) > 0 && !containsString(cfg.AllowedScopes, scope) {
+		add("cc-missing-scope", "Disallowed commit scope",
+			fmt.Sprintf("Commit scope '%s' is not in the allowed list (%s)", scope, strings.Join(cfg.AllowedScopes, ", ")),
+			"Use one of the configured scopes")
+	}
+
+	if descRunes := []rune(description); len(descRunes) > 0 && isUpperLetter(descRunes[0]) {
+		add("cc-subject-case", "Commit subject should be lowercase",
+			fmt.Sprintf("Commit subject '%s' starts with an uppercase letter", truncateMessage(description, 50)),
+			"Start the description with a lowercase letter")
+	}
+
+	if len(lines) > 1 && strings.TrimSpace(lines[1]) != "" {
+		add("cc-missing-body-blank-line", "Missing blank line before commit body",
+			"Commit body must be separated from the subject line by a blank line",
+			"Insert a blank line between the subject line and the body")
+	}
+
+	breakingChanges, malformed := parseBreakingChangeFooters(lines)
+	if malformed {
+		add("cc-breaking-change-footer", "Malformed BREAKING CHANGE footer",
+			"A footer looks like it's meant to announce a breaking change but isn't in the exact 'BREAKING CHANGE: <description>' form",
+			"Use the exact footer format: BREAKING CHANGE: <description>")
+	}
+	if breaking && len(breakingChanges) == 0 {
+		breakingChanges = append(breakingChanges, description)
+	}
+
+	if cfg.RequireSignoff && !hasSignoffFooter(lines) {
+		add("cc-missing-signoff", "Missing Signed-off-by footer",
+			"Commit is missing a required 'Signed-off-by' footer",
+			"Sign off the commit with `git commit -s`")
+	}
+
+	return issues, breakingChanges
+}
+
+// parseBreakingChangeFooters scans a commit message's lines (including the
+// header, which never matches) for BREAKING CHANGE footers, returning the
+// descriptions of any well-formed ones and whether a malformed attempt at
+// one was found.
+func parseBreakingChangeFooters(lines []string) ([]string, bool) {
+	var descriptions []string
+	malformed := false
+
+	for _, line := range lines {
+		if m := breakingChangeFooterPattern.FindStringSubmatch(line); m != nil {
+			descriptions = append(descriptions, m[1])
+			continue
+		}
+		if looseBreakingChangeFooterPattern.MatchString(line) {
+			malformed = true
+		}
+	}
+
+	return descriptions, malformed
+}
+
+// hasSignoffFooter reports whether any of lines is a Signed-off-by footer.
+func hasSignoffFooter(lines []string) bool {
+	for _, line := range lines {
+		if signoffFooterPattern.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+func isUpperLetter(r rune) bool {
+	return r >= 'A' && r <= 'Z'
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
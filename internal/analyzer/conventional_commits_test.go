@@ -0,0 +1,130 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/githealthchecker/git-health-checker/internal/config"
+)
+
+func TestParseConventionalCommit_ValidHeader(t *testing.T) {
+	cfg := &config.WorkflowConfig{}
+
+	issues, breaking := parseConventionalCommit("feat(auth): add login flow", cfg, "abc12345")
+	if len(issues) != 0 {
+		t.Errorf("expected no issues for a valid header, got %+v", issues)
+	}
+	if len(breaking) != 0 {
+		t.Errorf("expected no breaking changes, got %v", breaking)
+	}
+}
+
+func TestParseConventionalCommit_InvalidType(t *testing.T) {
+	cfg := &config.WorkflowConfig{AllowedTypes: []string{"feat", "fix"}}
+
+	issues, _ := parseConventionalCommit("oops: something happened", cfg, "abc12345")
+	if len(issues) != 1 || issues[0].Rule != "cc-invalid-type" {
+		t.Fatalf("expected a single cc-invalid-type issue, got %+v", issues)
+	}
+}
+
+func TestParseConventionalCommit_RequireScope(t *testing.T) {
+	cfg := &config.WorkflowConfig{RequireScope: true}
+
+	issues, _ := parseConventionalCommit("feat: add login flow", cfg, "abc12345")
+	if len(issues) != 1 || issues[0].Rule != "cc-missing-scope" {
+		t.Fatalf("expected a single cc-missing-scope issue, got %+v", issues)
+	}
+
+	issues, _ = parseConventionalCommit("feat(auth): add login flow", cfg, "abc12345")
+	if len(issues) != 0 {
+		t.Errorf("expected no issues once a scope is present, got %+v", issues)
+	}
+}
+
+func TestParseConventionalCommit_AllowedScopes(t *testing.T) {
+	cfg := &config.WorkflowConfig{AllowedScopes: []string{"auth", "billing"}}
+
+	issues, _ := parseConventionalCommit("feat(ui): add login flow", cfg, "abc12345")
+	if len(issues) != 1 || issues[0].Rule != "cc-missing-scope" {
+		t.Fatalf("expected a single cc-missing-scope issue for a disallowed scope, got %+v", issues)
+	}
+}
+
+func TestParseConventionalCommit_SubjectCase(t *testing.T) {
+	cfg := &config.WorkflowConfig{}
+
+	issues, _ := parseConventionalCommit("feat: Add login flow", cfg, "abc12345")
+	if len(issues) != 1 || issues[0].Rule != "cc-subject-case" {
+		t.Fatalf("expected a single cc-subject-case issue, got %+v", issues)
+	}
+}
+
+func TestParseConventionalCommit_MissingBodyBlankLine(t *testing.T) {
+	cfg := &config.WorkflowConfig{}
+
+	issues, _ := parseConventionalCommit("feat: add login flow\nsome body text without a blank line", cfg, "abc12345")
+	if len(issues) != 1 || issues[0].Rule != "cc-missing-body-blank-line" {
+		t.Fatalf("expected a single cc-missing-body-blank-line issue, got %+v", issues)
+	}
+
+	issues, _ = parseConventionalCommit("feat: add login flow\n\nsome body text with a blank line", cfg, "abc12345")
+	if len(issues) != 0 {
+		t.Errorf("expected no issues when the body is separated by a blank line, got %+v", issues)
+	}
+}
+
+func TestParseConventionalCommit_BreakingChangeFooter(t *testing.T) {
+	cfg := &config.WorkflowConfig{}
+
+	issues, breaking := parseConventionalCommit("feat: add login flow\n\nBREAKING CHANGE: old tokens are rejected", cfg, "abc12345")
+	if len(issues) != 0 {
+		t.Errorf("expected no issues for a well-formed footer, got %+v", issues)
+	}
+	if len(breaking) != 1 || breaking[0] != "old tokens are rejected" {
+		t.Errorf("expected one breaking change description, got %v", breaking)
+	}
+}
+
+func TestParseConventionalCommit_MalformedBreakingChangeFooter(t *testing.T) {
+	cfg := &config.WorkflowConfig{}
+
+	issues, _ := parseConventionalCommit("feat: add login flow\n\nbreaking-change: old tokens are rejected", cfg, "abc12345")
+	if len(issues) != 1 || issues[0].Rule != "cc-breaking-change-footer" {
+		t.Fatalf("expected a single cc-breaking-change-footer issue, got %+v", issues)
+	}
+}
+
+func TestParseConventionalCommit_BreakingMarkerWithoutFooter(t *testing.T) {
+	cfg := &config.WorkflowConfig{}
+
+	_, breaking := parseConventionalCommit("feat!: drop support for v1 tokens", cfg, "abc12345")
+	if len(breaking) != 1 || breaking[0] != "drop support for v1 tokens" {
+		t.Errorf("expected the header description to be used as the breaking change, got %v", breaking)
+	}
+}
+
+func TestParseConventionalCommit_RequireSignoff(t *testing.T) {
+	cfg := &config.WorkflowConfig{RequireSignoff: true}
+
+	issues, _ := parseConventionalCommit("feat: add login flow", cfg, "abc12345")
+	if len(issues) != 1 || issues[0].Rule != "cc-missing-signoff" {
+		t.Fatalf("expected a single cc-missing-signoff issue, got %+v", issues)
+	}
+
+	issues, _ = parseConventionalCommit("feat: add login flow\n\nSigned-off-by: Jane Doe <jane@example.com>", cfg, "abc12345")
+	if len(issues) != 0 {
+		t.Errorf("expected no issues once signed off, got %+v", issues)
+	}
+}
+
+func TestParseConventionalCommit_NonConventionalHeader(t *testing.T) {
+	cfg := &config.WorkflowConfig{}
+
+	issues, breaking := parseConventionalCommit("updated some stuff", cfg, "abc12345")
+	if len(issues) != 1 || issues[0].Rule != "conventional-commits" {
+		t.Fatalf("expected a single conventional-commits issue, got %+v", issues)
+	}
+	if len(breaking) != 0 {
+		t.Errorf("expected no breaking changes for an unparseable header, got %v", breaking)
+	}
+}
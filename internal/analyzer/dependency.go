@@ -1,26 +1,47 @@
 package analyzer
 
 import (
-	"bufio"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+
 	"github.com/githealthchecker/git-health-checker/internal/config"
 	"github.com/githealthchecker/git-health-checker/internal/report"
 )
 
 const goMod = "go.mod"
+const goWork = "go.work"
+const goSum = "go.sum"
 const packageJson = "package.json"
-const failedToCloseGoModError = "failed to close " + goMod + " file: %v\n"
+const packageLockJson = "package-lock.json"
+const yarnLock = "yarn.lock"
+const pnpmLockYaml = "pnpm-lock.yaml"
+const requirementsTxt = "requirements.txt"
+const poetryLock = "poetry.lock"
+const cargoLock = "Cargo.lock"
 
 type DependencyAnalyzer struct {
 	config   *config.DependencyConfig
 	repoPath string
+	// workspaceLocalReplace lists dependency paths a go.work workspace
+	// replaces with a local sibling module, set by analyzeGoWorkspace on the
+	// per-member analyzers it constructs so shouldSkipPackage can treat them
+	// the same way a go.mod-level replace already does.
+	workspaceLocalReplace map[string]bool
 }
 
 type GoModInfo struct {
@@ -32,15 +53,19 @@ type GoModInfo struct {
 		Version string `json:"Version"`
 		Time    string `json:"Time"`
 	} `json:"Update"`
+	// Retracted carries the rationale strings from the required version's
+	// own go.mod retract directives, populated by `go list -u -m -json`
+	// when the resolved version has been retracted by its module author.
+	Retracted []string `json:"Retracted"`
 }
 
 type PackageInfo struct {
-	Name             string
-	CurrentVersion   string
-	LatestVersion    string
-	DaysOld          int
-	HasVulnerability bool
-	IsBlocked        bool
+	Name              string
+	CurrentVersion    string
+	LatestVersion     string
+	DaysOld           int
+	IsBlocked         bool
+	RetractedComments []string
 }
 
 func NewDependencyAnalyzer(cfg *config.DependencyConfig, repoPath string) *DependencyAnalyzer {
@@ -67,6 +92,48 @@ func (a *DependencyAnalyzer) Analyze() ([]report.Issue, error) {
 			return nil, fmt.Errorf("failed to analyze unused Go modules: %w", err)
 		}
 		issues = append(issues, unusedIssues...)
+
+		// Surface local replace directives, which only resolve for whoever
+		// has that sibling path checked out
+		replaceIssues, err := a.analyzeGoModReplaces()
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze go.mod replace directives: %w", err)
+		}
+		issues = append(issues, replaceIssues...)
+	}
+
+	// Check for a Go workspace (go.work), a monorepo of several go.mod
+	// member modules sharing one root
+	if a.hasGoWork() {
+		workspaceIssues, err := a.analyzeGoWorkspace()
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze go.work workspace: %w", err)
+		}
+		issues = append(issues, workspaceIssues...)
+	}
+
+	if a.config.CheckVulnerabilities {
+		vulnIssues, err := a.analyzeVulnerabilities()
+		var lookupErr *vulnerabilityLookupError
+		switch {
+		case errors.As(err, &lookupErr):
+			// The backend itself is unreachable (rate-limited, offline,
+			// timed out, ...); degrade to the blocklist-only issues the
+			// other steps in Analyze already produce rather than losing
+			// the whole dependency report over it.
+		case err != nil:
+			return nil, fmt.Errorf("failed to check for known vulnerabilities: %w", err)
+		default:
+			issues = append(issues, vulnIssues...)
+		}
+	}
+
+	if a.config.CheckLockfileDrift {
+		lockIssues, err := a.analyzeLockfiles()
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze lockfiles: %w", err)
+		}
+		issues = append(issues, lockIssues...)
 	}
 
 	// Check for Node.js dependencies
@@ -78,19 +145,154 @@ func (a *DependencyAnalyzer) Analyze() ([]report.Issue, error) {
 		issues = append(issues, nodeIssues...)
 	}
 
+	// Check every other registered ecosystem (Python, Ruby, Rust, Java, PHP,
+	// and any an external package has registered) for blocked packages.
+	ecosystemIssues, err := a.analyzeEcosystemBlockedPackages()
+	if err != nil {
+		return nil, fmt.Errorf("failed to analyze ecosystem dependencies: %w", err)
+	}
+	issues = append(issues, ecosystemIssues...)
+
 	return issues, nil
 }
 
+// AnalyzeCtx is Analyze with cancellation, checked between each of its
+// phases (Go modules, workspace, vulnerability lookups, lockfile drift,
+// Node.js, other ecosystems) so a fix/check run against a large monorepo
+// can be interrupted between phases rather than only once the whole
+// dependency analysis has finished.
+func (a *DependencyAnalyzer) AnalyzeCtx(ctx context.Context) ([]report.Issue, error) {
+	var issues []report.Issue
+
+	if a.hasGoMod() {
+		goIssues, err := a.analyzeGoModules()
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze Go modules: %w", err)
+		}
+		issues = append(issues, goIssues...)
+
+		unusedIssues, err := a.analyzeUnusedGoModules()
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze unused Go modules: %w", err)
+		}
+		issues = append(issues, unusedIssues...)
+
+		replaceIssues, err := a.analyzeGoModReplaces()
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze go.mod replace directives: %w", err)
+		}
+		issues = append(issues, replaceIssues...)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return issues, err
+	}
+
+	if a.hasGoWork() {
+		workspaceIssues, err := a.analyzeGoWorkspace()
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze go.work workspace: %w", err)
+		}
+		issues = append(issues, workspaceIssues...)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return issues, err
+	}
+
+	if a.config.CheckVulnerabilities {
+		vulnIssues, err := a.analyzeVulnerabilities()
+		var lookupErr *vulnerabilityLookupError
+		switch {
+		case errors.As(err, &lookupErr):
+			// The backend itself is unreachable (rate-limited, offline,
+			// timed out, ...); degrade to the blocklist-only issues the
+			// other steps in AnalyzeCtx already produce rather than losing
+			// the whole dependency report over it.
+		case err != nil:
+			return nil, fmt.Errorf("failed to check for known vulnerabilities: %w", err)
+		default:
+			issues = append(issues, vulnIssues...)
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return issues, err
+	}
+
+	if a.config.CheckLockfileDrift {
+		lockIssues, err := a.analyzeLockfiles()
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze lockfiles: %w", err)
+		}
+		issues = append(issues, lockIssues...)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return issues, err
+	}
+
+	if a.hasPackageJson() {
+		nodeIssues, err := a.analyzeNodeModules()
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze Node modules: %w", err)
+		}
+		issues = append(issues, nodeIssues...)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return issues, err
+	}
+
+	ecosystemIssues, err := a.analyzeEcosystemBlockedPackages()
+	if err != nil {
+		return nil, fmt.Errorf("failed to analyze ecosystem dependencies: %w", err)
+	}
+	issues = append(issues, ecosystemIssues...)
+
+	return issues, ctx.Err()
+}
+
 func (a *DependencyAnalyzer) hasGoMod() bool {
 	_, err := os.Stat(filepath.Join(a.repoPath, goMod))
 	return err == nil
 }
 
+func (a *DependencyAnalyzer) hasGoWork() bool {
+	_, err := os.Stat(filepath.Join(a.repoPath, goWork))
+	return err == nil
+}
+
 func (a *DependencyAnalyzer) hasPackageJson() bool {
 	_, err := os.Stat(filepath.Join(a.repoPath, packageJson))
 	return err == nil
 }
 
+func (a *DependencyAnalyzer) hasPackageLockJson() bool {
+	_, err := os.Stat(filepath.Join(a.repoPath, packageLockJson))
+	return err == nil
+}
+
+func (a *DependencyAnalyzer) hasRequirementsTxt() bool {
+	_, err := os.Stat(filepath.Join(a.repoPath, requirementsTxt))
+	return err == nil
+}
+
+func (a *DependencyAnalyzer) hasGoSum() bool {
+	_, err := os.Stat(filepath.Join(a.repoPath, goSum))
+	return err == nil
+}
+
+func (a *DependencyAnalyzer) hasYarnLock() bool {
+	_, err := os.Stat(filepath.Join(a.repoPath, yarnLock))
+	return err == nil
+}
+
+func (a *DependencyAnalyzer) hasPnpmLockYaml() bool {
+	_, err := os.Stat(filepath.Join(a.repoPath, pnpmLockYaml))
+	return err == nil
+}
+
 func (a *DependencyAnalyzer) analyzeGoModules() ([]report.Issue, error) {
 	if !a.config.CheckOutdated {
 		return []report.Issue{}, nil
@@ -137,6 +339,10 @@ func (a *DependencyAnalyzer) processGoModulePackages(packages []PackageInfo) []r
 		if issue := a.checkOutdatedGoPackage(pkg); issue != nil {
 			issues = append(issues, *issue)
 		}
+
+		if issue := a.checkRetractedGoPackage(pkg); issue != nil {
+			issues = append(issues, *issue)
+		}
 	}
 
 	return issues
@@ -148,6 +354,13 @@ func (a *DependencyAnalyzer) shouldSkipPackage(pkg PackageInfo, moduleName strin
 		return true
 	}
 
+	// Skip a dependency the enclosing go.work replaces with a local sibling
+	// module: the workspace is deliberately developing against that local
+	// copy, so "latest version" data for the published path is meaningless.
+	if a.workspaceLocalReplace[pkg.Name] {
+		return true
+	}
+
 	// Skip indirect/transitive dependencies for outdated checks
 	// Only check direct dependencies that users can control
 	return a.isTransitiveDependency(pkg.Name)
@@ -186,49 +399,230 @@ func (a *DependencyAnalyzer) checkOutdatedGoPackage(pkg PackageInfo) *report.Iss
 		File:        goMod,
 		Rule:        "outdated-dependencies",
 		Fix:         fmt.Sprintf("Update to latest version: go get %s@%s", pkg.Name, pkg.LatestVersion),
+		Remediation: &report.Remediation{
+			Type:        "go-get",
+			Package:     pkg.Name,
+			FromVersion: pkg.CurrentVersion,
+			ToVersion:   pkg.LatestVersion,
+		},
+		SuggestedFixes: []report.SuggestedFix{{
+			Title:   fmt.Sprintf("Update %s to %s", pkg.Name, pkg.LatestVersion),
+			Command: fmt.Sprintf("go get %s@%s", pkg.Name, pkg.LatestVersion),
+		}},
+		CreatedAt: time.Now(),
+	}
+}
+
+// checkRetractedGoPackage reports a dependency whose resolved version has
+// been retracted by its own module author (surfaced by `go list -u -m
+// -json`'s Retracted field), which go.mod's own Retract directives can't
+// detect on their own since those only describe this module's versions,
+// not a dependency's.
+func (a *DependencyAnalyzer) checkRetractedGoPackage(pkg PackageInfo) *report.Issue {
+	if len(pkg.RetractedComments) == 0 {
+		return nil
+	}
+
+	return &report.Issue{
+		ID:          fmt.Sprintf("retracted-dependency-%s", strings.ReplaceAll(pkg.Name, "/", "-")),
+		Title:       "Retracted dependency version",
+		Description: fmt.Sprintf("%s@%s has been retracted by its module author: %s", pkg.Name, pkg.CurrentVersion, strings.Join(pkg.RetractedComments, "; ")),
+		Category:    report.CategoryDependencies,
+		Severity:    report.SeverityMedium,
+		File:        goMod,
+		Rule:        "retracted-dependencies",
+		Fix:         fmt.Sprintf("Update %s away from the retracted version: go get %s", pkg.Name, pkg.Name),
 		CreatedAt:   time.Now(),
 	}
 }
 
-func (a *DependencyAnalyzer) analyzeNodeModules() ([]report.Issue, error) {
+// analyzeGoModReplaces surfaces each go.mod replace directive that points
+// at a local filesystem path (e.g. "=> ../foo") rather than another
+// module version, since a local replace only resolves on machines that
+// have that sibling directory checked out and silently diverges from
+// go.sum otherwise.
+func (a *DependencyAnalyzer) analyzeGoModReplaces() ([]report.Issue, error) {
+	modFile, err := a.parseGoMod()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse go.mod: %w", err)
+	}
+
 	var issues []report.Issue
+	for _, r := range modFile.Replace {
+		if r.New.Version != "" {
+			continue // replaced with another module version, not a local path
+		}
 
-	// Simple check for known vulnerable packages in package.json
-	packageJsonPath := filepath.Join(a.repoPath, packageJson)
-	file, err := os.Open(packageJsonPath)
+		issues = append(issues, report.Issue{
+			ID:          fmt.Sprintf("local-replace-%s", strings.ReplaceAll(r.Old.Path, "/", "-")),
+			Title:       "Local replace directive in go.mod",
+			Description: fmt.Sprintf("%s is replaced with the local path %s, which only resolves on machines that have it checked out", r.Old.Path, r.New.Path),
+			Category:    report.CategoryDependencies,
+			Severity:    report.SeverityMedium,
+			File:        goMod,
+			Rule:        "local-replace-directive",
+			Fix:         fmt.Sprintf("Replace the local path with a published version of %s before merging, or document why the fork is required", r.Old.Path),
+			CreatedAt:   time.Now(),
+		})
+	}
+
+	return issues, nil
+}
+
+// parseGoWork reads and parses the repository's go.work with
+// golang.org/x/mod/modfile, giving structured access to its Use and Replace
+// directives.
+func (a *DependencyAnalyzer) parseGoWork() (*modfile.WorkFile, error) {
+	goWorkPath := filepath.Join(a.repoPath, goWork)
+	data, err := os.ReadFile(goWorkPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read go.work: %w", err)
+	}
+
+	workFile, err := modfile.ParseWork(goWorkPath, data, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open package.json: %w", err)
+		return nil, fmt.Errorf("failed to parse go.work: %w", err)
+	}
+
+	return workFile, nil
+}
+
+// analyzeGoWorkspace runs the outdated/unused/local-replace go.mod analyses
+// against every member module a go.work workspace's `use` directives name,
+// with each member's own cmd.Dir set to its module directory. Issue File
+// fields are rewritten relative to the workspace root (e.g. "go.mod" becomes
+// "services/api/go.mod") so a multi-module report stays unambiguous about
+// which member an issue belongs to. A workspace-level replace pointing a
+// dependency at a local path suppresses "outdated dependency" issues for
+// that path across every member, since the workspace is deliberately
+// developing against the local copy rather than a published version.
+func (a *DependencyAnalyzer) analyzeGoWorkspace() ([]report.Issue, error) {
+	workFile, err := a.parseGoWork()
+	if err != nil {
+		return nil, err
+	}
+
+	localReplace := make(map[string]bool, len(workFile.Replace))
+	for _, r := range workFile.Replace {
+		if r.New.Version == "" {
+			localReplace[r.Old.Path] = true
+		}
 	}
-	defer func(file *os.File) {
-		err := file.Close()
+
+	var issues []report.Issue
+	for _, use := range workFile.Use {
+		modulePath := filepath.Clean(use.Path)
+		memberAnalyzer := NewDependencyAnalyzer(a.config, filepath.Join(a.repoPath, modulePath))
+		memberAnalyzer.workspaceLocalReplace = localReplace
+
+		if !memberAnalyzer.hasGoMod() {
+			continue
+		}
+
+		memberIssues, err := memberAnalyzer.analyzeGoModules()
 		if err != nil {
-			fmt.Printf("failed to close package.json file: %v\n", err)
+			return nil, fmt.Errorf("failed to analyze go modules in workspace member %s: %w", modulePath, err)
 		}
-	}(file)
 
-	var packageData map[string]interface{}
-	if err := json.NewDecoder(file).Decode(&packageData); err != nil {
-		return nil, fmt.Errorf("failed to parse package.json: %w", err)
+		unusedIssues, err := memberAnalyzer.analyzeUnusedGoModules()
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze unused go modules in workspace member %s: %w", modulePath, err)
+		}
+		memberIssues = append(memberIssues, unusedIssues...)
+
+		replaceIssues, err := memberAnalyzer.analyzeGoModReplaces()
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze go.mod replace directives in workspace member %s: %w", modulePath, err)
+		}
+		memberIssues = append(memberIssues, replaceIssues...)
+
+		issues = append(issues, prefixIssueFiles(memberIssues, modulePath)...)
 	}
 
-	// Check dependencies
-	if deps, ok := packageData["dependencies"].(map[string]interface{}); ok {
+	return issues, nil
+}
+
+// prefixIssueFiles rewrites each issue's File field to be relative to the
+// workspace root rather than the member module it was found in, e.g. "go.mod"
+// under member "services/api" becomes "services/api/go.mod".
+func prefixIssueFiles(issues []report.Issue, modulePath string) []report.Issue {
+	for i := range issues {
+		if issues[i].File != "" {
+			issues[i].File = filepath.Join(modulePath, issues[i].File)
+		}
+	}
+	return issues
+}
+
+func (a *DependencyAnalyzer) analyzeNodeModules() ([]report.Issue, error) {
+	var issues []report.Issue
+
+	// Check package.json's direct, dev, peer, and optional dependencies
+	// against the blocklist.
+	manifest, err := a.parsePackageJsonManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	reportedBlocked := make(map[string]bool)
+	for _, deps := range []map[string]string{
+		manifest.Dependencies,
+		manifest.DevDependencies,
+		manifest.PeerDependencies,
+		manifest.OptionalDependencies,
+	} {
 		for pkg := range deps {
-			if a.isBlockedPackage(pkg) {
-				issue := report.Issue{
-					ID:          fmt.Sprintf("blocked-node-dependency-%s", strings.ReplaceAll(pkg, "/", "-")),
-					Title:       "Blocked Node.js dependency",
-					Description: fmt.Sprintf("Package %s is in the blocked list and should not be used", pkg),
-					Category:    report.CategorySecurity,
-					Severity:    report.SeverityHigh,
-					File:        packageJson,
-					Rule:        "blocked-dependencies",
-					Fix:         fmt.Sprintf("Remove %s from dependencies and find an alternative", pkg),
-					CreatedAt:   time.Now(),
-				}
-				issues = append(issues, issue)
+			if !a.isBlockedPackage(pkg) || reportedBlocked[pkg] {
+				continue
 			}
+			reportedBlocked[pkg] = true
+			issues = append(issues, report.Issue{
+				ID:          fmt.Sprintf("blocked-node-dependency-%s", strings.ReplaceAll(pkg, "/", "-")),
+				Title:       "Blocked Node.js dependency",
+				Description: fmt.Sprintf("Package %s is in the blocked list and should not be used", pkg),
+				Category:    report.CategorySecurity,
+				Severity:    report.SeverityHigh,
+				File:        packageJson,
+				Rule:        "blocked-dependencies",
+				Fix:         fmt.Sprintf("Remove %s from dependencies and find an alternative", pkg),
+				CreatedAt:   time.Now(),
+			})
+		}
+	}
+
+	// Also check the resolved lockfile graph, so a blocked package pulled in
+	// transitively (not named directly in package.json) is still caught.
+	lockFile, decoder := a.selectNpmLockfile()
+	if lockFile == "" {
+		return issues, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(a.repoPath, lockFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", lockFile, err)
+	}
+
+	locked, err := decoder.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dep := range locked {
+		if !a.isBlockedPackage(dep.Name) || reportedBlocked[dep.Name] {
+			continue
 		}
+		reportedBlocked[dep.Name] = true
+		issues = append(issues, report.Issue{
+			ID:          fmt.Sprintf("blocked-node-dependency-%s", strings.ReplaceAll(dep.Name, "/", "-")),
+			Title:       "Blocked Node.js dependency",
+			Description: fmt.Sprintf("Package %s is in the blocked list and should not be used (resolved transitively in %s)", dep.Name, lockFile),
+			Category:    report.CategorySecurity,
+			Severity:    report.SeverityHigh,
+			File:        lockFile,
+			Rule:        "blocked-dependencies",
+			Fix:         fmt.Sprintf("Remove %s from the dependency tree and find an alternative", dep.Name),
+			CreatedAt:   time.Now(),
+		})
 	}
 
 	return issues, nil
@@ -247,8 +641,9 @@ func (a *DependencyAnalyzer) parseGoModules(output []byte) ([]PackageInfo, error
 		}
 
 		pkg := PackageInfo{
-			Name:           currentModule.Path,
-			CurrentVersion: currentModule.Version,
+			Name:              currentModule.Path,
+			CurrentVersion:    currentModule.Version,
+			RetractedComments: currentModule.Retracted,
 		}
 
 		// Check if there's an update available
@@ -286,27 +681,30 @@ func (a *DependencyAnalyzer) calculateDaysOld(currentTime string) int {
 }
 
 func (a *DependencyAnalyzer) getModuleName() string {
+	modFile, err := a.parseGoMod()
+	if err != nil || modFile.Module == nil {
+		return ""
+	}
+
+	return modFile.Module.Mod.Path
+}
+
+// parseGoMod reads and parses the repository's go.mod with golang.org/x/mod/modfile,
+// giving structured access to Require, Replace, Exclude and Retract instead of the
+// line-oriented scanning the analyzer used to rely on.
+func (a *DependencyAnalyzer) parseGoMod() (*modfile.File, error) {
 	goModPath := filepath.Join(a.repoPath, goMod)
-	file, err := os.Open(goModPath)
+	data, err := os.ReadFile(goModPath)
 	if err != nil {
-		return ""
+		return nil, fmt.Errorf("failed to read go.mod: %w", err)
 	}
-	defer func(file *os.File) {
-		err := file.Close()
-		if err != nil {
-			fmt.Printf(failedToCloseGoModError, err)
-		}
-	}(file)
 
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if strings.HasPrefix(line, "module ") {
-			return strings.TrimPrefix(line, "module ")
-		}
+	modFile, err := modfile.Parse(goModPath, data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse go.mod: %w", err)
 	}
 
-	return ""
+	return modFile, nil
 }
 
 func (a *DependencyAnalyzer) isBlockedPackage(name string) bool {
@@ -329,24 +727,35 @@ func (a *DependencyAnalyzer) determineOutdatedSeverity(daysOld int) report.Sever
 	}
 }
 
+// analyzeUnusedGoModules flags direct, non-replaced requires that no Go file in the
+// repository imports. Indirect requires are left to `go mod tidy`, and a require with
+// a matching replace directive is skipped: the replacement (a local fork or path) may
+// not share the original import path, so treating it as unused would be a false positive.
 func (a *DependencyAnalyzer) analyzeUnusedGoModules() ([]report.Issue, error) {
 	var issues []report.Issue
 
-	// Get all dependencies from go.mod
-	dependencies, err := a.getGoModDependencies()
+	modFile, err := a.parseGoMod()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get go.mod dependencies: %w", err)
+		return nil, fmt.Errorf("failed to parse go.mod: %w", err)
 	}
 
-	// Get all imports from Go source files
 	imports, err := a.getGoImports()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get Go imports: %w", err)
 	}
 
-	// Find unused dependencies
-	for _, dep := range dependencies {
-		if !a.isImportUsed(dep, imports) && !a.isStandardLibrary(dep) && !a.isIndirectDependency(dep) {
+	replaced := make(map[string]bool, len(modFile.Replace))
+	for _, r := range modFile.Replace {
+		replaced[r.Old.Path] = true
+	}
+
+	for _, req := range modFile.Require {
+		dep := a.extractDependencyName(req)
+		if req.Indirect || a.isStandardLibrary(dep) || replaced[dep] {
+			continue
+		}
+
+		if !a.isImportUsed(dep, imports) {
 			issue := report.Issue{
 				ID:          fmt.Sprintf("unused-dependency-%s", strings.ReplaceAll(dep, "/", "-")),
 				Title:       "Unused dependency",
@@ -356,7 +765,15 @@ func (a *DependencyAnalyzer) analyzeUnusedGoModules() ([]report.Issue, error) {
 				File:        goMod,
 				Rule:        "unused-dependencies",
 				Fix:         fmt.Sprintf("Remove %s from go.mod with: go mod tidy", dep),
-				CreatedAt:   time.Now(),
+				Remediation: &report.Remediation{
+					Type:    "go-mod-tidy",
+					Package: dep,
+				},
+				SuggestedFixes: []report.SuggestedFix{{
+					Title:   fmt.Sprintf("Remove %s with go mod tidy", dep),
+					Command: "go mod tidy",
+				}},
+				CreatedAt: time.Now(),
 			}
 			issues = append(issues, issue)
 		}
@@ -365,94 +782,28 @@ func (a *DependencyAnalyzer) analyzeUnusedGoModules() ([]report.Issue, error) {
 	return issues, nil
 }
 
+// getGoModDependencies returns every module go.mod requires, direct and indirect.
 func (a *DependencyAnalyzer) getGoModDependencies() ([]string, error) {
-	goModPath := filepath.Join(a.repoPath, goMod)
-	file, err := os.Open(goModPath)
+	modFile, err := a.parseGoMod()
 	if err != nil {
-		return nil, fmt.Errorf("failed to open go.mod: %w", err)
-	}
-	defer func(file *os.File) {
-		err := file.Close()
-		if err != nil {
-			fmt.Printf(failedToCloseGoModError, err)
-		}
-	}(file)
-
-	scanner := bufio.NewScanner(file)
-	parser := &goModParser{}
-
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		parser.parseLine(line, a)
-	}
-
-	return parser.dependencies, scanner.Err()
-}
-
-type goModParser struct {
-	dependencies   []string
-	inRequireBlock bool
-}
-
-func (p *goModParser) parseLine(line string, analyzer *DependencyAnalyzer) {
-	if strings.HasPrefix(line, "require") {
-		p.handleRequireLine(line, analyzer)
-		return
-	}
-
-	if p.inRequireBlock {
-		p.handleRequireBlockLine(line, analyzer)
-	}
-}
-
-func (p *goModParser) handleRequireLine(line string, analyzer *DependencyAnalyzer) {
-	if strings.Contains(line, "(") {
-		p.inRequireBlock = true
-		// Handle single line require if it contains a package name
-		if !strings.HasSuffix(line, "(") {
-			p.addDependencyFromLine(strings.TrimPrefix(line, "require"), analyzer)
-		}
-	} else {
-		// Single line require
-		p.addDependencyFromLine(strings.TrimPrefix(line, "require"), analyzer)
+		return nil, err
 	}
-}
 
-func (p *goModParser) handleRequireBlockLine(line string, analyzer *DependencyAnalyzer) {
-	if strings.Contains(line, ")") {
-		p.inRequireBlock = false
-		return
+	dependencies := make([]string, 0, len(modFile.Require))
+	for _, req := range modFile.Require {
+		dependencies = append(dependencies, a.extractDependencyName(req))
 	}
-	p.addDependencyFromLine(line, analyzer)
-}
 
-func (p *goModParser) addDependencyFromLine(line string, analyzer *DependencyAnalyzer) {
-	dep := analyzer.extractDependencyName(line)
-	if dep != "" {
-		p.dependencies = append(p.dependencies, dep)
-	}
+	return dependencies, nil
 }
 
-func (a *DependencyAnalyzer) extractDependencyName(line string) string {
-	line = strings.TrimSpace(line)
-	if line == "" || strings.HasPrefix(line, "//") {
-		return ""
-	}
-
-	// Remove trailing comments and version info
-	parts := strings.Fields(line)
-	if len(parts) == 0 {
-		return ""
-	}
-
-	depName := parts[0]
-
-	// Remove parentheses if present
-	depName = strings.Trim(depName, "()")
-
-	return depName
+func (a *DependencyAnalyzer) extractDependencyName(req *modfile.Require) string {
+	return req.Mod.Path
 }
 
+// getGoImports walks every Go source file in the repository and collects the set of
+// import paths actually in use, honoring build constraints so files excluded by
+// //go:build tags for the current platform don't contribute false "in use" signals.
 func (a *DependencyAnalyzer) getGoImports() (map[string]bool, error) {
 	imports := make(map[string]bool)
 
@@ -482,7 +833,17 @@ func (a *DependencyAnalyzer) shouldProcessFile(path string) bool {
 		return false
 	}
 
-	return true
+	dir, name := filepath.Split(path)
+	ctx := build.Default
+	if len(a.config.BuildTags) > 0 {
+		ctx.BuildTags = a.config.BuildTags
+	}
+	matches, err := ctx.MatchFile(dir, name)
+	if err != nil {
+		return false
+	}
+
+	return matches
 }
 
 func (a *DependencyAnalyzer) mergeImports(allImports map[string]bool, fileImports map[string]bool) {
@@ -491,108 +852,34 @@ func (a *DependencyAnalyzer) mergeImports(allImports map[string]bool, fileImport
 	}
 }
 
+// extractImportsFromFile parses a Go source file with go/parser and returns the set
+// of import paths it declares. Using the real parser (rather than line scanning) gets
+// multi-line import blocks, dot imports, blank imports, and "C" imports right for free,
+// since they're all just *ast.ImportSpec nodes with a path.
 func (a *DependencyAnalyzer) extractImportsFromFile(filePath string) (map[string]bool, error) {
-	file, err := os.Open(filePath)
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filePath, nil, parser.ImportsOnly)
 	if err != nil {
 		return nil, err
 	}
-	defer func(file *os.File) {
-		err := file.Close()
-		if err != nil {
-			fmt.Printf("failed to close file %s: %v\n", filePath, err)
-		}
-	}(file)
-
-	scanner := bufio.NewScanner(file)
-	parser := &importParser{imports: make(map[string]bool)}
-
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
 
-		if parser.shouldStopParsing(line) {
-			break
+	imports := make(map[string]bool, len(file.Imports))
+	for _, spec := range file.Imports {
+		if imp := a.extractImportPath(spec); imp != "" {
+			imports[imp] = true
 		}
-
-		parser.parseLine(line, a)
 	}
 
-	return parser.imports, scanner.Err()
-}
-
-type importParser struct {
-	imports       map[string]bool
-	inImportBlock bool
-}
-
-func (p *importParser) shouldStopParsing(line string) bool {
-	return !p.inImportBlock &&
-		!strings.HasPrefix(line, "package") &&
-		!strings.HasPrefix(line, "import") &&
-		line != "" &&
-		!strings.HasPrefix(line, "//")
+	return imports, nil
 }
 
-func (p *importParser) parseLine(line string, analyzer *DependencyAnalyzer) {
-	if strings.HasPrefix(line, "import") {
-		p.handleImportLine(line, analyzer)
-		return
-	}
-
-	if p.inImportBlock {
-		p.handleImportBlockLine(line, analyzer)
-	}
-}
-
-func (p *importParser) handleImportLine(line string, analyzer *DependencyAnalyzer) {
-	if strings.Contains(line, "(") {
-		p.inImportBlock = true
-		// Handle single line import if it contains a package name
-		if !strings.HasSuffix(line, "(") {
-			p.addImportFromLine(strings.TrimPrefix(line, "import"), analyzer)
-		}
-	} else {
-		// Single-line import
-		p.addImportFromLine(strings.TrimPrefix(line, "import"), analyzer)
-	}
-}
-
-func (p *importParser) handleImportBlockLine(line string, analyzer *DependencyAnalyzer) {
-	if strings.Contains(line, ")") {
-		p.inImportBlock = false
-		return
-	}
-	p.addImportFromLine(line, analyzer)
-}
-
-func (p *importParser) addImportFromLine(line string, analyzer *DependencyAnalyzer) {
-	imp := analyzer.extractImportPath(line)
-	if imp != "" {
-		p.imports[imp] = true
-	}
-}
-
-func (a *DependencyAnalyzer) extractImportPath(line string) string {
-	line = strings.TrimSpace(line)
-	if line == "" || strings.HasPrefix(line, "//") {
+func (a *DependencyAnalyzer) extractImportPath(spec *ast.ImportSpec) string {
+	path, err := strconv.Unquote(spec.Path.Value)
+	if err != nil {
 		return ""
 	}
 
-	// Remove alias if present (e.g., "alias 'path'" or "_ 'path'")
-	parts := strings.Fields(line)
-	var importPath string
-
-	if len(parts) >= 2 {
-		// Has alias, take the last part
-		importPath = parts[len(parts)-1]
-	} else if len(parts) == 1 {
-		// No alias
-		importPath = parts[0]
-	}
-
-	// Remove quotes
-	importPath = strings.Trim(importPath, `"'`)
-
-	return importPath
+	return path
 }
 
 func (a *DependencyAnalyzer) isImportUsed(dependency string, imports map[string]bool) bool {
@@ -628,128 +915,710 @@ func (a *DependencyAnalyzer) isStandardLibrary(pkg string) bool {
 }
 
 func (a *DependencyAnalyzer) isIndirectDependency(pkg string) bool {
-	// Check if the dependency is marked as '// indirect' in go.mod
-	goModPath := filepath.Join(a.repoPath, goMod)
-	file, err := os.Open(goModPath)
+	modFile, err := a.parseGoMod()
 	if err != nil {
 		return false
 	}
-	defer func(file *os.File) {
-		err := file.Close()
-		if err != nil {
-			fmt.Printf(failedToCloseGoModError, err)
-		}
-	}(file)
-
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
 
-		// Look for lines that contain the package and // indirect comment
-		if strings.Contains(line, pkg) && strings.Contains(line, "// indirect") {
-			return true
+	for _, req := range modFile.Require {
+		if req.Mod.Path == pkg {
+			return req.Indirect
 		}
 	}
 
 	return false
 }
 
+// isTransitiveDependency reports whether pkg is only pulled in indirectly -
+// i.e. go.mod's require directive for it carries the "// indirect" marker -
+// rather than being a direct dependency of this module. This is the same
+// check isIndirectDependency makes; a package absent from go.mod entirely
+// (neither direct nor indirect) is also reported as transitive, since it
+// can only have reached the build through some other module's requirement.
 func (a *DependencyAnalyzer) isTransitiveDependency(pkg string) bool {
-	// Check if this package is a direct dependency in go.mod
-	// If it's not in the main require block, it's a transitive dependency
-	directDeps, err := a.getDirectDependencies()
+	modFile, err := a.parseGoMod()
 	if err != nil {
 		return false
 	}
 
-	for _, directDep := range directDeps {
-		if directDep == pkg {
-			return false // It's a direct dependency
+	for _, req := range modFile.Require {
+		if req.Mod.Path == pkg {
+			return req.Indirect
 		}
 	}
 
-	return true // It's a transitive dependency
+	return true
+}
+
+// vulnerabilityLookupError wraps a failure to reach the configured
+// vulnerability backend (as opposed to a local parsing or configuration
+// error), so Analyze can tell the two apart and degrade to blocklist-only
+// mode instead of aborting the whole dependency analysis over what's often a
+// transient network problem.
+type vulnerabilityLookupError struct {
+	err error
+}
+
+func (e *vulnerabilityLookupError) Error() string {
+	return fmt.Sprintf("vulnerability lookup failed: %v", e.err)
 }
 
-func (a *DependencyAnalyzer) getDirectDependencies() ([]string, error) {
-	file, err := a.openGoModFile()
+func (e *vulnerabilityLookupError) Unwrap() error {
+	return e.err
+}
+
+// analyzeVulnerabilities gathers dependency versions from every manifest the
+// repository has (go.mod, package-lock.json, requirements.txt) and checks
+// them against the configured vulnerability backend (OSV by default, GitHub
+// Advisory, or an offline local snapshot - see vulnerabilityBackend),
+// optionally through an on-disk TTL cache, mapping matched advisories into
+// report.Issue. When config.OSVSymbolFiltering is enabled, an advisory is
+// only reported if at least one of its affected symbols actually appears in
+// the repository's Go source, cutting down on vulnerabilities in code paths
+// the repo never calls.
+func (a *DependencyAnalyzer) analyzeVulnerabilities() ([]report.Issue, error) {
+	modulesByFile, err := a.collectVulnerabilityModules()
 	if err != nil {
 		return nil, err
 	}
-	defer a.closeGoModFile(file)
+	if len(modulesByFile) == 0 {
+		return nil, nil
+	}
+
+	var allModules []osvModule
+	for _, modules := range modulesByFile {
+		allModules = append(allModules, modules...)
+	}
+
+	var sourceText string
+	if a.config.OSVSymbolFiltering {
+		sourceText, err = a.concatenateGoSource()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	backend, err := a.vulnerabilityBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := backend.QueryBatch(allModules)
+	if err != nil {
+		return nil, &vulnerabilityLookupError{err: err}
+	}
+
+	var issues []report.Issue
+	for file, modules := range modulesByFile {
+		for _, mod := range modules {
+			for _, record := range matches[mod] {
+				if a.config.OSVSymbolFiltering && !symbolsReachable(record, sourceText) {
+					continue
+				}
+				issues = append(issues, osvIssue(mod, record, file))
+			}
+		}
+	}
 
-	return a.parseDirectDependencies(file)
+	return issues, nil
 }
 
-func (a *DependencyAnalyzer) openGoModFile() (*os.File, error) {
-	goModPath := filepath.Join(a.repoPath, goMod)
-	file, err := os.Open(goModPath)
+// collectVulnerabilityModules reads every dependency manifest present in
+// the repository, keyed by the manifest's filename so matched issues can
+// report which file declares the vulnerable dependency.
+func (a *DependencyAnalyzer) collectVulnerabilityModules() (map[string][]osvModule, error) {
+	modulesByFile := make(map[string][]osvModule)
+
+	if a.hasGoMod() {
+		modules, err := a.getGoModuleVersions()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read go.mod dependencies: %w", err)
+		}
+		if len(modules) > 0 {
+			modulesByFile[goMod] = modules
+		}
+	}
+
+	if a.hasPackageLockJson() {
+		modules, err := a.getNpmModuleVersions()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read package-lock.json dependencies: %w", err)
+		}
+		if len(modules) > 0 {
+			modulesByFile[packageLockJson] = modules
+		}
+	}
+
+	if a.hasRequirementsTxt() {
+		modules, err := a.getPipModuleVersions()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read requirements.txt dependencies: %w", err)
+		}
+		if len(modules) > 0 {
+			modulesByFile[requirementsTxt] = modules
+		}
+	}
+
+	return modulesByFile, nil
+}
+
+// vulnerabilityBackend builds the backend analyzeVulnerabilities queries,
+// selected by config.VulnerabilitySource ("osv" by default, "github", or
+// "local"), wrapped in an on-disk TTL cache when config.VulnerabilityCacheTTL
+// is set.
+func (a *DependencyAnalyzer) vulnerabilityBackend() (vulnerabilityBackend, error) {
+	var backend vulnerabilityBackend
+
+	switch a.config.VulnerabilitySource {
+	case "github":
+		token := a.config.GitHubToken
+		if token == "" {
+			token = os.Getenv("GITHUB_TOKEN")
+		}
+		backend = newGitHubAdvisoryBackend(token)
+	case "local":
+		cacheDir, err := a.vulnerabilityCacheDir()
+		if err != nil {
+			return nil, err
+		}
+		return newLocalDBBackend(cacheDir), nil
+	case "", "osv":
+		backend = newOSVBackend(a.config.OSVDatabasePath)
+	default:
+		return nil, fmt.Errorf("unknown dependencies.vulnerability_source %q", a.config.VulnerabilitySource)
+	}
+
+	if a.config.VulnerabilityCacheTTL > 0 {
+		cacheDir, err := a.vulnerabilityCacheDir()
+		if err != nil {
+			return nil, err
+		}
+		backend = newCachedVulnBackend(backend, cacheDir, a.config.VulnerabilityCacheTTL)
+	}
+
+	return backend, nil
+}
+
+func (a *DependencyAnalyzer) vulnerabilityCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
 	if err != nil {
-		return nil, fmt.Errorf("failed to open go.mod: %w", err)
+		return "", fmt.Errorf("failed to resolve cache directory: %w", err)
 	}
-	return file, nil
+	return filepath.Join(dir, "githealthchecker", "vulnerabilities"), nil
 }
 
-func (a *DependencyAnalyzer) closeGoModFile(file *os.File) {
-	err := file.Close()
+// getGoModuleVersions returns every module go.mod requires (direct and
+// transitive) as an osvModule.
+func (a *DependencyAnalyzer) getGoModuleVersions() ([]osvModule, error) {
+	modFile, err := a.parseGoMod()
 	if err != nil {
-		fmt.Printf(failedToCloseGoModError, err)
+		if os.IsNotExist(errors.Unwrap(err)) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	modules := make([]osvModule, 0, len(modFile.Require))
+	for _, req := range modFile.Require {
+		if req.Mod.Path == "" || !strings.Contains(req.Mod.Path, ".") {
+			continue
+		}
+		modules = append(modules, osvModule{Ecosystem: "Go", Name: req.Mod.Path, Version: req.Mod.Version})
 	}
+
+	return modules, nil
 }
 
-func (a *DependencyAnalyzer) parseDirectDependencies(file *os.File) ([]string, error) {
-	var directDeps []string
-	scanner := bufio.NewScanner(file)
+// getNpmModuleVersions parses package-lock.json's "packages" map (lockfile
+// v2/v3 format) into osvModule tuples for vulnerability lookups.
+func (a *DependencyAnalyzer) getNpmModuleVersions() ([]osvModule, error) {
+	data, err := os.ReadFile(filepath.Join(a.repoPath, packageLockJson))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read package-lock.json: %w", err)
+	}
 
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
+	var lockfile struct {
+		Packages map[string]struct {
+			Version string `json:"version"`
+		} `json:"packages"`
+	}
+	if err := json.Unmarshal(data, &lockfile); err != nil {
+		return nil, fmt.Errorf("failed to parse package-lock.json: %w", err)
+	}
 
-		if a.isStartOfMainRequireBlock(line) {
-			deps, err := a.extractDependenciesFromRequireBlock(scanner)
-			if err != nil {
-				return nil, err
-			}
-			directDeps = append(directDeps, deps...)
-			break
+	modules := make([]osvModule, 0, len(lockfile.Packages))
+	for path, pkg := range lockfile.Packages {
+		name := strings.TrimPrefix(path, "node_modules/")
+		if name == "" || pkg.Version == "" {
+			continue
+		}
+		modules = append(modules, osvModule{Ecosystem: "npm", Name: name, Version: pkg.Version})
+	}
+	return modules, nil
+}
+
+// getPipModuleVersions parses a requirements.txt file's pinned
+// "name==version" lines into osvModule tuples. Unpinned, editable (-e), and
+// option lines are skipped since they carry no resolvable version.
+func (a *DependencyAnalyzer) getPipModuleVersions() ([]osvModule, error) {
+	data, err := os.ReadFile(filepath.Join(a.repoPath, requirementsTxt))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read requirements.txt: %w", err)
+	}
+
+	var modules []osvModule
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "-") {
+			continue
+		}
+
+		idx := strings.Index(line, "==")
+		if idx == -1 {
+			continue
+		}
+
+		name := strings.TrimSpace(line[:idx])
+		version := strings.TrimSpace(line[idx+2:])
+		if name == "" || version == "" {
+			continue
+		}
+		modules = append(modules, osvModule{Ecosystem: "PyPI", Name: name, Version: version})
+	}
+	return modules, nil
+}
+
+// analyzeLockfiles cross-checks each manifest the repository has against its
+// lockfile: go.mod against go.sum, and package.json against whichever npm
+// lockfile is present (package-lock.json, yarn.lock, or pnpm-lock.yaml, in
+// that priority order). It flags a missing lockfile, any manifest entry the
+// lockfile doesn't resolve (drift), missing integrity hashes in
+// package-lock.json, and a go.sum hash that no longer matches what's in the
+// local module cache.
+//
+// Cargo.lock and poetry.lock have first-class decoders in lockfile.go for
+// future use (e.g. feeding exact versions to the vulnerability scanner), but
+// aren't cross-checked here: the analyzer doesn't parse Cargo.toml or
+// pyproject.toml, so there's no manifest to detect drift against yet.
+func (a *DependencyAnalyzer) analyzeLockfiles() ([]report.Issue, error) {
+	var issues []report.Issue
+
+	if a.hasGoMod() {
+		goIssues, err := a.analyzeGoSumDrift()
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze go.sum: %w", err)
+		}
+		issues = append(issues, goIssues...)
+	}
+
+	if a.hasPackageJson() {
+		npmIssues, err := a.analyzeNpmLockDrift()
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze npm lockfile: %w", err)
+		}
+		issues = append(issues, npmIssues...)
+	}
+
+	return issues, nil
+}
+
+// analyzeGoSumDrift flags a missing go.sum, any go.mod requirement with no
+// matching go.sum hash, and any go.sum hash that no longer matches what's
+// recorded in the local module cache.
+func (a *DependencyAnalyzer) analyzeGoSumDrift() ([]report.Issue, error) {
+	if !a.hasGoSum() {
+		return []report.Issue{{
+			ID:          "missing-lockfile-go-sum",
+			Title:       "Missing go.sum",
+			Description: "go.mod is present but go.sum is missing, so dependency versions aren't pinned to a verified hash",
+			Category:    report.CategoryDependencies,
+			Severity:    report.SeverityMedium,
+			File:        goMod,
+			Rule:        "missing-lockfile",
+			Fix:         "Run `go mod tidy` to generate go.sum",
+			CreatedAt:   time.Now(),
+		}}, nil
+	}
+
+	modFile, err := a.parseGoMod()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(a.repoPath, goSum))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read go.sum: %w", err)
+	}
+
+	locked, err := (goSumLockfile{}).Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	lockedVersions := make(map[string]bool, len(locked))
+	for _, dep := range locked {
+		lockedVersions[dep.Name+"@"+dep.Version] = true
+	}
+
+	var issues []report.Issue
+	for _, req := range modFile.Require {
+		if lockedVersions[req.Mod.Path+"@"+req.Mod.Version] {
+			continue
+		}
+		issues = append(issues, report.Issue{
+			ID:          fmt.Sprintf("lockfile-drift-%s", strings.ReplaceAll(req.Mod.Path, "/", "-")),
+			Title:       "go.sum out of date",
+			Description: fmt.Sprintf("go.mod requires %s@%s but go.sum has no matching hash entry", req.Mod.Path, req.Mod.Version),
+			Category:    report.CategoryDependencies,
+			Severity:    report.SeverityMedium,
+			File:        goSum,
+			Rule:        "lockfile-drift",
+			Fix:         "Run `go mod tidy` to refresh go.sum",
+			CreatedAt:   time.Now(),
+		})
+	}
+
+	issues = append(issues, a.verifyGoSumAgainstModuleCache(locked)...)
+
+	return issues, nil
+}
+
+// verifyGoSumAgainstModuleCache compares each go.sum hash against the
+// ziphash file Go's module cache records for that module version. It's
+// best-effort: a module that hasn't been downloaded locally has no ziphash
+// file to compare against and is silently skipped, since that's the common
+// case (go.sum routinely pins far more modules than are actually cached).
+func (a *DependencyAnalyzer) verifyGoSumAgainstModuleCache(locked []LockedDependency) []report.Issue {
+	cacheDir := a.resolveGoModCache()
+	if cacheDir == "" {
+		return nil
+	}
+
+	var issues []report.Issue
+	for _, dep := range locked {
+		if dep.Integrity == "" {
+			continue
+		}
+
+		escapedPath, err := module.EscapePath(dep.Name)
+		if err != nil {
+			continue
+		}
+		escapedVersion, err := module.EscapeVersion(dep.Version)
+		if err != nil {
+			continue
+		}
+
+		ziphashPath := filepath.Join(cacheDir, "cache", "download", escapedPath, "@v", escapedVersion+".ziphash")
+		cachedHash, err := os.ReadFile(ziphashPath)
+		if err != nil {
+			continue // not present in the local module cache; nothing to verify
 		}
+
+		expected := strings.TrimPrefix(dep.Integrity, "h1:")
+		actual := strings.TrimPrefix(strings.TrimSpace(string(cachedHash)), "h1:")
+		if actual == expected {
+			continue
+		}
+
+		issues = append(issues, report.Issue{
+			ID:          fmt.Sprintf("lockfile-hash-mismatch-%s", strings.ReplaceAll(dep.Name, "/", "-")),
+			Title:       "go.sum hash mismatch with local module cache",
+			Description: fmt.Sprintf("go.sum records a different hash for %s@%s than what's in the local module cache, which may indicate a tampered or corrupted cache entry", dep.Name, dep.Version),
+			Category:    report.CategorySecurity,
+			Severity:    report.SeverityCritical,
+			File:        goSum,
+			Rule:        "lockfile-hash-mismatch",
+			Fix:         fmt.Sprintf("Run `go clean -modcache` and re-download %s, then verify go.sum with `go mod verify`", dep.Name),
+			CreatedAt:   time.Now(),
+		})
 	}
 
-	return directDeps, scanner.Err()
+	return issues
 }
 
-func (a *DependencyAnalyzer) isStartOfMainRequireBlock(line string) bool {
-	return strings.HasPrefix(line, "require (")
+// resolveGoModCache returns the directory Go downloads and caches modules
+// into, preferring the GOMODCACHE environment variable and falling back to
+// `go env GOMODCACHE`. It returns "" if neither source yields a value.
+func (a *DependencyAnalyzer) resolveGoModCache() string {
+	if dir := os.Getenv("GOMODCACHE"); dir != "" {
+		return dir
+	}
+
+	cmd := exec.Command("go", "env", "GOMODCACHE")
+	output, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
 }
 
-func (a *DependencyAnalyzer) extractDependenciesFromRequireBlock(scanner *bufio.Scanner) ([]string, error) {
-	var deps []string
+// analyzeNpmLockDrift flags a missing npm lockfile, any package.json
+// dependency the lockfile doesn't resolve, and - when package-lock.json is
+// the lockfile in use - any entry missing its integrity hash.
+func (a *DependencyAnalyzer) analyzeNpmLockDrift() ([]report.Issue, error) {
+	manifestDeps, err := a.getPackageJsonDependencyNames()
+	if err != nil {
+		return nil, err
+	}
+
+	lockFile, decoder := a.selectNpmLockfile()
+	if lockFile == "" {
+		return []report.Issue{{
+			ID:          "missing-lockfile-npm",
+			Title:       "Missing npm lockfile",
+			Description: "package.json is present but no package-lock.json, yarn.lock, or pnpm-lock.yaml was found, so installs aren't reproducible",
+			Category:    report.CategoryDependencies,
+			Severity:    report.SeverityMedium,
+			File:        packageJson,
+			Rule:        "missing-lockfile",
+			Fix:         "Run `npm install` (or your package manager's equivalent) to generate a lockfile and commit it",
+			CreatedAt:   time.Now(),
+		}}, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(a.repoPath, lockFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", lockFile, err)
+	}
+
+	locked, err := decoder.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	lockedNames := make(map[string]bool, len(locked))
+	for _, dep := range locked {
+		lockedNames[dep.Name] = true
+	}
+
+	var issues []report.Issue
+	for _, name := range manifestDeps {
+		if lockedNames[name] {
+			continue
+		}
+		issues = append(issues, report.Issue{
+			ID:          fmt.Sprintf("lockfile-drift-%s", strings.ReplaceAll(name, "/", "-")),
+			Title:       "Lockfile out of date",
+			Description: fmt.Sprintf("%s is declared in package.json but not resolved in %s", name, lockFile),
+			Category:    report.CategoryDependencies,
+			Severity:    report.SeverityMedium,
+			File:        lockFile,
+			Rule:        "lockfile-drift",
+			Fix:         "Reinstall dependencies to refresh the lockfile",
+			CreatedAt:   time.Now(),
+		})
+	}
+
+	if lockFile == packageLockJson {
+		issues = append(issues, a.checkNpmLockIntegrity(locked)...)
 
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
+		rootDeps, err := a.getPackageLockRootDependencyNames(data)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, a.checkManifestDrift(rootDeps, manifestDeps, lockFile)...)
+	}
+
+	return issues, nil
+}
+
+// checkManifestDrift flags a dependency declared as a root requirement in
+// the lockfile but no longer present in package.json - the reverse of the
+// missing-from-lockfile drift analyzeNpmLockDrift's caller already checks,
+// catching a package.json edit that wasn't followed by reinstalling.
+func (a *DependencyAnalyzer) checkManifestDrift(lockRootDeps, manifestDeps []string, lockFile string) []report.Issue {
+	inManifest := make(map[string]bool, len(manifestDeps))
+	for _, name := range manifestDeps {
+		inManifest[name] = true
+	}
 
-		if a.isEndOfRequireBlock(line) {
-			break
+	var issues []report.Issue
+	for _, name := range lockRootDeps {
+		if inManifest[name] {
+			continue
 		}
+		issues = append(issues, report.Issue{
+			ID:          fmt.Sprintf("manifest-drift-%s", strings.ReplaceAll(name, "/", "-")),
+			Title:       "Lockfile ahead of package.json",
+			Description: fmt.Sprintf("%s is a root dependency in %s but is no longer declared in package.json", name, lockFile),
+			Category:    report.CategoryDependencies,
+			Severity:    report.SeverityLow,
+			File:        packageJson,
+			Rule:        "lockfile-drift",
+			Fix:         "Add the dependency back to package.json, or remove it and reinstall to refresh the lockfile",
+			CreatedAt:   time.Now(),
+		})
+	}
+	return issues
+}
+
+// getPackageLockRootDependencyNames reads package-lock.json's root package
+// entry (the "" key in "packages", lockfile v2/v3) and returns the names of
+// its declared "dependencies" and "devDependencies" - the manifest
+// requirements npm resolved this lockfile against, which npmLockfile.Parse
+// doesn't expose since it only returns the flattened resolved graph.
+func (a *DependencyAnalyzer) getPackageLockRootDependencyNames(data []byte) ([]string, error) {
+	var lockfile struct {
+		Packages map[string]struct {
+			Dependencies    map[string]string `json:"dependencies"`
+			DevDependencies map[string]string `json:"devDependencies"`
+		} `json:"packages"`
+	}
+	if err := json.Unmarshal(data, &lockfile); err != nil {
+		return nil, fmt.Errorf("failed to parse package-lock.json: %w", err)
+	}
 
-		if dep := a.extractValidDependency(line); dep != "" {
-			deps = append(deps, dep)
+	root, ok := lockfile.Packages[""]
+	if !ok {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(root.Dependencies)+len(root.DevDependencies))
+	for name := range root.Dependencies {
+		names = append(names, name)
+	}
+	for name := range root.DevDependencies {
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// selectNpmLockfile returns the filename and decoder for whichever npm
+// lockfile is present, preferring package-lock.json, then yarn.lock, then
+// pnpm-lock.yaml. It returns "" if none are present.
+func (a *DependencyAnalyzer) selectNpmLockfile() (string, Lockfile) {
+	switch {
+	case a.hasPackageLockJson():
+		return packageLockJson, npmLockfile{}
+	case a.hasYarnLock():
+		return yarnLock, yarnLockfile{}
+	case a.hasPnpmLockYaml():
+		return pnpmLockYaml, pnpmLockfile{}
+	default:
+		return "", nil
+	}
+}
+
+// checkNpmLockIntegrity flags package-lock.json entries with no integrity
+// hash, which leaves npm unable to verify the package contents it installs
+// - a supply-chain risk.
+func (a *DependencyAnalyzer) checkNpmLockIntegrity(locked []LockedDependency) []report.Issue {
+	var issues []report.Issue
+	for _, dep := range locked {
+		if dep.Integrity != "" {
+			continue
 		}
+		issues = append(issues, report.Issue{
+			ID:          fmt.Sprintf("missing-integrity-%s", strings.ReplaceAll(dep.Name, "/", "-")),
+			Title:       "Missing lockfile integrity hash",
+			Description: fmt.Sprintf("%s has no integrity hash in package-lock.json, so npm can't verify the installed package hasn't been tampered with", dep.Name),
+			Category:    report.CategorySecurity,
+			Severity:    report.SeverityHigh,
+			File:        packageLockJson,
+			Rule:        "missing-integrity",
+			Fix:         "Regenerate package-lock.json with a current npm version so every entry carries an integrity hash",
+			CreatedAt:   time.Now(),
+		})
 	}
+	return issues
+}
 
-	return deps, nil
+// packageJsonManifest is the subset of package.json's dependency maps this
+// analyzer reads: direct, dev, peer, and optional dependencies.
+type packageJsonManifest struct {
+	Dependencies         map[string]string `json:"dependencies"`
+	DevDependencies      map[string]string `json:"devDependencies"`
+	PeerDependencies     map[string]string `json:"peerDependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
 }
 
-func (a *DependencyAnalyzer) isEndOfRequireBlock(line string) bool {
-	return strings.Contains(line, ")")
+// parsePackageJsonManifest reads and decodes the repository's package.json
+// into its dependency maps.
+func (a *DependencyAnalyzer) parsePackageJsonManifest() (*packageJsonManifest, error) {
+	data, err := os.ReadFile(filepath.Join(a.repoPath, packageJson))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read package.json: %w", err)
+	}
+
+	var manifest packageJsonManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse package.json: %w", err)
+	}
+
+	return &manifest, nil
 }
 
-func (a *DependencyAnalyzer) extractValidDependency(line string) string {
-	if a.isValidDependencyLine(line) {
-		return a.extractDependencyName(line)
+// getPackageJsonDependencyNames returns every package named in package.json's
+// "dependencies", "devDependencies", "peerDependencies", and
+// "optionalDependencies" maps.
+func (a *DependencyAnalyzer) getPackageJsonDependencyNames() ([]string, error) {
+	manifest, err := a.parsePackageJsonManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(manifest.Dependencies)+len(manifest.DevDependencies)+len(manifest.PeerDependencies)+len(manifest.OptionalDependencies))
+	for _, deps := range []map[string]string{manifest.Dependencies, manifest.DevDependencies, manifest.PeerDependencies, manifest.OptionalDependencies} {
+		for name := range deps {
+			names = append(names, name)
+		}
 	}
-	return ""
+
+	return names, nil
 }
 
-func (a *DependencyAnalyzer) isValidDependencyLine(line string) bool {
-	return line != "" && !strings.HasPrefix(line, "//")
+// concatenateGoSource reads every Go source file in the repository into one
+// string for a cheap substring-based symbol reachability check. This is a
+// heuristic stand-in for real call-graph analysis: it can't tell whether a
+// matching identifier is actually the vulnerable symbol versus a local one
+// that happens to share its name, so it only ever narrows --symbol-filtered
+// results, never a plain OSV query.
+func (a *DependencyAnalyzer) concatenateGoSource() (string, error) {
+	var builder strings.Builder
+
+	err := filepath.Walk(a.repoPath, func(path string, info os.FileInfo, walkErr error) error {
+		if walkErr != nil || !a.shouldProcessFile(path) {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+
+		builder.Write(content)
+		builder.WriteByte('\n')
+		return nil
+	})
+
+	return builder.String(), err
+}
+
+// symbolsReachable reports whether any of record's affected symbols appear
+// in sourceText. A record with no declared symbols is always considered
+// reachable, since OSV doesn't require database_specific.symbols to be set.
+func symbolsReachable(record osvRecord, sourceText string) bool {
+	symbols := recordSymbols(record)
+	if len(symbols) == 0 {
+		return true
+	}
+
+	for _, symbol := range symbols {
+		name := symbol
+		if idx := strings.LastIndex(symbol, "."); idx != -1 {
+			name = symbol[idx+1:]
+		}
+		if name != "" && strings.Contains(sourceText, name) {
+			return true
+		}
+	}
+
+	return false
 }
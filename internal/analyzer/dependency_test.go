@@ -1,12 +1,17 @@
 package analyzer
 
 import (
+	"errors"
+	"go/ast"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+
 	"github.com/githealthchecker/git-health-checker/internal/config"
 	"github.com/githealthchecker/git-health-checker/internal/report"
 )
@@ -353,21 +358,18 @@ func TestDependencyAnalyzer_ExtractDependencyName(t *testing.T) {
 	analyzer := NewDependencyAnalyzer(cfg, ".")
 
 	tests := []struct {
-		line     string
+		req      *modfile.Require
 		expected string
 	}{
-		{"github.com/spf13/cobra v1.7.0", "github.com/spf13/cobra"},
-		{"  github.com/fatih/color v1.15.0  ", "github.com/fatih/color"},
-		{"github.com/inconshreveable/mousetrap v1.1.0 // indirect", "github.com/inconshreveable/mousetrap"},
-		{"", ""},
-		{"// comment line", ""},
-		{"(github.com/test/pkg v1.0.0)", "github.com/test/pkg"},
+		{&modfile.Require{Mod: module.Version{Path: "github.com/spf13/cobra", Version: "v1.7.0"}}, "github.com/spf13/cobra"},
+		{&modfile.Require{Mod: module.Version{Path: "github.com/fatih/color", Version: "v1.15.0"}}, "github.com/fatih/color"},
+		{&modfile.Require{Mod: module.Version{Path: "github.com/inconshreveable/mousetrap", Version: "v1.1.0"}, Indirect: true}, "github.com/inconshreveable/mousetrap"},
 	}
 
 	for _, test := range tests {
-		result := analyzer.extractDependencyName(test.line)
+		result := analyzer.extractDependencyName(test.req)
 		if result != test.expected {
-			t.Errorf("extractDependencyName(%q) = %q, expected %q", test.line, result, test.expected)
+			t.Errorf("extractDependencyName(%+v) = %q, expected %q", test.req, result, test.expected)
 		}
 	}
 }
@@ -377,23 +379,22 @@ func TestDependencyAnalyzer_ExtractImportPath(t *testing.T) {
 	analyzer := NewDependencyAnalyzer(cfg, ".")
 
 	tests := []struct {
-		line     string
+		name     string
+		spec     *ast.ImportSpec
 		expected string
 	}{
-		{`"fmt"`, "fmt"},
-		{`"github.com/spf13/cobra"`, "github.com/spf13/cobra"},
-		{`_ "github.com/lib/pq"`, "github.com/lib/pq"},
-		{`color "github.com/fatih/color"`, "github.com/fatih/color"},
-		{`  "strings"  `, "strings"},
-		{`'github.com/test/pkg'`, "github.com/test/pkg"},
-		{"", ""},
-		{"// comment", ""},
+		{"plain", &ast.ImportSpec{Path: &ast.BasicLit{Value: `"fmt"`}}, "fmt"},
+		{"nested path", &ast.ImportSpec{Path: &ast.BasicLit{Value: `"github.com/spf13/cobra"`}}, "github.com/spf13/cobra"},
+		{"blank import", &ast.ImportSpec{Name: ast.NewIdent("_"), Path: &ast.BasicLit{Value: `"github.com/lib/pq"`}}, "github.com/lib/pq"},
+		{"aliased import", &ast.ImportSpec{Name: ast.NewIdent("color"), Path: &ast.BasicLit{Value: `"github.com/fatih/color"`}}, "github.com/fatih/color"},
+		{"dot import", &ast.ImportSpec{Name: ast.NewIdent("."), Path: &ast.BasicLit{Value: `"github.com/test/pkg"`}}, "github.com/test/pkg"},
+		{"malformed literal", &ast.ImportSpec{Path: &ast.BasicLit{Value: `"unterminated`}}, ""},
 	}
 
 	for _, test := range tests {
-		result := analyzer.extractImportPath(test.line)
+		result := analyzer.extractImportPath(test.spec)
 		if result != test.expected {
-			t.Errorf("extractImportPath(%q) = %q, expected %q", test.line, result, test.expected)
+			t.Errorf("extractImportPath(%s) = %q, expected %q", test.name, result, test.expected)
 		}
 	}
 }
@@ -464,6 +465,74 @@ func main() {
 	}
 }
 
+func TestDependencyAnalyzer_ShouldProcessFile_RespectsBuildTags(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "build_tags_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer func(path string) {
+		err := os.RemoveAll(path)
+		if err != nil {
+			t.Fatalf("Failed to clean up temp directory %s: %v", path, err)
+		}
+	}(tempDir)
+
+	cfg := &config.DependencyConfig{}
+	analyzer := NewDependencyAnalyzer(cfg, tempDir)
+
+	ignoredContent := `//go:build ignore
+
+package main
+
+import "github.com/should/not-count"
+
+func main() {}
+`
+	ignoredPath := filepath.Join(tempDir, "ignored.go")
+	if err := os.WriteFile(ignoredPath, []byte(ignoredContent), 0644); err != nil {
+		t.Fatalf("Failed to create ignored.go: %v", err)
+	}
+
+	if analyzer.shouldProcessFile(ignoredPath) {
+		t.Error("shouldProcessFile() should return false for a //go:build ignore file")
+	}
+}
+
+func TestDependencyAnalyzer_ShouldProcessFile_HonorsConfiguredBuildTags(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "build_tags_config_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer func(path string) {
+		if err := os.RemoveAll(path); err != nil {
+			t.Fatalf("Failed to clean up temp directory %s: %v", path, err)
+		}
+	}(tempDir)
+
+	integrationContent := `//go:build integration
+
+package main
+
+import "github.com/only/under-integration-tag"
+
+func main() {}
+`
+	integrationPath := filepath.Join(tempDir, "integration.go")
+	if err := os.WriteFile(integrationPath, []byte(integrationContent), 0644); err != nil {
+		t.Fatalf("Failed to create integration.go: %v", err)
+	}
+
+	withoutTags := NewDependencyAnalyzer(&config.DependencyConfig{}, tempDir)
+	if withoutTags.shouldProcessFile(integrationPath) {
+		t.Error("shouldProcessFile() should exclude an integration-tagged file when no BuildTags are configured")
+	}
+
+	withTags := NewDependencyAnalyzer(&config.DependencyConfig{BuildTags: []string{"integration"}}, tempDir)
+	if !withTags.shouldProcessFile(integrationPath) {
+		t.Error("shouldProcessFile() should include an integration-tagged file when BuildTags includes \"integration\"")
+	}
+}
+
 func TestDependencyAnalyzer_IsImportUsed(t *testing.T) {
 	cfg := &config.DependencyConfig{}
 	analyzer := NewDependencyAnalyzer(cfg, ".")
@@ -606,3 +675,592 @@ func main() {
 		}
 	}
 }
+
+func TestDependencyAnalyzer_AnalyzeUnusedGoModules_RespectsReplace(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "unused_deps_replace_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer func(path string) {
+		err := os.RemoveAll(path)
+		if err != nil {
+			t.Fatalf("Failed to clean up temp directory %s: %v", path, err)
+		}
+	}(tempDir)
+
+	cfg := &config.DependencyConfig{}
+	analyzer := NewDependencyAnalyzer(cfg, tempDir)
+
+	// github.com/fatih/color is never imported, but it's replaced with a local fork
+	// whose import path callers would use instead, so it must not be flagged unused.
+	goModContent := `module github.com/test/project
+
+go 1.21
+
+require (
+	github.com/spf13/cobra v1.7.0
+	github.com/fatih/color v1.15.0
+)
+
+replace github.com/fatih/color => ./vendor/color-fork
+`
+	goModPath := filepath.Join(tempDir, "go.mod")
+	if err := os.WriteFile(goModPath, []byte(goModContent), 0644); err != nil {
+		t.Fatalf("Failed to create go.mod: %v", err)
+	}
+
+	mainContent := `package main
+
+import "github.com/spf13/cobra"
+
+func main() {
+	_ = cobra.Command{}
+}
+`
+	mainPath := filepath.Join(tempDir, "main.go")
+	if err := os.WriteFile(mainPath, []byte(mainContent), 0644); err != nil {
+		t.Fatalf("Failed to create main.go: %v", err)
+	}
+
+	issues, err := analyzer.analyzeUnusedGoModules()
+	if err != nil {
+		t.Fatalf("analyzeUnusedGoModules() failed: %v", err)
+	}
+
+	for _, issue := range issues {
+		if strings.Contains(issue.Description, "github.com/fatih/color") {
+			t.Errorf("replaced dependency github.com/fatih/color should not be flagged as unused")
+		}
+	}
+}
+
+func TestDependencyAnalyzer_ShouldSkipPackage_RespectsWorkspaceLocalReplace(t *testing.T) {
+	tempDir := t.TempDir()
+	goModContent := "module github.com/test/project\n\ngo 1.21\n\nrequire github.com/org/shared v1.0.0\n"
+	if err := os.WriteFile(filepath.Join(tempDir, "go.mod"), []byte(goModContent), 0644); err != nil {
+		t.Fatalf("Failed to create go.mod: %v", err)
+	}
+
+	analyzer := NewDependencyAnalyzer(&config.DependencyConfig{}, tempDir)
+	analyzer.workspaceLocalReplace = map[string]bool{"github.com/org/shared": true}
+
+	pkg := PackageInfo{Name: "github.com/org/shared", CurrentVersion: "v1.0.0"}
+	if !analyzer.shouldSkipPackage(pkg, "github.com/test/project") {
+		t.Error("expected a dependency replaced by the workspace with a local sibling to be skipped")
+	}
+}
+
+func TestDependencyAnalyzer_AnalyzeGoWorkspace_PrefixesIssueFilesAndSuppressesLocalReplace(t *testing.T) {
+	tempDir := t.TempDir()
+
+	goWorkContent := `go 1.21
+
+use (
+	./services/api
+	./services/worker
+)
+
+replace github.com/org/shared => ./services/shared
+`
+	if err := os.WriteFile(filepath.Join(tempDir, "go.work"), []byte(goWorkContent), 0644); err != nil {
+		t.Fatalf("Failed to create go.work: %v", err)
+	}
+
+	apiDir := filepath.Join(tempDir, "services", "api")
+	if err := os.MkdirAll(apiDir, 0755); err != nil {
+		t.Fatalf("Failed to create api module dir: %v", err)
+	}
+	apiGoModContent := `module github.com/org/api
+
+go 1.21
+
+require (
+	github.com/org/shared v1.0.0
+	github.com/spf13/cobra v1.7.0
+)
+`
+	if err := os.WriteFile(filepath.Join(apiDir, "go.mod"), []byte(apiGoModContent), 0644); err != nil {
+		t.Fatalf("Failed to create api go.mod: %v", err)
+	}
+	apiMainContent := `package main
+
+import "github.com/org/shared"
+
+func main() {
+	shared.Init()
+}
+`
+	if err := os.WriteFile(filepath.Join(apiDir, "main.go"), []byte(apiMainContent), 0644); err != nil {
+		t.Fatalf("Failed to create api main.go: %v", err)
+	}
+
+	workerDir := filepath.Join(tempDir, "services", "worker")
+	if err := os.MkdirAll(workerDir, 0755); err != nil {
+		t.Fatalf("Failed to create worker module dir: %v", err)
+	}
+	workerGoModContent := `module github.com/org/worker
+
+go 1.21
+
+require github.com/fatih/color v1.15.0
+`
+	if err := os.WriteFile(filepath.Join(workerDir, "go.mod"), []byte(workerGoModContent), 0644); err != nil {
+		t.Fatalf("Failed to create worker go.mod: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(workerDir, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0644); err != nil {
+		t.Fatalf("Failed to create worker main.go: %v", err)
+	}
+
+	analyzer := NewDependencyAnalyzer(&config.DependencyConfig{}, tempDir)
+	issues, err := analyzer.analyzeGoWorkspace()
+	if err != nil {
+		t.Fatalf("analyzeGoWorkspace() failed: %v", err)
+	}
+
+	foundWorkerUnused, foundAPIUnused := false, false
+	for _, issue := range issues {
+		if issue.Rule != "unused-dependencies" {
+			continue
+		}
+		switch {
+		case strings.Contains(issue.Description, "github.com/fatih/color"):
+			foundWorkerUnused = true
+			if issue.File != filepath.Join("services", "worker", "go.mod") {
+				t.Errorf("expected the worker module's unused-dependency issue File to be prefixed with its module path, got %q", issue.File)
+			}
+		case strings.Contains(issue.Description, "github.com/spf13/cobra"):
+			foundAPIUnused = true
+			if issue.File != filepath.Join("services", "api", "go.mod") {
+				t.Errorf("expected the api module's unused-dependency issue File to be prefixed with its module path, got %q", issue.File)
+			}
+		case strings.Contains(issue.Description, "github.com/org/shared"):
+			t.Error("github.com/org/shared is imported by api/main.go and should not be flagged as unused")
+		}
+	}
+	if !foundWorkerUnused {
+		t.Fatal("expected an unused-dependency issue for github.com/fatih/color in the worker module")
+	}
+	if !foundAPIUnused {
+		t.Fatal("expected an unused-dependency issue for github.com/spf13/cobra in the api module")
+	}
+}
+
+func TestDependencyAnalyzer_AnalyzeGoModReplaces_FlagsLocalPath(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "go_mod_replace_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer func(path string) {
+		if err := os.RemoveAll(path); err != nil {
+			t.Fatalf("Failed to clean up temp directory %s: %v", path, err)
+		}
+	}(tempDir)
+
+	cfg := &config.DependencyConfig{}
+	analyzer := NewDependencyAnalyzer(cfg, tempDir)
+
+	goModContent := `module github.com/test/project
+
+go 1.21
+
+require (
+	github.com/spf13/cobra v1.7.0
+	github.com/fatih/color v1.15.0
+)
+
+replace github.com/fatih/color => ./vendor/color-fork
+
+replace github.com/spf13/cobra => github.com/spf13/cobra v1.7.1
+`
+	goModPath := filepath.Join(tempDir, "go.mod")
+	if err := os.WriteFile(goModPath, []byte(goModContent), 0644); err != nil {
+		t.Fatalf("Failed to create go.mod: %v", err)
+	}
+
+	issues, err := analyzer.analyzeGoModReplaces()
+	if err != nil {
+		t.Fatalf("analyzeGoModReplaces() failed: %v", err)
+	}
+
+	if len(issues) != 1 {
+		t.Fatalf("expected exactly 1 local-replace issue, got %d", len(issues))
+	}
+	if issues[0].Rule != "local-replace-directive" {
+		t.Errorf("expected rule local-replace-directive, got %s", issues[0].Rule)
+	}
+	if !strings.Contains(issues[0].Description, "github.com/fatih/color") {
+		t.Errorf("expected the local replace of github.com/fatih/color to be flagged, got: %s", issues[0].Description)
+	}
+}
+
+func TestDependencyAnalyzer_CheckRetractedGoPackage(t *testing.T) {
+	analyzer := NewDependencyAnalyzer(&config.DependencyConfig{}, t.TempDir())
+
+	if issue := analyzer.checkRetractedGoPackage(PackageInfo{Name: "github.com/example/clean", CurrentVersion: "v1.0.0"}); issue != nil {
+		t.Errorf("expected no issue for a package without retractions, got %+v", issue)
+	}
+
+	retracted := PackageInfo{
+		Name:              "github.com/example/bad",
+		CurrentVersion:    "v1.2.0",
+		RetractedComments: []string{"contains a critical security flaw"},
+	}
+	issue := analyzer.checkRetractedGoPackage(retracted)
+	if issue == nil {
+		t.Fatal("expected an issue for a retracted package version")
+	}
+	if issue.Rule != "retracted-dependencies" {
+		t.Errorf("expected rule retracted-dependencies, got %s", issue.Rule)
+	}
+	if !strings.Contains(issue.Description, "contains a critical security flaw") {
+		t.Errorf("expected the retraction rationale in the description, got: %s", issue.Description)
+	}
+}
+
+func TestDependencyAnalyzer_GetNpmModuleVersions(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "npm_modules_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer func(path string) {
+		if err := os.RemoveAll(path); err != nil {
+			t.Fatalf("Failed to clean up temp directory %s: %v", path, err)
+		}
+	}(tempDir)
+
+	lockContent := `{
+		"name": "example",
+		"packages": {
+			"": {"name": "example"},
+			"node_modules/lodash": {"version": "4.17.20"},
+			"node_modules/@scope/pkg": {"version": "1.0.0"}
+		}
+	}`
+	lockPath := filepath.Join(tempDir, "package-lock.json")
+	if err := os.WriteFile(lockPath, []byte(lockContent), 0644); err != nil {
+		t.Fatalf("Failed to create package-lock.json: %v", err)
+	}
+
+	analyzer := NewDependencyAnalyzer(&config.DependencyConfig{}, tempDir)
+	modules, err := analyzer.getNpmModuleVersions()
+	if err != nil {
+		t.Fatalf("getNpmModuleVersions() failed: %v", err)
+	}
+
+	found := make(map[string]string)
+	for _, mod := range modules {
+		if mod.Ecosystem != "npm" {
+			t.Errorf("expected ecosystem npm, got %s", mod.Ecosystem)
+		}
+		found[mod.Name] = mod.Version
+	}
+
+	if found["lodash"] != "4.17.20" {
+		t.Errorf("expected lodash@4.17.20, got %q", found["lodash"])
+	}
+	if found["@scope/pkg"] != "1.0.0" {
+		t.Errorf("expected @scope/pkg@1.0.0, got %q", found["@scope/pkg"])
+	}
+	if len(modules) != 2 {
+		t.Errorf("expected 2 modules (root package entry skipped), got %d", len(modules))
+	}
+}
+
+func TestDependencyAnalyzer_GetPipModuleVersions(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "pip_modules_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer func(path string) {
+		if err := os.RemoveAll(path); err != nil {
+			t.Fatalf("Failed to clean up temp directory %s: %v", path, err)
+		}
+	}(tempDir)
+
+	requirementsContent := `# comment line
+requests==2.25.1
+django>=3.0
+-e git+https://example.com/pkg.git#egg=pkg
+flask==1.1.2
+`
+	requirementsPath := filepath.Join(tempDir, "requirements.txt")
+	if err := os.WriteFile(requirementsPath, []byte(requirementsContent), 0644); err != nil {
+		t.Fatalf("Failed to create requirements.txt: %v", err)
+	}
+
+	analyzer := NewDependencyAnalyzer(&config.DependencyConfig{}, tempDir)
+	modules, err := analyzer.getPipModuleVersions()
+	if err != nil {
+		t.Fatalf("getPipModuleVersions() failed: %v", err)
+	}
+
+	found := make(map[string]string)
+	for _, mod := range modules {
+		if mod.Ecosystem != "PyPI" {
+			t.Errorf("expected ecosystem PyPI, got %s", mod.Ecosystem)
+		}
+		found[mod.Name] = mod.Version
+	}
+
+	if found["requests"] != "2.25.1" {
+		t.Errorf("expected requests==2.25.1, got %q", found["requests"])
+	}
+	if found["flask"] != "1.1.2" {
+		t.Errorf("expected flask==1.1.2, got %q", found["flask"])
+	}
+	if _, ok := found["django"]; ok {
+		t.Errorf("unpinned requirement django>=3.0 should be skipped")
+	}
+	if len(modules) != 2 {
+		t.Errorf("expected 2 pinned requirements, got %d", len(modules))
+	}
+}
+
+func TestDependencyAnalyzer_VulnerabilityBackend_SelectsBySource(t *testing.T) {
+	cfg := &config.DependencyConfig{VulnerabilitySource: "local"}
+	analyzer := NewDependencyAnalyzer(cfg, "")
+
+	backend, err := analyzer.vulnerabilityBackend()
+	if err != nil {
+		t.Fatalf("vulnerabilityBackend() failed: %v", err)
+	}
+	if _, ok := backend.(*localDBBackend); !ok {
+		t.Errorf("expected a *localDBBackend for vulnerability_source=local, got %T", backend)
+	}
+
+	cfg.VulnerabilitySource = "unknown"
+	if _, err := analyzer.vulnerabilityBackend(); err == nil {
+		t.Error("expected an error for an unknown vulnerability_source")
+	}
+}
+
+func TestDependencyAnalyzer_AnalyzeVulnerabilities_WrapsBackendFailure(t *testing.T) {
+	tempDir := t.TempDir()
+	goModContent := "module test/project\n\ngo 1.21\n\nrequire github.com/pkg/errors v0.9.1\n"
+	if err := os.WriteFile(filepath.Join(tempDir, "go.mod"), []byte(goModContent), 0644); err != nil {
+		t.Fatalf("failed to write go.mod: %v", err)
+	}
+
+	// Point --osv-db at a regular file instead of a directory so the
+	// offline backend's directory read fails with something other than
+	// "not exist", simulating an unreachable backend.
+	badDBPath := filepath.Join(tempDir, "not-a-directory")
+	if err := os.WriteFile(badDBPath, []byte("x"), 0644); err != nil {
+		t.Fatalf("failed to write bad db path: %v", err)
+	}
+
+	analyzer := NewDependencyAnalyzer(&config.DependencyConfig{
+		CheckVulnerabilities: true,
+		OSVDatabasePath:      badDBPath,
+	}, tempDir)
+
+	_, err := analyzer.analyzeVulnerabilities()
+	var lookupErr *vulnerabilityLookupError
+	if !errors.As(err, &lookupErr) {
+		t.Fatalf("expected a *vulnerabilityLookupError, got %v (%T)", err, err)
+	}
+}
+
+func TestDependencyAnalyzer_Analyze_DegradesOnVulnerabilityBackendFailure(t *testing.T) {
+	tempDir := t.TempDir()
+	goModContent := "module test/project\n\ngo 1.21\n\nrequire github.com/pkg/errors v0.9.1\n"
+	if err := os.WriteFile(filepath.Join(tempDir, "go.mod"), []byte(goModContent), 0644); err != nil {
+		t.Fatalf("failed to write go.mod: %v", err)
+	}
+
+	badDBPath := filepath.Join(tempDir, "not-a-directory")
+	if err := os.WriteFile(badDBPath, []byte("x"), 0644); err != nil {
+		t.Fatalf("failed to write bad db path: %v", err)
+	}
+
+	analyzer := NewDependencyAnalyzer(&config.DependencyConfig{
+		CheckVulnerabilities: true,
+		OSVDatabasePath:      badDBPath,
+	}, tempDir)
+
+	if _, err := analyzer.Analyze(); err != nil {
+		t.Fatalf("Analyze() should degrade to blocklist-only mode on a vulnerability backend failure, got error: %v", err)
+	}
+}
+
+func TestDependencyAnalyzer_AnalyzeGoSumDrift_MissingGoSum(t *testing.T) {
+	tempDir := t.TempDir()
+	goModContent := "module test/project\n\ngo 1.21\n\nrequire github.com/pkg/errors v0.9.1\n"
+	if err := os.WriteFile(filepath.Join(tempDir, "go.mod"), []byte(goModContent), 0644); err != nil {
+		t.Fatalf("failed to write go.mod: %v", err)
+	}
+
+	analyzer := NewDependencyAnalyzer(&config.DependencyConfig{}, tempDir)
+	issues, err := analyzer.analyzeGoSumDrift()
+	if err != nil {
+		t.Fatalf("analyzeGoSumDrift() failed: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Rule != "missing-lockfile" {
+		t.Fatalf("expected a single missing-lockfile issue, got %+v", issues)
+	}
+}
+
+func TestDependencyAnalyzer_AnalyzeGoSumDrift_FlagsDrift(t *testing.T) {
+	tempDir := t.TempDir()
+	goModContent := "module test/project\n\ngo 1.21\n\nrequire github.com/pkg/errors v0.9.1\n"
+	if err := os.WriteFile(filepath.Join(tempDir, "go.mod"), []byte(goModContent), 0644); err != nil {
+		t.Fatalf("failed to write go.mod: %v", err)
+	}
+	// go.sum pins a different version than go.mod requires.
+	goSumContent := "github.com/pkg/errors v0.9.0 h1:abc=\ngithub.com/pkg/errors v0.9.0/go.mod h1:def=\n"
+	if err := os.WriteFile(filepath.Join(tempDir, "go.sum"), []byte(goSumContent), 0644); err != nil {
+		t.Fatalf("failed to write go.sum: %v", err)
+	}
+
+	analyzer := NewDependencyAnalyzer(&config.DependencyConfig{}, tempDir)
+	issues, err := analyzer.analyzeGoSumDrift()
+	if err != nil {
+		t.Fatalf("analyzeGoSumDrift() failed: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Rule != "lockfile-drift" {
+		t.Fatalf("expected a single lockfile-drift issue, got %+v", issues)
+	}
+}
+
+func TestDependencyAnalyzer_AnalyzeNpmLockDrift_MissingLockfile(t *testing.T) {
+	tempDir := t.TempDir()
+	packageJsonContent := `{"name": "test", "dependencies": {"lodash": "^4.17.21"}}`
+	if err := os.WriteFile(filepath.Join(tempDir, "package.json"), []byte(packageJsonContent), 0644); err != nil {
+		t.Fatalf("failed to write package.json: %v", err)
+	}
+
+	analyzer := NewDependencyAnalyzer(&config.DependencyConfig{}, tempDir)
+	issues, err := analyzer.analyzeNpmLockDrift()
+	if err != nil {
+		t.Fatalf("analyzeNpmLockDrift() failed: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Rule != "missing-lockfile" {
+		t.Fatalf("expected a single missing-lockfile issue, got %+v", issues)
+	}
+}
+
+func TestDependencyAnalyzer_AnalyzeNpmLockDrift_FlagsDriftAndMissingIntegrity(t *testing.T) {
+	tempDir := t.TempDir()
+	packageJsonContent := `{"name": "test", "dependencies": {"lodash": "^4.17.21", "express": "^4.18.2"}}`
+	if err := os.WriteFile(filepath.Join(tempDir, "package.json"), []byte(packageJsonContent), 0644); err != nil {
+		t.Fatalf("failed to write package.json: %v", err)
+	}
+	// The lockfile resolves lodash (with no integrity hash) but not express.
+	lockContent := `{
+  "packages": {
+    "": {"name": "test"},
+    "node_modules/lodash": {"version": "4.17.21"}
+  }
+}`
+	if err := os.WriteFile(filepath.Join(tempDir, "package-lock.json"), []byte(lockContent), 0644); err != nil {
+		t.Fatalf("failed to write package-lock.json: %v", err)
+	}
+
+	analyzer := NewDependencyAnalyzer(&config.DependencyConfig{}, tempDir)
+	issues, err := analyzer.analyzeNpmLockDrift()
+	if err != nil {
+		t.Fatalf("analyzeNpmLockDrift() failed: %v", err)
+	}
+
+	var driftIssues, integrityIssues int
+	for _, issue := range issues {
+		switch issue.Rule {
+		case "lockfile-drift":
+			driftIssues++
+		case "missing-integrity":
+			integrityIssues++
+		}
+	}
+	if driftIssues != 1 {
+		t.Errorf("expected 1 lockfile-drift issue for the unresolved express dependency, got %d", driftIssues)
+	}
+	if integrityIssues != 1 {
+		t.Errorf("expected 1 missing-integrity issue for lodash, got %d", integrityIssues)
+	}
+}
+
+func TestDependencyAnalyzer_AnalyzeNodeModules_ChecksAllManifestCategoriesAndTransitiveGraph(t *testing.T) {
+	tempDir := t.TempDir()
+	packageJsonContent := `{
+  "name": "test",
+  "dependencies": {"express": "^4.18.2"},
+  "devDependencies": {"moment": "^2.29.4"}
+}`
+	if err := os.WriteFile(filepath.Join(tempDir, "package.json"), []byte(packageJsonContent), 0644); err != nil {
+		t.Fatalf("failed to write package.json: %v", err)
+	}
+	// left-pad isn't named in package.json at all - only pulled in transitively.
+	lockContent := `{
+  "packages": {
+    "": {"name": "test"},
+    "node_modules/express": {"version": "4.18.2"},
+    "node_modules/moment": {"version": "2.29.4"},
+    "node_modules/left-pad": {"version": "1.3.0"}
+  }
+}`
+	if err := os.WriteFile(filepath.Join(tempDir, "package-lock.json"), []byte(lockContent), 0644); err != nil {
+		t.Fatalf("failed to write package-lock.json: %v", err)
+	}
+
+	cfg := &config.DependencyConfig{BlockedPackages: []string{"moment", "left-pad"}}
+	analyzer := NewDependencyAnalyzer(cfg, tempDir)
+	issues, err := analyzer.analyzeNodeModules()
+	if err != nil {
+		t.Fatalf("analyzeNodeModules() failed: %v", err)
+	}
+
+	foundMoment, foundLeftPad := false, false
+	for _, issue := range issues {
+		if issue.Rule != "blocked-dependencies" {
+			continue
+		}
+		switch {
+		case strings.Contains(issue.Description, "moment"):
+			foundMoment = true
+		case strings.Contains(issue.Description, "left-pad"):
+			foundLeftPad = true
+		}
+	}
+	if !foundMoment {
+		t.Error("expected moment (a devDependency) to be flagged as blocked")
+	}
+	if !foundLeftPad {
+		t.Error("expected left-pad (only resolved transitively in the lockfile) to be flagged as blocked")
+	}
+}
+
+func TestDependencyAnalyzer_AnalyzeNpmLockDrift_FlagsManifestDrift(t *testing.T) {
+	tempDir := t.TempDir()
+	// package.json no longer declares "chalk", but the lockfile's root
+	// requirements still do - it was removed without reinstalling.
+	packageJsonContent := `{"name": "test", "dependencies": {"express": "^4.18.2"}}`
+	if err := os.WriteFile(filepath.Join(tempDir, "package.json"), []byte(packageJsonContent), 0644); err != nil {
+		t.Fatalf("failed to write package.json: %v", err)
+	}
+	lockContent := `{
+  "packages": {
+    "": {"name": "test", "dependencies": {"express": "^4.18.2", "chalk": "^5.3.0"}},
+    "node_modules/express": {"version": "4.18.2"},
+    "node_modules/chalk": {"version": "5.3.0"}
+  }
+}`
+	if err := os.WriteFile(filepath.Join(tempDir, "package-lock.json"), []byte(lockContent), 0644); err != nil {
+		t.Fatalf("failed to write package-lock.json: %v", err)
+	}
+
+	analyzer := NewDependencyAnalyzer(&config.DependencyConfig{}, tempDir)
+	issues, err := analyzer.analyzeNpmLockDrift()
+	if err != nil {
+		t.Fatalf("analyzeNpmLockDrift() failed: %v", err)
+	}
+
+	found := false
+	for _, issue := range issues {
+		if issue.Rule == "lockfile-drift" && strings.Contains(issue.Description, "chalk") && strings.Contains(issue.Description, "no longer declared") {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected chalk to be flagged as a lockfile-only dependency missing from package.json")
+	}
+}
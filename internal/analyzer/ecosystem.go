@@ -0,0 +1,481 @@
+package analyzer
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/modfile"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+const pyprojectToml = "pyproject.toml"
+const pipfile = "Pipfile"
+const gemfile = "Gemfile"
+const cargoToml = "Cargo.toml"
+const pomXml = "pom.xml"
+const buildGradle = "build.gradle"
+const composerJson = "composer.json"
+
+// Dependency is a single dependency declared in an ecosystem's manifest,
+// reduced to the two things every ecosystem-agnostic check (blocked-package,
+// outdated, vulnerability lookup) needs. Ecosystems whose manifests carry
+// richer data (lockfile integrity, replace directives, ...) expose that
+// through their own types - LockedDependency, GoModInfo - rather than here.
+type Dependency struct {
+	Name    string
+	Version string
+}
+
+// EcosystemAnalyzer detects and parses one language ecosystem's dependency
+// manifest, letting DependencyAnalyzer run checks that apply the same way to
+// every ecosystem (currently blocked-package detection) without hardcoding
+// each manifest format it understands. Go and npm also get dedicated
+// outdated/unused-dependency/vulnerability checks elsewhere in this package;
+// this interface only needs to cover what's shared across all of them.
+type EcosystemAnalyzer interface {
+	// Detect reports whether root contains this ecosystem's manifest.
+	Detect(root string) bool
+	// Parse returns every dependency the manifest declares.
+	Parse(root string) ([]Dependency, error)
+	// Ecosystem names the package ecosystem this analyzer parses (e.g. "Go",
+	// "npm", "PyPI"), used both as the OSV ecosystem name and as Issue.File
+	// for findings that aren't tied to one specific manifest file.
+	Ecosystem() string
+}
+
+var ecosystemRegistry []EcosystemAnalyzer
+
+// RegisterEcosystemAnalyzer makes an EcosystemAnalyzer available to every
+// DependencyAnalyzer. External packages can call this from their own init()
+// to add support for ecosystems this package doesn't ship, without modifying
+// this package.
+func RegisterEcosystemAnalyzer(a EcosystemAnalyzer) {
+	ecosystemRegistry = append(ecosystemRegistry, a)
+}
+
+func init() {
+	RegisterEcosystemAnalyzer(goEcosystemAnalyzer{})
+	RegisterEcosystemAnalyzer(npmEcosystemAnalyzer{})
+	RegisterEcosystemAnalyzer(pythonEcosystemAnalyzer{})
+	RegisterEcosystemAnalyzer(rubyEcosystemAnalyzer{})
+	RegisterEcosystemAnalyzer(rustEcosystemAnalyzer{})
+	RegisterEcosystemAnalyzer(javaEcosystemAnalyzer{})
+	RegisterEcosystemAnalyzer(phpEcosystemAnalyzer{})
+}
+
+// analyzeEcosystemBlockedPackages runs the blocked-package check uniformly
+// across every registered EcosystemAnalyzer whose manifest is present and
+// whose ecosystem isn't disabled via config.DisabledEcosystems. Go and npm
+// are skipped here: analyzeGoModules and analyzeNodeModules already flag
+// their blocked packages, alongside the outdated and unused checks that have
+// no ecosystem-agnostic equivalent yet.
+func (a *DependencyAnalyzer) analyzeEcosystemBlockedPackages() ([]report.Issue, error) {
+	var issues []report.Issue
+
+	for _, ecosystem := range ecosystemRegistry {
+		name := ecosystem.Ecosystem()
+		if name == "Go" || name == "npm" || a.isEcosystemDisabled(name) {
+			continue
+		}
+		if !ecosystem.Detect(a.repoPath) {
+			continue
+		}
+
+		deps, err := ecosystem.Parse(a.repoPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s dependencies: %w", name, err)
+		}
+
+		for _, dep := range deps {
+			if !a.isBlockedPackage(dep.Name) {
+				continue
+			}
+			issues = append(issues, report.Issue{
+				ID:          fmt.Sprintf("blocked-%s-dependency-%s", strings.ToLower(name), strings.ReplaceAll(dep.Name, "/", "-")),
+				Title:       fmt.Sprintf("Blocked %s dependency", name),
+				Description: fmt.Sprintf("Package %s is in the blocked list and should not be used", dep.Name),
+				Category:    report.CategorySecurity,
+				Severity:    report.SeverityHigh,
+				File:        name,
+				Rule:        "blocked-dependencies",
+				Fix:         fmt.Sprintf("Remove %s and find an alternative package", dep.Name),
+				CreatedAt:   time.Now(),
+			})
+		}
+	}
+
+	return issues, nil
+}
+
+// isEcosystemDisabled reports whether name appears in
+// config.DisabledEcosystems, matched case-insensitively so config authors
+// don't need to match the analyzer's exact casing ("pypi" disables "PyPI").
+func (a *DependencyAnalyzer) isEcosystemDisabled(name string) bool {
+	for _, disabled := range a.config.DisabledEcosystems {
+		if strings.EqualFold(disabled, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// goEcosystemAnalyzer implements EcosystemAnalyzer for Go modules declared in
+// go.mod, parsed with golang.org/x/mod/modfile like the rest of the Go
+// handling in this package.
+type goEcosystemAnalyzer struct{}
+
+func (goEcosystemAnalyzer) Ecosystem() string { return "Go" }
+
+func (goEcosystemAnalyzer) Detect(root string) bool {
+	_, err := os.Stat(filepath.Join(root, goMod))
+	return err == nil
+}
+
+func (goEcosystemAnalyzer) Parse(root string) ([]Dependency, error) {
+	path := filepath.Join(root, goMod)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read go.mod: %w", err)
+	}
+
+	modFile, err := modfile.Parse(path, data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse go.mod: %w", err)
+	}
+
+	deps := make([]Dependency, 0, len(modFile.Require))
+	for _, req := range modFile.Require {
+		deps = append(deps, Dependency{Name: req.Mod.Path, Version: req.Mod.Version})
+	}
+	return deps, nil
+}
+
+// npmEcosystemAnalyzer implements EcosystemAnalyzer for Node.js packages
+// declared in package.json's "dependencies" and "devDependencies" maps.
+type npmEcosystemAnalyzer struct{}
+
+func (npmEcosystemAnalyzer) Ecosystem() string { return "npm" }
+
+func (npmEcosystemAnalyzer) Detect(root string) bool {
+	_, err := os.Stat(filepath.Join(root, packageJson))
+	return err == nil
+}
+
+func (npmEcosystemAnalyzer) Parse(root string) ([]Dependency, error) {
+	data, err := os.ReadFile(filepath.Join(root, packageJson))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read package.json: %w", err)
+	}
+
+	var manifest struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse package.json: %w", err)
+	}
+
+	deps := make([]Dependency, 0, len(manifest.Dependencies)+len(manifest.DevDependencies))
+	for name, version := range manifest.Dependencies {
+		deps = append(deps, Dependency{Name: name, Version: version})
+	}
+	for name, version := range manifest.DevDependencies {
+		deps = append(deps, Dependency{Name: name, Version: version})
+	}
+	return deps, nil
+}
+
+// pythonEcosystemAnalyzer implements EcosystemAnalyzer for Python projects,
+// merging whichever of pyproject.toml, requirements.txt and Pipfile are
+// present - a project may use more than one, e.g. a poetry pyproject.toml
+// alongside a generated requirements.txt for deployment.
+type pythonEcosystemAnalyzer struct{}
+
+func (pythonEcosystemAnalyzer) Ecosystem() string { return "PyPI" }
+
+func (pythonEcosystemAnalyzer) Detect(root string) bool {
+	for _, name := range []string{pyprojectToml, requirementsTxt, pipfile} {
+		if _, err := os.Stat(filepath.Join(root, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (pythonEcosystemAnalyzer) Parse(root string) ([]Dependency, error) {
+	var deps []Dependency
+
+	if data, err := os.ReadFile(filepath.Join(root, requirementsTxt)); err == nil {
+		deps = append(deps, parseRequirementsTxt(data)...)
+	}
+	if data, err := os.ReadFile(filepath.Join(root, pyprojectToml)); err == nil {
+		deps = append(deps, parsePyprojectDependencies(data)...)
+	}
+	if data, err := os.ReadFile(filepath.Join(root, pipfile)); err == nil {
+		deps = append(deps, parseTomlSectionAssignments(data, "packages")...)
+	}
+
+	return deps, nil
+}
+
+// pySpecifierPattern splits a PEP 508 requirement ("requests>=2.25.1",
+// "django") into its bare package name, discarding any version specifier,
+// environment marker or extras.
+var pySpecifierPattern = regexp.MustCompile(`^[A-Za-z0-9_.\-]+`)
+
+// parseRequirementsTxt parses a requirements.txt file into Dependency
+// values, keeping unpinned requirements (unlike getPipModuleVersions, which
+// only needs exact versions to query a vulnerability database).
+func parseRequirementsTxt(data []byte) []Dependency {
+	var deps []Dependency
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "-") {
+			continue
+		}
+
+		name := pySpecifierPattern.FindString(line)
+		if name == "" {
+			continue
+		}
+		deps = append(deps, Dependency{Name: name})
+	}
+	return deps
+}
+
+// pyprojectDependencyLinePattern matches a quoted PEP 508 requirement inside
+// a pyproject.toml "dependencies = [...]" array.
+var pyprojectDependencyLinePattern = regexp.MustCompile(`"([^"]+)"`)
+
+// parsePyprojectDependencies does a line-oriented scan for pyproject.toml's
+// "dependencies = [" array (PEP 621) and extracts each quoted requirement.
+// Poetry's "[tool.poetry.dependencies]" table uses a different shape and
+// isn't parsed here.
+func parsePyprojectDependencies(data []byte) []Dependency {
+	var deps []Dependency
+	inDependencies := false
+
+	for _, rawLine := range strings.Split(string(data), "\n") {
+		line := strings.TrimSpace(rawLine)
+		switch {
+		case strings.HasPrefix(line, "dependencies") && strings.Contains(line, "["):
+			inDependencies = true
+		case inDependencies && strings.Contains(line, "]"):
+			inDependencies = false
+		case inDependencies:
+			if match := pyprojectDependencyLinePattern.FindStringSubmatch(line); match != nil {
+				if name := pySpecifierPattern.FindString(match[1]); name != "" {
+					deps = append(deps, Dependency{Name: name})
+				}
+			}
+		}
+	}
+	return deps
+}
+
+// parseTomlSectionAssignments does a line-oriented scan for a single
+// "[section]" table (Pipfile's "[packages]"/"[dev-packages]", for example)
+// and collects its "name = ..." assignments as Dependency names, stopping at
+// the next "[" table header. It doesn't attempt to parse a version out of
+// inline-table values like `name = {version = "*"}`.
+func parseTomlSectionAssignments(data []byte, section string) []Dependency {
+	var deps []Dependency
+	inSection := false
+
+	for _, rawLine := range strings.Split(string(data), "\n") {
+		line := strings.TrimSpace(rawLine)
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "["):
+			inSection = strings.Trim(line, "[]") == section
+		case inSection:
+			name, _, ok := strings.Cut(line, "=")
+			if !ok {
+				continue
+			}
+			if name = strings.TrimSpace(name); name != "" {
+				deps = append(deps, Dependency{Name: name})
+			}
+		}
+	}
+	return deps
+}
+
+// rubyEcosystemAnalyzer implements EcosystemAnalyzer for Ruby gems declared
+// in a Gemfile.
+type rubyEcosystemAnalyzer struct{}
+
+func (rubyEcosystemAnalyzer) Ecosystem() string { return "RubyGems" }
+
+func (rubyEcosystemAnalyzer) Detect(root string) bool {
+	_, err := os.Stat(filepath.Join(root, gemfile))
+	return err == nil
+}
+
+// gemLinePattern matches a Gemfile `gem "name"` or `gem "name", "version"`
+// declaration, capturing the name and an optional version requirement.
+var gemLinePattern = regexp.MustCompile(`^gem\s+['"]([^'"]+)['"](?:\s*,\s*['"]([^'"]+)['"])?`)
+
+func (rubyEcosystemAnalyzer) Parse(root string) ([]Dependency, error) {
+	data, err := os.ReadFile(filepath.Join(root, gemfile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Gemfile: %w", err)
+	}
+
+	var deps []Dependency
+	for _, rawLine := range strings.Split(string(data), "\n") {
+		line := strings.TrimSpace(rawLine)
+		if match := gemLinePattern.FindStringSubmatch(line); match != nil {
+			deps = append(deps, Dependency{Name: match[1], Version: match[2]})
+		}
+	}
+	return deps, nil
+}
+
+// rustEcosystemAnalyzer implements EcosystemAnalyzer for crates declared in
+// Cargo.toml's "[dependencies]" table.
+type rustEcosystemAnalyzer struct{}
+
+func (rustEcosystemAnalyzer) Ecosystem() string { return "crates.io" }
+
+func (rustEcosystemAnalyzer) Detect(root string) bool {
+	_, err := os.Stat(filepath.Join(root, cargoToml))
+	return err == nil
+}
+
+// cargoVersionPattern pulls the quoted version out of either a plain
+// `name = "1.0"` assignment or an inline table `name = { version = "1.0" }`.
+var cargoVersionPattern = regexp.MustCompile(`"([^"]+)"`)
+
+func (rustEcosystemAnalyzer) Parse(root string) ([]Dependency, error) {
+	data, err := os.ReadFile(filepath.Join(root, cargoToml))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Cargo.toml: %w", err)
+	}
+
+	var deps []Dependency
+	inDependencies := false
+	for _, rawLine := range strings.Split(string(data), "\n") {
+		line := strings.TrimSpace(rawLine)
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "["):
+			section := strings.Trim(line, "[]")
+			inDependencies = section == "dependencies" || section == "dev-dependencies" || section == "build-dependencies"
+		case inDependencies:
+			name, value, ok := strings.Cut(line, "=")
+			if !ok {
+				continue
+			}
+			version := ""
+			if match := cargoVersionPattern.FindStringSubmatch(value); match != nil {
+				version = match[1]
+			}
+			deps = append(deps, Dependency{Name: strings.TrimSpace(name), Version: version})
+		}
+	}
+	return deps, nil
+}
+
+// javaEcosystemAnalyzer implements EcosystemAnalyzer for Java/JVM
+// dependencies declared in a Maven pom.xml or a Gradle build.gradle.
+type javaEcosystemAnalyzer struct{}
+
+func (javaEcosystemAnalyzer) Ecosystem() string { return "Maven" }
+
+func (javaEcosystemAnalyzer) Detect(root string) bool {
+	for _, name := range []string{pomXml, buildGradle} {
+		if _, err := os.Stat(filepath.Join(root, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+type pomXMLDocument struct {
+	Dependencies struct {
+		Dependency []struct {
+			GroupID    string `xml:"groupId"`
+			ArtifactID string `xml:"artifactId"`
+			Version    string `xml:"version"`
+		} `xml:"dependency"`
+	} `xml:"dependencies"`
+}
+
+// gradleDependencyPattern matches a Gradle dependency declaration such as
+// `implementation 'com.google.guava:guava:32.1.3-jre'` or the
+// parenthesized/double-quoted equivalents, capturing the
+// "group:artifact:version" coordinate.
+var gradleDependencyPattern = regexp.MustCompile(`(?:implementation|api|compile|testImplementation|runtimeOnly)\s*[(]?\s*['"]([^'":]+):([^'":]+):([^'"]+)['"]`)
+
+func (javaEcosystemAnalyzer) Parse(root string) ([]Dependency, error) {
+	var deps []Dependency
+
+	if data, err := os.ReadFile(filepath.Join(root, pomXml)); err == nil {
+		var doc pomXMLDocument
+		if err := xml.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("failed to parse pom.xml: %w", err)
+		}
+		for _, dep := range doc.Dependencies.Dependency {
+			if dep.ArtifactID == "" {
+				continue
+			}
+			deps = append(deps, Dependency{Name: dep.GroupID + ":" + dep.ArtifactID, Version: dep.Version})
+		}
+	}
+
+	if data, err := os.ReadFile(filepath.Join(root, buildGradle)); err == nil {
+		for _, match := range gradleDependencyPattern.FindAllStringSubmatch(string(data), -1) {
+			deps = append(deps, Dependency{Name: match[1] + ":" + match[2], Version: match[3]})
+		}
+	}
+
+	return deps, nil
+}
+
+// phpEcosystemAnalyzer implements EcosystemAnalyzer for PHP packages
+// declared in composer.json's "require" and "require-dev" maps.
+type phpEcosystemAnalyzer struct{}
+
+func (phpEcosystemAnalyzer) Ecosystem() string { return "Packagist" }
+
+func (phpEcosystemAnalyzer) Detect(root string) bool {
+	_, err := os.Stat(filepath.Join(root, composerJson))
+	return err == nil
+}
+
+func (phpEcosystemAnalyzer) Parse(root string) ([]Dependency, error) {
+	data, err := os.ReadFile(filepath.Join(root, composerJson))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read composer.json: %w", err)
+	}
+
+	var manifest struct {
+		Require    map[string]string `json:"require"`
+		RequireDev map[string]string `json:"require-dev"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse composer.json: %w", err)
+	}
+
+	deps := make([]Dependency, 0, len(manifest.Require)+len(manifest.RequireDev))
+	for name, version := range manifest.Require {
+		if name == "php" || strings.HasPrefix(name, "ext-") {
+			continue // platform requirements, not installable packages
+		}
+		deps = append(deps, Dependency{Name: name, Version: version})
+	}
+	for name, version := range manifest.RequireDev {
+		deps = append(deps, Dependency{Name: name, Version: version})
+	}
+	return deps, nil
+}
@@ -0,0 +1,281 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/githealthchecker/git-health-checker/internal/config"
+)
+
+func TestGoEcosystemAnalyzer(t *testing.T) {
+	tempDir := t.TempDir()
+	goModContent := "module example.com/test\n\ngo 1.21\n\nrequire github.com/pkg/errors v0.9.1\n"
+	if err := os.WriteFile(filepath.Join(tempDir, goMod), []byte(goModContent), 0644); err != nil {
+		t.Fatalf("failed to write go.mod: %v", err)
+	}
+
+	analyzer := goEcosystemAnalyzer{}
+	if !analyzer.Detect(tempDir) {
+		t.Fatal("Detect() should find go.mod")
+	}
+
+	deps, err := analyzer.Parse(tempDir)
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+	if len(deps) != 1 || deps[0].Name != "github.com/pkg/errors" || deps[0].Version != "v0.9.1" {
+		t.Errorf("unexpected dependencies: %+v", deps)
+	}
+}
+
+func TestNpmEcosystemAnalyzer(t *testing.T) {
+	tempDir := t.TempDir()
+	content := `{"dependencies": {"lodash": "^4.17.21"}, "devDependencies": {"jest": "^29.0.0"}}`
+	if err := os.WriteFile(filepath.Join(tempDir, packageJson), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write package.json: %v", err)
+	}
+
+	analyzer := npmEcosystemAnalyzer{}
+	if !analyzer.Detect(tempDir) {
+		t.Fatal("Detect() should find package.json")
+	}
+
+	deps, err := analyzer.Parse(tempDir)
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+	if len(deps) != 2 {
+		t.Fatalf("expected 2 dependencies, got %d", len(deps))
+	}
+}
+
+func TestPythonEcosystemAnalyzer(t *testing.T) {
+	tempDir := t.TempDir()
+	reqContent := "requests==2.25.1\n# comment\ndjango>=3.0\n"
+	if err := os.WriteFile(filepath.Join(tempDir, requirementsTxt), []byte(reqContent), 0644); err != nil {
+		t.Fatalf("failed to write requirements.txt: %v", err)
+	}
+
+	analyzer := pythonEcosystemAnalyzer{}
+	if !analyzer.Detect(tempDir) {
+		t.Fatal("Detect() should find requirements.txt")
+	}
+
+	deps, err := analyzer.Parse(tempDir)
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+
+	found := make(map[string]bool)
+	for _, dep := range deps {
+		found[dep.Name] = true
+	}
+	if !found["requests"] || !found["django"] {
+		t.Errorf("expected both requests and django (unpinned included), got %+v", deps)
+	}
+}
+
+func TestPythonEcosystemAnalyzer_Pyproject(t *testing.T) {
+	tempDir := t.TempDir()
+	content := `[project]
+name = "example"
+dependencies = [
+    "requests>=2.25.1",
+    "flask==1.1.2",
+]
+`
+	if err := os.WriteFile(filepath.Join(tempDir, pyprojectToml), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write pyproject.toml: %v", err)
+	}
+
+	deps, err := (pythonEcosystemAnalyzer{}).Parse(tempDir)
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+
+	found := make(map[string]bool)
+	for _, dep := range deps {
+		found[dep.Name] = true
+	}
+	if !found["requests"] || !found["flask"] {
+		t.Errorf("expected requests and flask, got %+v", deps)
+	}
+}
+
+func TestRubyEcosystemAnalyzer(t *testing.T) {
+	tempDir := t.TempDir()
+	content := "source 'https://rubygems.org'\n\ngem 'rails', '7.0.4'\ngem \"rack\"\n"
+	if err := os.WriteFile(filepath.Join(tempDir, gemfile), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write Gemfile: %v", err)
+	}
+
+	analyzer := rubyEcosystemAnalyzer{}
+	if !analyzer.Detect(tempDir) {
+		t.Fatal("Detect() should find Gemfile")
+	}
+
+	deps, err := analyzer.Parse(tempDir)
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+	if len(deps) != 2 || deps[0].Name != "rails" || deps[0].Version != "7.0.4" || deps[1].Name != "rack" {
+		t.Errorf("unexpected dependencies: %+v", deps)
+	}
+}
+
+func TestRustEcosystemAnalyzer(t *testing.T) {
+	tempDir := t.TempDir()
+	content := `[package]
+name = "example"
+
+[dependencies]
+serde = "1.0.130"
+tokio = { version = "1.28.0", features = ["full"] }
+
+[dev-dependencies]
+criterion = "0.5.1"
+`
+	if err := os.WriteFile(filepath.Join(tempDir, cargoToml), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write Cargo.toml: %v", err)
+	}
+
+	analyzer := rustEcosystemAnalyzer{}
+	if !analyzer.Detect(tempDir) {
+		t.Fatal("Detect() should find Cargo.toml")
+	}
+
+	deps, err := analyzer.Parse(tempDir)
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+
+	found := make(map[string]string)
+	for _, dep := range deps {
+		found[dep.Name] = dep.Version
+	}
+	if found["serde"] != "1.0.130" {
+		t.Errorf("expected serde@1.0.130, got %q", found["serde"])
+	}
+	if found["tokio"] != "1.28.0" {
+		t.Errorf("expected tokio@1.28.0 from an inline table, got %q", found["tokio"])
+	}
+	if found["criterion"] != "0.5.1" {
+		t.Errorf("expected criterion from [dev-dependencies], got %q", found["criterion"])
+	}
+}
+
+func TestJavaEcosystemAnalyzer_Pom(t *testing.T) {
+	tempDir := t.TempDir()
+	content := `<project>
+  <dependencies>
+    <dependency>
+      <groupId>com.google.guava</groupId>
+      <artifactId>guava</artifactId>
+      <version>32.1.3-jre</version>
+    </dependency>
+  </dependencies>
+</project>`
+	if err := os.WriteFile(filepath.Join(tempDir, pomXml), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write pom.xml: %v", err)
+	}
+
+	analyzer := javaEcosystemAnalyzer{}
+	if !analyzer.Detect(tempDir) {
+		t.Fatal("Detect() should find pom.xml")
+	}
+
+	deps, err := analyzer.Parse(tempDir)
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+	if len(deps) != 1 || deps[0].Name != "com.google.guava:guava" || deps[0].Version != "32.1.3-jre" {
+		t.Errorf("unexpected dependencies: %+v", deps)
+	}
+}
+
+func TestJavaEcosystemAnalyzer_Gradle(t *testing.T) {
+	tempDir := t.TempDir()
+	content := "dependencies {\n    implementation 'com.google.guava:guava:32.1.3-jre'\n    testImplementation \"junit:junit:4.13.2\"\n}\n"
+	if err := os.WriteFile(filepath.Join(tempDir, buildGradle), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write build.gradle: %v", err)
+	}
+
+	deps, err := (javaEcosystemAnalyzer{}).Parse(tempDir)
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+	if len(deps) != 2 {
+		t.Fatalf("expected 2 dependencies, got %d", len(deps))
+	}
+}
+
+func TestPhpEcosystemAnalyzer(t *testing.T) {
+	tempDir := t.TempDir()
+	content := `{"require": {"php": ">=8.0", "monolog/monolog": "^2.0"}, "require-dev": {"phpunit/phpunit": "^9.0"}}`
+	if err := os.WriteFile(filepath.Join(tempDir, composerJson), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write composer.json: %v", err)
+	}
+
+	analyzer := phpEcosystemAnalyzer{}
+	if !analyzer.Detect(tempDir) {
+		t.Fatal("Detect() should find composer.json")
+	}
+
+	deps, err := analyzer.Parse(tempDir)
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+
+	found := make(map[string]bool)
+	for _, dep := range deps {
+		found[dep.Name] = true
+	}
+	if found["php"] {
+		t.Error("platform requirement 'php' should be skipped")
+	}
+	if !found["monolog/monolog"] || !found["phpunit/phpunit"] {
+		t.Errorf("expected monolog/monolog and phpunit/phpunit, got %+v", deps)
+	}
+}
+
+func TestDependencyAnalyzer_AnalyzeEcosystemBlockedPackages(t *testing.T) {
+	tempDir := t.TempDir()
+	content := `{"require": {"monolog/monolog": "^2.0"}}`
+	if err := os.WriteFile(filepath.Join(tempDir, composerJson), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write composer.json: %v", err)
+	}
+
+	cfg := &config.DependencyConfig{BlockedPackages: []string{"monolog/monolog"}}
+	analyzer := NewDependencyAnalyzer(cfg, tempDir)
+
+	issues, err := analyzer.analyzeEcosystemBlockedPackages()
+	if err != nil {
+		t.Fatalf("analyzeEcosystemBlockedPackages() failed: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Rule != "blocked-dependencies" {
+		t.Fatalf("expected a single blocked-dependencies issue, got %+v", issues)
+	}
+}
+
+func TestDependencyAnalyzer_AnalyzeEcosystemBlockedPackages_RespectsDisabledEcosystems(t *testing.T) {
+	tempDir := t.TempDir()
+	content := `{"require": {"monolog/monolog": "^2.0"}}`
+	if err := os.WriteFile(filepath.Join(tempDir, composerJson), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write composer.json: %v", err)
+	}
+
+	cfg := &config.DependencyConfig{
+		BlockedPackages:    []string{"monolog/monolog"},
+		DisabledEcosystems: []string{"packagist"},
+	}
+	analyzer := NewDependencyAnalyzer(cfg, tempDir)
+
+	issues, err := analyzer.analyzeEcosystemBlockedPackages()
+	if err != nil {
+		t.Fatalf("analyzeEcosystemBlockedPackages() failed: %v", err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("expected 0 issues with Packagist disabled, got %+v", issues)
+	}
+}
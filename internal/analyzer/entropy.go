@@ -0,0 +1,111 @@
+package analyzer
+
+import (
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/githealthchecker/git-health-checker/internal/config"
+)
+
+// shannonEntropy computes H = -Σ p(c) log2 p(c) over s's character
+// distribution, in bits per character. A short, repetitive, or
+// low-cardinality string (e.g. "aaaa" or "password") scores near zero; a
+// random-looking key scores close to log2(alphabet size).
+func shannonEntropy(s string) float64 {
+	if len(s) == 0 {
+		return 0
+	}
+
+	counts := make(map[rune]int)
+	for _, r := range s {
+		counts[r]++
+	}
+
+	total := float64(len(s))
+	var entropy float64
+	for _, count := range counts {
+		p := float64(count) / total
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+var (
+	hexCandidatePattern    = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+	base64CandidatePattern = regexp.MustCompile(`^[A-Za-z0-9+/]+={0,2}$`)
+)
+
+// classifySecretAlphabet picks the character class a candidate value
+// belongs to, so its entropy can be judged against the right threshold: hex
+// digests and base64-encoded keys have very different expected entropy for
+// the same "this looks random" intuition.
+func classifySecretAlphabet(s string) string {
+	switch {
+	case hexCandidatePattern.MatchString(s):
+		return "hex"
+	case base64CandidatePattern.MatchString(s):
+		return "base64"
+	default:
+		return "other"
+	}
+}
+
+// entropyThreshold returns the configured minimum entropy for alphabet, and
+// whether that alphabet has a configured threshold at all ("other" doesn't,
+// since free-form text has no meaningful entropy floor to gate on).
+func entropyThreshold(cfg *config.SecurityConfig, alphabet string) (float64, bool) {
+	switch alphabet {
+	case "hex":
+		return cfg.MinEntropyHex, true
+	case "base64":
+		return cfg.MinEntropyBase64, true
+	default:
+		return 0, false
+	}
+}
+
+// extractCandidateValue pulls the value portion out of a secret-pattern
+// match: the right-hand side of the last `=`/`:`, or the whole match quoted
+// value when neither separator is present, with surrounding quotes/spaces
+// trimmed so entropy is computed over the actual value, not the key name.
+func extractCandidateValue(match string) string {
+	trimmed := strings.TrimSpace(match)
+	if idx := strings.LastIndexAny(trimmed, "=:"); idx != -1 {
+		trimmed = strings.TrimSpace(trimmed[idx+1:])
+	}
+	return strings.Trim(trimmed, `'"`)
+}
+
+// highEntropyLiteralPattern finds base64-/hex-shaped runs long enough to be
+// worth entropy-scoring, independent of any KEY=/secret: prefix, so keys
+// pasted without a recognizable label are still caught.
+var highEntropyLiteralPattern = regexp.MustCompile(`[A-Za-z0-9+/]{20,}={0,2}|[0-9a-fA-F]{20,}`)
+
+// entropyResult bundles the candidate's computed entropy with whether it
+// clears the configured bar, so callers can both gate on it and record it
+// in Issue.Metadata without recomputing.
+type entropyResult struct {
+	value    string
+	alphabet string
+	entropy  float64
+	passes   bool
+}
+
+// scoreEntropy judges match's candidate value against cfg's thresholds. A
+// candidate below threshold is still kept if it's at least
+// MinHighEntropyLength characters long, since a long low-entropy string
+// (e.g. a base64 blob with padding) can still be a real secret.
+func scoreEntropy(cfg *config.SecurityConfig, match string) entropyResult {
+	candidate := extractCandidateValue(match)
+	alphabet := classifySecretAlphabet(candidate)
+	entropy := shannonEntropy(candidate)
+
+	threshold, hasThreshold := entropyThreshold(cfg, alphabet)
+	passes := true
+	if hasThreshold && entropy < threshold && len(candidate) < cfg.MinHighEntropyLength {
+		passes = false
+	}
+
+	return entropyResult{value: candidate, alphabet: alphabet, entropy: entropy, passes: passes}
+}
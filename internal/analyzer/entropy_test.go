@@ -0,0 +1,96 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/githealthchecker/git-health-checker/internal/config"
+)
+
+func TestShannonEntropy(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		minWant float64
+		maxWant float64
+	}{
+		{"empty", "", 0, 0},
+		{"all same char", "aaaaaaaaaa", 0, 0},
+		{"low cardinality word", "password", 0, 3},
+		{"random-looking base64", "aG93TXVjaFdvb2RDb3VsZEFXb29kY2h1Y2s=", 3.5, 4.5},
+	}
+
+	for _, test := range tests {
+		got := shannonEntropy(test.value)
+		if got < test.minWant || got > test.maxWant {
+			t.Errorf("%s: shannonEntropy(%q) = %.2f, want between %.2f and %.2f",
+				test.name, test.value, got, test.minWant, test.maxWant)
+		}
+	}
+}
+
+func TestClassifySecretAlphabet(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{"deadbeef1234", "hex"},
+		{"aG93TXVjaFdvb2Q=", "base64"},
+		{"not valid! text", "other"},
+	}
+
+	for _, test := range tests {
+		result := classifySecretAlphabet(test.value)
+		if result != test.expected {
+			t.Errorf("classifySecretAlphabet(%q) = %s, want %s", test.value, result, test.expected)
+		}
+	}
+}
+
+func TestExtractCandidateValue(t *testing.T) {
+	tests := []struct {
+		match    string
+		expected string
+	}{
+		{`api_key = "sk_test_1234567890abcdef"`, "sk_test_1234567890abcdef"},
+		{`token: 'abc123def456'`, "abc123def456"},
+		{"just-a-value", "just-a-value"},
+	}
+
+	for _, test := range tests {
+		result := extractCandidateValue(test.match)
+		if result != test.expected {
+			t.Errorf("extractCandidateValue(%q) = %q, want %q", test.match, result, test.expected)
+		}
+	}
+}
+
+func TestScoreEntropy_DropsLowEntropyShortValues(t *testing.T) {
+	cfg := &config.SecurityConfig{
+		MinEntropyBase64:    4.5,
+		MinEntropyHex:       3.0,
+		MinHighEntropyLength: 20,
+	}
+
+	lowEntropy := scoreEntropy(cfg, `password = "aaaaaaaa"`)
+	if lowEntropy.passes {
+		t.Error("expected a short, low-entropy value to fail the entropy filter")
+	}
+
+	highEntropy := scoreEntropy(cfg, `secret = "Tl9kPq2xRzW8mVbY4cAeJfUo"`)
+	if !highEntropy.passes {
+		t.Errorf("expected a high-entropy base64-shaped value to pass, got entropy %.2f", highEntropy.entropy)
+	}
+}
+
+func TestScoreEntropy_KeepsLongValuesRegardlessOfEntropy(t *testing.T) {
+	cfg := &config.SecurityConfig{
+		MinEntropyBase64:    4.5,
+		MinEntropyHex:       3.0,
+		MinHighEntropyLength: 5,
+	}
+
+	result := scoreEntropy(cfg, `token = "aaaaaaaaaaaaaaaaaaaa"`)
+	if !result.passes {
+		t.Error("expected a value at least MinHighEntropyLength long to pass even with low entropy")
+	}
+}
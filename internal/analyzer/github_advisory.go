@@ -0,0 +1,299 @@
+package analyzer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const githubGraphQLURL = "https://api.github.com/graphql"
+
+// githubAdvisoryEcosystems maps an osvModule.Ecosystem onto the
+// SecurityAdvisoryEcosystem enum GitHub's GraphQL API expects. Ecosystems
+// with no GitHub Advisory equivalent are simply skipped.
+var githubAdvisoryEcosystems = map[string]string{
+	"Go":   "GO",
+	"npm":  "NPM",
+	"PyPI": "PIP",
+}
+
+const githubSecurityVulnerabilitiesQuery = `query($ecosystem: SecurityAdvisoryEcosystem!, $package: String!) {
+  securityVulnerabilities(ecosystem: $ecosystem, package: $package, first: 25) {
+    nodes {
+      advisory {
+        summary
+        description
+        severity
+        identifiers { type value }
+      }
+      vulnerableVersionRange
+      firstPatchedVersion { identifier }
+    }
+  }
+}`
+
+type githubGraphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type githubSecurityVulnerabilitiesResponse struct {
+	Data struct {
+		SecurityVulnerabilities struct {
+			Nodes []githubVulnerabilityNode `json:"nodes"`
+		} `json:"securityVulnerabilities"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+type githubVulnerabilityNode struct {
+	Advisory struct {
+		Summary     string `json:"summary"`
+		Description string `json:"description"`
+		Severity    string `json:"severity"`
+		Identifiers []struct {
+			Type  string `json:"type"`
+			Value string `json:"value"`
+		} `json:"identifiers"`
+	} `json:"advisory"`
+	VulnerableVersionRange string `json:"vulnerableVersionRange"`
+	FirstPatchedVersion    *struct {
+		Identifier string `json:"identifier"`
+	} `json:"firstPatchedVersion"`
+}
+
+// githubAdvisoryBackend is the vulnerabilityBackend backed by GitHub's
+// Security Advisory GraphQL API, queried one package at a time since
+// securityVulnerabilities takes a single ecosystem/package pair per call.
+type githubAdvisoryBackend struct {
+	token   string
+	url     string
+	retrier *httpRetrier
+}
+
+func newGitHubAdvisoryBackend(token string) *githubAdvisoryBackend {
+	return &githubAdvisoryBackend{
+		token:   token,
+		url:     githubGraphQLURL,
+		retrier: newHTTPRetrier(),
+	}
+}
+
+func (b *githubAdvisoryBackend) QueryBatch(modules []osvModule) (map[osvModule][]osvRecord, error) {
+	results := make(map[osvModule][]osvRecord)
+
+	for _, mod := range modules {
+		ghEcosystem, ok := githubAdvisoryEcosystems[mod.Ecosystem]
+		if !ok {
+			continue
+		}
+
+		records, err := b.queryModule(mod, ghEcosystem)
+		if err != nil {
+			return nil, err
+		}
+		if len(records) > 0 {
+			results[mod] = records
+		}
+	}
+
+	return results, nil
+}
+
+func (b *githubAdvisoryBackend) queryModule(mod osvModule, ghEcosystem string) ([]osvRecord, error) {
+	reqBody, err := json.Marshal(githubGraphQLRequest{
+		Query: githubSecurityVulnerabilitiesQuery,
+		Variables: map[string]interface{}{
+			"ecosystem": ghEcosystem,
+			"package":   mod.Name,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GitHub advisory query for %s: %w", mod.Name, err)
+	}
+
+	headers := map[string]string{"Content-Type": "application/json"}
+	if b.token != "" {
+		headers["Authorization"] = "Bearer " + b.token
+	}
+
+	respBody, err := b.retrier.do(http.MethodPost, b.url, headers, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("GitHub advisory query failed for %s: %w", mod.Name, err)
+	}
+
+	var parsed githubSecurityVulnerabilitiesResponse
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to decode GitHub advisory response for %s: %w", mod.Name, err)
+	}
+	if len(parsed.Errors) > 0 {
+		return nil, fmt.Errorf("GitHub advisory API error for %s: %s", mod.Name, parsed.Errors[0].Message)
+	}
+
+	var records []osvRecord
+	for _, node := range parsed.Data.SecurityVulnerabilities.Nodes {
+		if !versionMatchesRange(mod.Version, node.VulnerableVersionRange) {
+			continue
+		}
+		record, err := githubNodeToRecord(node)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert GitHub advisory for %s: %w", mod.Name, err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// versionMatchesRange reports whether version satisfies every
+// comma-separated constraint in a GitHub "vulnerableVersionRange" string,
+// e.g. ">= 1.0.0, < 2.0.0".
+func versionMatchesRange(version, rangeExpr string) bool {
+	if rangeExpr == "" {
+		return true
+	}
+
+	for _, constraint := range strings.Split(rangeExpr, ",") {
+		constraint = strings.TrimSpace(constraint)
+		if constraint == "" {
+			continue
+		}
+		if !versionSatisfiesConstraint(version, constraint) {
+			return false
+		}
+	}
+	return true
+}
+
+func versionSatisfiesConstraint(version, constraint string) bool {
+	for _, op := range []string{">=", "<=", "!=", ">", "<", "="} {
+		if !strings.HasPrefix(constraint, op) {
+			continue
+		}
+
+		target := strings.TrimSpace(strings.TrimPrefix(constraint, op))
+		cmp := compareVersions(version, target)
+		switch op {
+		case ">=":
+			return cmp >= 0
+		case "<=":
+			return cmp <= 0
+		case "!=":
+			return cmp != 0
+		case ">":
+			return cmp > 0
+		case "<":
+			return cmp < 0
+		case "=":
+			return cmp == 0
+		}
+	}
+	return true
+}
+
+// githubNodeToRecord converts a GitHub advisory node into an osvRecord, by
+// marshalling it into the same JSON shape osvRecord already decodes from
+// OSV, so downstream severity/fix mapping (cvssToSeverity, firstFixedVersion,
+// osvIssue) stays backend-agnostic.
+func githubNodeToRecord(node githubVulnerabilityNode) (osvRecord, error) {
+	id := ""
+	for _, identifier := range node.Advisory.Identifiers {
+		if identifier.Type == "GHSA" {
+			id = identifier.Value
+			break
+		}
+	}
+	if id == "" && len(node.Advisory.Identifiers) > 0 {
+		id = node.Advisory.Identifiers[0].Value
+	}
+
+	var fixedVersion string
+	if node.FirstPatchedVersion != nil {
+		fixedVersion = node.FirstPatchedVersion.Identifier
+	}
+
+	doc := struct {
+		ID       string   `json:"id"`
+		Aliases  []string `json:"aliases"`
+		Summary  string   `json:"summary"`
+		Details  string   `json:"details"`
+		Severity []struct {
+			Type  string `json:"type"`
+			Score string `json:"score"`
+		} `json:"severity"`
+		Affected []struct {
+			Ranges []struct {
+				Type   string `json:"type"`
+				Events []struct {
+					Introduced string `json:"introduced,omitempty"`
+					Fixed      string `json:"fixed,omitempty"`
+				} `json:"events"`
+			} `json:"ranges"`
+		} `json:"affected"`
+	}{
+		ID:      id,
+		Summary: node.Advisory.Summary,
+		Details: node.Advisory.Description,
+	}
+	doc.Severity = append(doc.Severity, struct {
+		Type  string `json:"type"`
+		Score string `json:"score"`
+	}{Type: "CVSS_V3", Score: fmt.Sprintf("%.1f", githubSeverityScore(node.Advisory.Severity))})
+	doc.Affected = append(doc.Affected, struct {
+		Ranges []struct {
+			Type   string `json:"type"`
+			Events []struct {
+				Introduced string `json:"introduced,omitempty"`
+				Fixed      string `json:"fixed,omitempty"`
+			} `json:"events"`
+		} `json:"ranges"`
+	}{})
+	doc.Affected[0].Ranges = append(doc.Affected[0].Ranges, struct {
+		Type   string `json:"type"`
+		Events []struct {
+			Introduced string `json:"introduced,omitempty"`
+			Fixed      string `json:"fixed,omitempty"`
+		} `json:"events"`
+	}{Type: "SEMVER"})
+	doc.Affected[0].Ranges[0].Events = append(doc.Affected[0].Ranges[0].Events, struct {
+		Introduced string `json:"introduced,omitempty"`
+		Fixed      string `json:"fixed,omitempty"`
+	}{Introduced: "0"})
+	if fixedVersion != "" {
+		doc.Affected[0].Ranges[0].Events = append(doc.Affected[0].Ranges[0].Events, struct {
+			Introduced string `json:"introduced,omitempty"`
+			Fixed      string `json:"fixed,omitempty"`
+		}{Fixed: fixedVersion})
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return osvRecord{}, err
+	}
+
+	var record osvRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return osvRecord{}, err
+	}
+	return record, nil
+}
+
+// githubSeverityScore maps GitHub's qualitative advisory severity onto a
+// representative CVSS base score, so cvssToSeverity can map every backend's
+// records through the same severity scale.
+func githubSeverityScore(severity string) float64 {
+	switch strings.ToUpper(severity) {
+	case "CRITICAL":
+		return 9.8
+	case "HIGH":
+		return 7.5
+	case "MODERATE":
+		return 5.3
+	case "LOW":
+		return 2.0
+	default:
+		return 5.3
+	}
+}
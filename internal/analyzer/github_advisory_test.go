@@ -0,0 +1,131 @@
+package analyzer
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+func TestGitHubAdvisoryBackend_QueryBatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Fatalf("expected Authorization header, got %q", got)
+		}
+
+		var req githubGraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode GraphQL request: %v", err)
+		}
+		if req.Variables["ecosystem"] != "GO" || req.Variables["package"] != "example.com/vuln" {
+			t.Fatalf("unexpected GraphQL variables: %+v", req.Variables)
+		}
+
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"securityVulnerabilities": {
+					"nodes": [{
+						"advisory": {
+							"summary": "Example vulnerability",
+							"description": "details here",
+							"severity": "HIGH",
+							"identifiers": [{"type": "GHSA", "value": "GHSA-test-9999"}]
+						},
+						"vulnerableVersionRange": ">= 1.0.0, < 1.5.0",
+						"firstPatchedVersion": {"identifier": "1.5.0"}
+					}]
+				}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	backend := newGitHubAdvisoryBackend("test-token")
+	backend.url = server.URL
+
+	mod := osvModule{Ecosystem: "Go", Name: "example.com/vuln", Version: "1.2.0"}
+	results, err := backend.QueryBatch([]osvModule{mod})
+	if err != nil {
+		t.Fatalf("QueryBatch() failed: %v", err)
+	}
+
+	records := results[mod]
+	if len(records) != 1 {
+		t.Fatalf("expected 1 matching record, got %d", len(records))
+	}
+	if records[0].ID != "GHSA-test-9999" {
+		t.Errorf("expected ID GHSA-test-9999, got %s", records[0].ID)
+	}
+	if severity := cvssToSeverity(records[0].Severity); severity != report.SeverityHigh {
+		t.Errorf("expected HIGH severity to map to SeverityHigh, got %s", severity)
+	}
+	if fixed := firstFixedVersion(records[0]); fixed != "1.5.0" {
+		t.Errorf("expected fixed version 1.5.0, got %s", fixed)
+	}
+}
+
+func TestGitHubAdvisoryBackend_QueryBatch_OutOfRangeVersionSkipped(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"securityVulnerabilities": {
+					"nodes": [{
+						"advisory": {
+							"summary": "Example vulnerability",
+							"severity": "HIGH",
+							"identifiers": [{"type": "GHSA", "value": "GHSA-test-9999"}]
+						},
+						"vulnerableVersionRange": "< 1.0.0"
+					}]
+				}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	backend := newGitHubAdvisoryBackend("")
+	backend.url = server.URL
+
+	mod := osvModule{Ecosystem: "Go", Name: "example.com/vuln", Version: "2.0.0"}
+	results, err := backend.QueryBatch([]osvModule{mod})
+	if err != nil {
+		t.Fatalf("QueryBatch() failed: %v", err)
+	}
+	if len(results[mod]) != 0 {
+		t.Errorf("expected the out-of-range advisory to be filtered out, got %+v", results[mod])
+	}
+}
+
+func TestVersionMatchesRange(t *testing.T) {
+	tests := []struct {
+		version  string
+		rng      string
+		expected bool
+	}{
+		{"1.2.0", ">= 1.0.0, < 1.5.0", true},
+		{"1.5.0", ">= 1.0.0, < 1.5.0", false},
+		{"0.9.0", ">= 1.0.0, < 1.5.0", false},
+		{"1.0.0", "", true},
+	}
+
+	for _, test := range tests {
+		if got := versionMatchesRange(test.version, test.rng); got != test.expected {
+			t.Errorf("versionMatchesRange(%s, %q) = %v, expected %v", test.version, test.rng, got, test.expected)
+		}
+	}
+}
+
+func TestGitHubAdvisoryBackend_SkipsUnmappedEcosystem(t *testing.T) {
+	backend := newGitHubAdvisoryBackend("")
+
+	mod := osvModule{Ecosystem: "crates.io", Name: "example", Version: "1.0.0"}
+	results, err := backend.QueryBatch([]osvModule{mod})
+	if err != nil {
+		t.Fatalf("QueryBatch() failed: %v", err)
+	}
+	if len(results) != 0 {
+		t.Errorf("expected unmapped ecosystem to be skipped, got %+v", results)
+	}
+}
@@ -0,0 +1,241 @@
+package analyzer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/githealthchecker/git-health-checker/internal/config"
+	"github.com/githealthchecker/git-health-checker/internal/git"
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+// historyBlobSizeLimit caps how large a blob HistorySecretAnalyzer will read
+// into memory, the same defensive bound SecurityAnalyzer applies implicitly
+// by only scanning cached (size-limited) files.
+const historyBlobSizeLimit = 5 * 1024 * 1024
+
+// HistorySecretAnalyzer runs SecurityAnalyzer's secret patterns, structured
+// content rules, and entropy scoring against every blob ever committed to
+// the repository's history, not just the files present in HEAD. A
+// credential that was committed and later deleted is invisible to
+// SecurityAnalyzer's point-in-time scan but still lives on in a historical
+// blob, the same gap detect-secrets' "scan the whole history" mode closes.
+//
+// It's opt-in via `check --scan-history`, since walking every commit is far
+// more expensive than scanning the working tree once. Each returned Issue
+// carries a "content_hash" Metadata entry keyed the same way
+// baseline.HistoryBaseline triages findings, plus the introducing commit's
+// Author/AuthorEmail/LastModified/CommitHash.
+type HistorySecretAnalyzer struct {
+	config           *config.SecurityConfig
+	repo             *git.Repository
+	compiledPatterns []*regexp.Regexp
+	structuredRules  []*compiledRule
+}
+
+// NewHistorySecretAnalyzer builds a HistorySecretAnalyzer, pre-compiling
+// cfg's SecretPatterns and the contents-part structured rules the same way
+// NewSecurityAnalyzer does, so every commit visited reuses the same
+// compiled patterns rather than recompiling them per blob.
+func NewHistorySecretAnalyzer(cfg *config.SecurityConfig, repo *git.Repository) *HistorySecretAnalyzer {
+	a := &HistorySecretAnalyzer{config: cfg, repo: repo}
+
+	for _, pattern := range cfg.SecretPatterns {
+		if compiled, err := regexp.Compile(pattern); err == nil {
+			a.compiledPatterns = append(a.compiledPatterns, compiled)
+		}
+	}
+
+	for _, rule := range cfg.Rules {
+		if rule.Part == config.RulePartContents {
+			a.structuredRules = append(a.structuredRules, compileSecretRule(rule))
+		}
+	}
+
+	return a
+}
+
+// HashSecretContent derives the content hash carried on every Issue's
+// "content_hash" Metadata entry, so a baseline can recognize the same secret
+// value reappearing at a different commit or line as already-triaged.
+func HashSecretContent(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// Analyze walks commit history from HEAD back to sinceRef (exclusive), or
+// the full history if sinceRef is empty, scanning every blob introduced
+// along the way. A blob already seen at an earlier (newer) commit is never
+// rescanned, since its content - and therefore any secret in it - hasn't
+// changed; the issue it produces still carries the commit that introduced
+// it, i.e. the first commit WalkCommits visits carrying that blob.
+func (a *HistorySecretAnalyzer) Analyze(ctx context.Context, sinceRef string) ([]report.Issue, error) {
+	var sinceHash plumbing.Hash
+	if sinceRef != "" {
+		hash, err := a.repo.ResolveRevision(sinceRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve --since ref %q: %w", sinceRef, err)
+		}
+		sinceHash = hash
+	}
+
+	seenBlobs := make(map[plumbing.Hash]bool)
+	var issues []report.Issue
+
+	err := a.repo.WalkCommits(ctx, git.CommitWalkOpts{}, func(commit *object.Commit) error {
+		if sinceRef != "" && commit.Hash == sinceHash {
+			return git.ErrStopWalk
+		}
+
+		found, err := a.scanCommit(commit, seenBlobs)
+		if err != nil {
+			return err
+		}
+		issues = append(issues, found...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk commit history: %w", err)
+	}
+
+	return issues, nil
+}
+
+// scanCommit scans every blob in commit's tree that hasn't already been
+// seen at a newer commit, returning one Issue per match.
+func (a *HistorySecretAnalyzer) scanCommit(commit *object.Commit, seenBlobs map[plumbing.Hash]bool) ([]report.Issue, error) {
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tree for commit %s: %w", commit.Hash, err)
+	}
+
+	var issues []report.Issue
+
+	fileIter := tree.Files()
+	defer fileIter.Close()
+
+	err = fileIter.ForEach(func(f *object.File) error {
+		if seenBlobs[f.Blob.Hash] {
+			return nil
+		}
+		seenBlobs[f.Blob.Hash] = true
+
+		if f.Size > historyBlobSizeLimit || f.Size == 0 {
+			return nil
+		}
+
+		content, err := f.Contents()
+		if err != nil || !looksLikeText(content) {
+			return nil
+		}
+
+		issues = append(issues, a.scanBlobContent(content, f.Name, commit)...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate files for commit %s: %w", commit.Hash, err)
+	}
+
+	return issues, nil
+}
+
+// looksLikeText applies the same null-byte heuristic FileScanner uses to
+// skip binaries, so historical blobs of compiled artifacts or images aren't
+// scanned line-by-line for "secrets".
+func looksLikeText(content string) bool {
+	return !strings.ContainsRune(content, 0)
+}
+
+// scanBlobContent runs the legacy SecretPatterns, the contents-part
+// structured rules, and the standalone high-entropy-string check against a
+// single historical blob, line by line - the same three passes
+// SecurityAnalyzer runs over HEAD's files.
+func (a *HistorySecretAnalyzer) scanBlobContent(content, filePath string, commit *object.Commit) []report.Issue {
+	var issues []report.Issue
+
+	for lineNum, line := range strings.Split(content, "\n") {
+		for _, pattern := range a.compiledPatterns {
+			for _, match := range pattern.FindAllString(line, -1) {
+				if isAllowedSecretValue(a.config, match) {
+					continue
+				}
+				issues = append(issues, a.historyIssue(
+					"history-secret-detection", "Potential secret detected in git history",
+					fmt.Sprintf("Found pattern that may contain credentials: %s", truncateString(match, 80)),
+					"Use environment variables or secure secret management",
+					determineSecretSeverity(match), filePath, lineNum+1, match, commit))
+			}
+		}
+
+		for _, cr := range a.structuredRules {
+			for _, match := range cr.findMatches(line) {
+				if cr.isAllowed(filePath, match) || isAllowedSecretValue(a.config, match) {
+					continue
+				}
+				fix := cr.rule.Fix
+				if fix == "" {
+					fix = "Use environment variables or a secret manager instead of committing credentials"
+				}
+				issues = append(issues, a.historyIssue(
+					cr.rule.ID, "Potential secret detected in git history",
+					fmt.Sprintf("%s: %s", cr.rule.Name, truncateString(match, 80)), fix,
+					cr.rule.Severity, filePath, lineNum+1, match, commit))
+			}
+		}
+
+		if !a.config.EntropyFiltering {
+			continue
+		}
+		for _, literal := range highEntropyLiteralPattern.FindAllString(line, -1) {
+			if len(literal) < a.config.MinHighEntropyLength || isAllowedSecretValue(a.config, literal) {
+				continue
+			}
+			alphabet := classifySecretAlphabet(literal)
+			threshold, hasThreshold := entropyThreshold(a.config, alphabet)
+			if !hasThreshold || shannonEntropy(literal) < threshold {
+				continue
+			}
+			issues = append(issues, a.historyIssue(
+				"high-entropy-string", "High-entropy string detected in git history",
+				fmt.Sprintf("Found a high-entropy %s literal that may be an unlabeled credential: %s", alphabet, truncateString(literal, 80)),
+				"Verify this value isn't a credential; if it is, move it to environment variables or a secret manager",
+				report.SeverityLow, filePath, lineNum+1, literal, commit))
+		}
+	}
+
+	return issues
+}
+
+// historyIssue builds the report.Issue for a single historical finding,
+// attributing it to the commit that introduced the blob rather than HEAD -
+// unlike enrichBlame's --no-blame-gated attribution of live issues, a
+// history finding's author and commit are part of what it reports, so
+// they're always set and enrichBlame skips over them (see cmd/check.go).
+func (a *HistorySecretAnalyzer) historyIssue(rule, title, description, fix string, severity report.Severity, filePath string, line int, matchedContent string, commit *object.Commit) report.Issue {
+	shortHash := commit.Hash.String()[:8]
+	return report.Issue{
+		ID:           fmt.Sprintf("%s-%s-%s-%d", rule, shortHash, strings.ReplaceAll(filePath, "/", "-"), line),
+		Title:        title,
+		Description:  fmt.Sprintf("%s (introduced in commit %s)", description, shortHash),
+		Category:     report.CategorySecurity,
+		Severity:     severity,
+		File:         filePath,
+		Line:         line,
+		Rule:         rule,
+		Fix:          fix,
+		Metadata:     map[string]string{"content_hash": HashSecretContent(matchedContent)},
+		Author:       commit.Author.Name,
+		AuthorEmail:  commit.Author.Email,
+		LastModified: commit.Author.When,
+		CommitHash:   commit.Hash.String(),
+		CreatedAt:    time.Now(),
+	}
+}
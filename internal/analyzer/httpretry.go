@@ -0,0 +1,79 @@
+package analyzer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+var jsonHeaders = map[string]string{"Content-Type": "application/json"}
+
+// httpRetrier issues HTTP requests with exponential backoff on 429 (rate
+// limited) and 5xx responses, shared by the OSV and GitHub Advisory
+// vulnerability backends so neither has to hand-roll its own retry loop.
+type httpRetrier struct {
+	client         *http.Client
+	maxRetries     int
+	initialBackoff time.Duration
+}
+
+func newHTTPRetrier() *httpRetrier {
+	return &httpRetrier{
+		client:         &http.Client{Timeout: 15 * time.Second},
+		maxRetries:     3,
+		initialBackoff: 200 * time.Millisecond,
+	}
+}
+
+// do sends a single request, retrying on 429 or 5xx responses with
+// exponential backoff, and returns the response body on a 200.
+func (r *httpRetrier) do(method, url string, headers map[string]string, body []byte) ([]byte, error) {
+	backoff := r.initialBackoff
+
+	var lastErr error
+	for attempt := 0; attempt <= r.maxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+
+		var reader io.Reader
+		if body != nil {
+			reader = bytes.NewReader(body)
+		}
+
+		req, err := http.NewRequest(method, url, reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build request to %s: %w", url, err)
+		}
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+
+		resp, err := r.client.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		respBody, readErr := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if readErr != nil {
+			lastErr = readErr
+			continue
+		}
+
+		if resp.StatusCode == http.StatusOK {
+			return respBody, nil
+		}
+
+		lastErr = fmt.Errorf("request to %s returned status %d", url, resp.StatusCode)
+		if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < 500 {
+			return nil, lastErr
+		}
+	}
+
+	return nil, fmt.Errorf("request to %s failed after %d attempts: %w", url, r.maxRetries+1, lastErr)
+}
@@ -0,0 +1,454 @@
+package analyzer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/githealthchecker/git-health-checker/internal/baseline"
+	"github.com/githealthchecker/git-health-checker/internal/config"
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+// defaultLinterConcurrency bounds how many linters run at once when
+// LintersConfig.Concurrency isn't set.
+const defaultLinterConcurrency = 4
+
+// lineFormatPattern matches the "file:line[:col]: message" shape most
+// linters emit in their default or unix/gcc-style text output - e.g. go
+// vet's "./main.go:10:5: unused variable", hadolint's "Dockerfile:3 DL3006
+// warning: ...", or eslint --format unix's "file.js:1:1: message [rule]".
+var lineFormatPattern = regexp.MustCompile(`^([^:\n]+):(\d+)(?::(\d+))?:?\s*(.+)$`)
+
+// LintersAnalyzer runs the configured external linters against whichever
+// languages CodeStatsAnalyzer found present in the repository, replacing the
+// old Go-only GoWarningsAnalyzer with a pluggable, polyglot subsystem. A
+// linter whose binary isn't installed is skipped rather than failing the
+// scan.
+type LintersAnalyzer struct {
+	cfg       *config.LintersConfig
+	repoPath  string
+	languages map[string]bool
+}
+
+// NewLintersAnalyzer builds a LintersAnalyzer scoped to languages, the set
+// of language names (CodeStatsAnalyzer.LanguageBreakdown keys, e.g. "Go",
+// "Python") detected in the repository.
+func NewLintersAnalyzer(cfg *config.LintersConfig, repoPath string, languages []string) *LintersAnalyzer {
+	present := make(map[string]bool, len(languages))
+	for _, lang := range languages {
+		present[lang] = true
+	}
+
+	return &LintersAnalyzer{
+		cfg:       cfg,
+		repoPath:  repoPath,
+		languages: present,
+	}
+}
+
+func (a *LintersAnalyzer) Analyze() ([]report.Issue, error) {
+	if !a.cfg.Enabled {
+		return nil, nil
+	}
+
+	var toRun []config.LinterConfig
+	for _, linter := range a.cfg.Linters {
+		if linter.Enabled && a.appliesTo(linter) {
+			toRun = append(toRun, linter)
+		}
+	}
+
+	concurrency := a.cfg.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultLinterConcurrency
+	}
+
+	results := make([][]report.Issue, len(toRun))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, linter := range toRun {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, linter config.LinterConfig) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = a.runOne(linter)
+		}(i, linter)
+	}
+	wg.Wait()
+
+	var issues []report.Issue
+	for _, found := range results {
+		issues = append(issues, found...)
+	}
+
+	return dedupeIssues(issues), nil
+}
+
+// runOne runs a single linter end to end: a low-severity tool-missing
+// diagnostic if its binary isn't on PATH (rather than a silent skip, so a
+// profile that enables a tool nobody installed finds out why it got no
+// findings from it), or its parsed output otherwise.
+func (a *LintersAnalyzer) runOne(linter config.LinterConfig) []report.Issue {
+	if _, err := exec.LookPath(linter.Command); err != nil {
+		return []report.Issue{a.toolMissingIssue(linter)}
+	}
+
+	output, err := a.run(linter)
+	if err != nil && output == "" {
+		return nil // most linters exit non-zero purely because they found something; an empty output alongside an error means it didn't run at all
+	}
+
+	return a.parse(linter, output)
+}
+
+// toolMissingIssue records that linter is enabled but its binary wasn't
+// found on PATH, so a missing install doesn't silently look like a clean
+// bill of health.
+func (a *LintersAnalyzer) toolMissingIssue(linter config.LinterConfig) report.Issue {
+	return report.Issue{
+		ID:          fmt.Sprintf("tool-missing-%s", linter.Name),
+		Title:       "External tool not found on PATH",
+		Description: fmt.Sprintf("%s is enabled in linters.linters but its binary (%s) wasn't found on PATH, so its checks were skipped", linter.Name, linter.Command),
+		Category:    report.CategoryQuality,
+		Severity:    report.SeverityLow,
+		Rule:        "tool-missing",
+		Fix:         fmt.Sprintf("Install %s, or disable it in linters.linters if it's not meant to run here", linter.Command),
+		CreatedAt:   time.Now(),
+	}
+}
+
+// dedupeIssues drops duplicate findings from the same (file, line, column,
+// rule) quadruple, keeping the first one seen. Two enabled linters can
+// legitimately report the same position - go vet and a golangci-lint
+// profile that also runs vet, say - and a caller shouldn't see it twice.
+func dedupeIssues(issues []report.Issue) []report.Issue {
+	seen := make(map[string]bool, len(issues))
+	deduped := make([]report.Issue, 0, len(issues))
+
+	for _, issue := range issues {
+		key := fmt.Sprintf("%s:%d:%d:%s", issue.File, issue.Line, issue.Column, issue.Rule)
+		if issue.File == "" {
+			key = issue.ID // a repo-wide diagnostic (e.g. tool-missing) has no position to key on
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		deduped = append(deduped, issue)
+	}
+
+	return deduped
+}
+
+// appliesTo reports whether linter should run given the languages detected
+// in the repository; a linter with no Languages configured always runs.
+func (a *LintersAnalyzer) appliesTo(linter config.LinterConfig) bool {
+	if len(linter.Languages) == 0 {
+		return true
+	}
+	for _, lang := range linter.Languages {
+		if a.languages[lang] {
+			return true
+		}
+	}
+	return false
+}
+
+// run invokes linter.Command, bounded by linter.Timeout if set, falling
+// back to the subsystem's configured timeout (2 minutes if that's unset
+// too).
+func (a *LintersAnalyzer) run(linter config.LinterConfig) (string, error) {
+	timeout := linter.Timeout
+	if timeout <= 0 {
+		timeout = a.cfg.Timeout
+	}
+	if timeout <= 0 {
+		timeout = 2 * time.Minute
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, linter.Command, linter.Args...)
+	cmd.Dir = a.repoPath
+	output, err := cmd.CombinedOutput()
+	return string(output), err
+}
+
+func (a *LintersAnalyzer) parse(linter config.LinterConfig, output string) []report.Issue {
+	if output == "" {
+		return nil
+	}
+
+	switch linter.Format {
+	case "golangci-json":
+		return a.parseGolangciJSON(linter, output)
+	case "gosec-json":
+		return a.parseGosecJSON(linter, output)
+	case "staticcheck-json":
+		return a.parseStaticcheckJSON(linter, output)
+	case "gofumpt-diff":
+		return a.parseGofumptDiff(linter, output)
+	case "gitleaks-json":
+		return a.parseGitleaksJSON(linter, output)
+	default:
+		return a.parseLineFormat(linter, output)
+	}
+}
+
+// parseLineFormat handles the generic "file:line[:col]: message" text
+// format shared by go vet, staticcheck, ruff, eslint --format unix, pylint
+// --output-format=parseable, shellcheck -f gcc, and hadolint -f gcc.
+func (a *LintersAnalyzer) parseLineFormat(linter config.LinterConfig, output string) []report.Issue {
+	var issues []report.Issue
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		matches := lineFormatPattern.FindStringSubmatch(line)
+		if len(matches) != 5 {
+			continue
+		}
+
+		lineNum, err := strconv.Atoi(matches[2])
+		if err != nil {
+			continue
+		}
+		col, _ := strconv.Atoi(matches[3]) // absent column parses to 0, which is fine
+
+		issues = append(issues, a.issue(linter, matches[1], lineNum, col, matches[4]))
+	}
+
+	return issues
+}
+
+// golangciIssue mirrors only the fields LintersAnalyzer needs from
+// golangci-lint's `--out-format json` output.
+type golangciIssue struct {
+	FromLinter string `json:"FromLinter"`
+	Text       string `json:"Text"`
+	Pos        struct {
+		Filename string `json:"Filename"`
+		Line     int    `json:"Line"`
+		Column   int    `json:"Column"`
+	} `json:"Pos"`
+}
+
+type golangciReport struct {
+	Issues []golangciIssue `json:"Issues"`
+}
+
+// parseGolangciJSON handles golangci-lint's structured output, tagging each
+// finding with the underlying linter golangci-lint ran (e.g. "unused",
+// "errcheck") rather than golangci-lint itself, so overriding one of its
+// constituent linters via Config.Rules works the same as overriding a
+// standalone one.
+func (a *LintersAnalyzer) parseGolangciJSON(linter config.LinterConfig, output string) []report.Issue {
+	var parsed golangciReport
+	if err := json.Unmarshal([]byte(output), &parsed); err != nil {
+		return nil
+	}
+
+	issues := make([]report.Issue, 0, len(parsed.Issues))
+	for _, found := range parsed.Issues {
+		rule := found.FromLinter
+		if rule == "" {
+			rule = linter.Name
+		}
+		issues = append(issues, a.issueWithRule(rule, found.Pos.Filename, found.Pos.Line, found.Pos.Column, found.Text, linter.Severity))
+	}
+
+	return issues
+}
+
+func (a *LintersAnalyzer) issue(linter config.LinterConfig, file string, line, col int, message string) report.Issue {
+	return a.issueWithRule(linter.Name, file, line, col, message, linter.Severity)
+}
+
+func (a *LintersAnalyzer) issueWithRule(rule, file string, line, col int, message string, severity report.Severity) report.Issue {
+	return a.categorizedIssue(report.CategoryQuality, rule, file, line, col, message, severity)
+}
+
+// categorizedIssue is issueWithRule with an explicit Category, for an
+// adapter (gitleaks) whose findings belong somewhere other than Quality.
+func (a *LintersAnalyzer) categorizedIssue(category report.Category, rule, file string, line, col int, message string, severity report.Severity) report.Issue {
+	file = strings.TrimPrefix(file, "./")
+	if severity == "" {
+		severity = report.SeverityMedium
+	}
+
+	return report.Issue{
+		ID:          fmt.Sprintf("%s-%s-%d", rule, strings.ReplaceAll(file, "/", "-"), line),
+		Title:       fmt.Sprintf("%s finding", rule),
+		Description: message,
+		Category:    category,
+		Severity:    severity,
+		File:        file,
+		Line:        line,
+		Column:      col,
+		Rule:        rule,
+		Fix:         fmt.Sprintf("Address the %s finding", rule),
+		Rationale:   fmt.Sprintf("%s reported this at %s:%d", rule, file, line),
+		FixGuidance: &report.FixGuidance{
+			Effort: report.EffortMedium,
+			Probe:  fmt.Sprintf("Re-run `%s` and confirm the finding is gone", rule),
+		},
+		CreatedAt: time.Now(),
+	}
+}
+
+// gosecIssue mirrors only the fields LintersAnalyzer needs from gosec's
+// `-fmt json` output. Line and Column are strings in gosec's own schema,
+// and Line is occasionally a "10-12" range for a multi-line finding.
+type gosecIssue struct {
+	Severity string `json:"severity"`
+	RuleID   string `json:"rule_id"`
+	Details  string `json:"details"`
+	File     string `json:"file"`
+	Line     string `json:"line"`
+	Column   string `json:"column"`
+}
+
+type gosecReport struct {
+	Issues []gosecIssue `json:"Issues"`
+}
+
+// parseGosecJSON handles gosec's structured output, remapping its own
+// HIGH/MEDIUM/LOW severity scale onto report.Severity.
+func (a *LintersAnalyzer) parseGosecJSON(linter config.LinterConfig, output string) []report.Issue {
+	var parsed gosecReport
+	if err := json.Unmarshal([]byte(output), &parsed); err != nil {
+		return nil
+	}
+
+	issues := make([]report.Issue, 0, len(parsed.Issues))
+	for _, found := range parsed.Issues {
+		line, _ := strconv.Atoi(strings.SplitN(found.Line, "-", 2)[0])
+		col, _ := strconv.Atoi(found.Column)
+		issues = append(issues, a.issueWithRule(found.RuleID, found.File, line, col, found.Details, gosecSeverity(found.Severity, linter.Severity)))
+	}
+
+	return issues
+}
+
+func gosecSeverity(raw string, fallback report.Severity) report.Severity {
+	switch strings.ToUpper(raw) {
+	case "HIGH":
+		return report.SeverityHigh
+	case "MEDIUM":
+		return report.SeverityMedium
+	case "LOW":
+		return report.SeverityLow
+	default:
+		return fallback
+	}
+}
+
+// staticcheckFinding mirrors one line of staticcheck's `-f json` output,
+// which prints one JSON object per finding rather than a single array.
+type staticcheckFinding struct {
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+	Location struct {
+		File   string `json:"file"`
+		Line   int    `json:"line"`
+		Column int    `json:"column"`
+	} `json:"location"`
+}
+
+// parseStaticcheckJSON handles staticcheck's newline-delimited JSON,
+// skipping any line that isn't a well-formed finding rather than failing
+// the whole tool's output.
+func (a *LintersAnalyzer) parseStaticcheckJSON(linter config.LinterConfig, output string) []report.Issue {
+	var issues []report.Issue
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		var finding staticcheckFinding
+		if err := json.Unmarshal([]byte(line), &finding); err != nil {
+			continue
+		}
+
+		rule := finding.Code
+		if rule == "" {
+			rule = linter.Name
+		}
+		issues = append(issues, a.issueWithRule(rule, finding.Location.File, finding.Location.Line, finding.Location.Column, finding.Message, linter.Severity))
+	}
+
+	return issues
+}
+
+// parseGofumptDiff handles `gofumpt -l -d`'s unified-diff output, reusing
+// baseline.ParsePatch to find which files it touched, and reports one
+// "gofumpt" issue per file rather than one per reformatted line - gofumpt
+// findings are about a file's overall formatting, not any individual line.
+func (a *LintersAnalyzer) parseGofumptDiff(linter config.LinterConfig, output string) []report.Issue {
+	ranges := baseline.ParsePatch(output)
+
+	files := make([]string, 0, len(ranges))
+	for file := range ranges {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	issues := make([]report.Issue, 0, len(files))
+	for _, file := range files {
+		message := fmt.Sprintf("gofumpt would reformat %d line(s) of this file", len(ranges[file]))
+		issues = append(issues, a.issueWithRule("gofumpt", file, 0, 0, message, linter.Severity))
+	}
+
+	return issues
+}
+
+// gitleaksFinding mirrors only the fields LintersAnalyzer needs from
+// `gitleaks detect --report-format json`.
+type gitleaksFinding struct {
+	Description string `json:"Description"`
+	RuleID      string `json:"RuleID"`
+	File        string `json:"File"`
+	StartLine   int    `json:"StartLine"`
+	StartColumn int    `json:"StartColumn"`
+}
+
+// parseGitleaksJSON handles gitleaks' structured output. Unlike every other
+// adapter, its findings are filed under CategorySecurity, not
+// CategoryQuality - a detected secret isn't a code-quality concern.
+func (a *LintersAnalyzer) parseGitleaksJSON(linter config.LinterConfig, output string) []report.Issue {
+	var findings []gitleaksFinding
+	if err := json.Unmarshal([]byte(output), &findings); err != nil {
+		return nil
+	}
+
+	issues := make([]report.Issue, 0, len(findings))
+	for _, found := range findings {
+		rule := found.RuleID
+		if rule == "" {
+			rule = linter.Name
+		}
+		severity := linter.Severity
+		if severity == "" {
+			severity = report.SeverityHigh // a detected secret defaults higher than LintersAnalyzer's usual medium
+		}
+		issues = append(issues, a.categorizedIssue(report.CategorySecurity, rule, found.File, found.StartLine, found.StartColumn, found.Description, severity))
+	}
+
+	return issues
+}
@@ -0,0 +1,291 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/githealthchecker/git-health-checker/internal/config"
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+func goVetLinter() config.LinterConfig {
+	return config.LinterConfig{
+		Name: "go-vet", Command: "go", Args: []string{"vet", "./..."},
+		Languages: []string{"Go"}, Format: "line", Enabled: true, Severity: report.SeverityMedium,
+	}
+}
+
+func TestLintersAnalyzer_AppliesTo(t *testing.T) {
+	analyzer := NewLintersAnalyzer(&config.LintersConfig{Enabled: true}, "/test", []string{"Go"})
+
+	if !analyzer.appliesTo(goVetLinter()) {
+		t.Error("expected a Go linter to apply when Go is present")
+	}
+
+	pylint := config.LinterConfig{Name: "pylint", Languages: []string{"Python"}}
+	if analyzer.appliesTo(pylint) {
+		t.Error("expected a Python-only linter not to apply when only Go is present")
+	}
+
+	noLanguages := config.LinterConfig{Name: "generic"}
+	if !analyzer.appliesTo(noLanguages) {
+		t.Error("expected a linter with no configured Languages to always apply")
+	}
+}
+
+func TestLintersAnalyzer_ParseLineFormat(t *testing.T) {
+	analyzer := NewLintersAnalyzer(&config.LintersConfig{Enabled: true}, "/test", []string{"Go"})
+	linter := goVetLinter()
+
+	tests := []struct {
+		name           string
+		output         string
+		expectedIssues int
+	}{
+		{"empty output", "", 0},
+		{"single warning", "./main.go:10:5: unused variable 'x'", 1},
+		{"multiple warnings", "./main.go:10:5: unused variable 'x'\n./helper.go:20:1: unreachable code", 2},
+		{"invalid format", "some random text without proper format", 0},
+		{"column-less format", "Dockerfile:3 DL3006 warning: pin the image tag", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := analyzer.parseLineFormat(linter, tt.output)
+			if len(issues) != tt.expectedIssues {
+				t.Errorf("expected %d issues, got %d", tt.expectedIssues, len(issues))
+			}
+		})
+	}
+}
+
+func TestLintersAnalyzer_ParseLineFormat_IssueFields(t *testing.T) {
+	analyzer := NewLintersAnalyzer(&config.LintersConfig{Enabled: true}, "/test", []string{"Go"})
+	linter := goVetLinter()
+
+	issues := analyzer.parseLineFormat(linter, "./main.go:10:5: unused variable 'x'")
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue, got %d", len(issues))
+	}
+
+	issue := issues[0]
+	if issue.File != "main.go" {
+		t.Errorf("expected file 'main.go', got %q", issue.File)
+	}
+	if issue.Line != 10 || issue.Column != 5 {
+		t.Errorf("expected line 10, column 5, got line %d, column %d", issue.Line, issue.Column)
+	}
+	if issue.Rule != "go-vet" {
+		t.Errorf("expected rule 'go-vet', got %q", issue.Rule)
+	}
+	if issue.Category != report.CategoryQuality {
+		t.Errorf("expected category %s, got %s", report.CategoryQuality, issue.Category)
+	}
+}
+
+func TestLintersAnalyzer_ParseGolangciJSON(t *testing.T) {
+	analyzer := NewLintersAnalyzer(&config.LintersConfig{Enabled: true}, "/test", []string{"Go"})
+	linter := config.LinterConfig{Name: "golangci-lint", Format: "golangci-json"}
+
+	output := `{"Issues":[{"FromLinter":"unused","Text":"func foo is unused","Pos":{"Filename":"foo.go","Line":12,"Column":1}}]}`
+
+	issues := analyzer.parseGolangciJSON(linter, output)
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue, got %d", len(issues))
+	}
+	if issues[0].Rule != "unused" {
+		t.Errorf("expected rule 'unused' (the underlying linter), got %q", issues[0].Rule)
+	}
+	if issues[0].File != "foo.go" || issues[0].Line != 12 {
+		t.Errorf("expected foo.go:12, got %s:%d", issues[0].File, issues[0].Line)
+	}
+}
+
+func TestLintersAnalyzer_ParseGosecJSON(t *testing.T) {
+	analyzer := NewLintersAnalyzer(&config.LintersConfig{Enabled: true}, "/test", []string{"Go"})
+	linter := config.LinterConfig{Name: "gosec", Format: "gosec-json", Severity: report.SeverityMedium}
+
+	output := `{"Issues":[{"severity":"HIGH","rule_id":"G101","details":"Potential hardcoded credentials","file":"config.go","line":"10-12","column":"5"}]}`
+
+	issues := analyzer.parseGosecJSON(linter, output)
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue, got %d", len(issues))
+	}
+	if issues[0].Rule != "G101" || issues[0].File != "config.go" || issues[0].Line != 10 {
+		t.Errorf("unexpected issue: %+v", issues[0])
+	}
+	if issues[0].Severity != report.SeverityHigh {
+		t.Errorf("expected gosec's HIGH to map to %s, got %s", report.SeverityHigh, issues[0].Severity)
+	}
+}
+
+func TestLintersAnalyzer_ParseStaticcheckJSON(t *testing.T) {
+	analyzer := NewLintersAnalyzer(&config.LintersConfig{Enabled: true}, "/test", []string{"Go"})
+	linter := config.LinterConfig{Name: "staticcheck", Format: "staticcheck-json", Severity: report.SeverityMedium}
+
+	output := `{"code":"SA4006","message":"this value is never used","location":{"file":"main.go","line":10,"column":2}}
+{"code":"SA1019","message":"deprecated","location":{"file":"other.go","line":3,"column":1}}
+`
+
+	issues := analyzer.parseStaticcheckJSON(linter, output)
+	if len(issues) != 2 {
+		t.Fatalf("expected 2 issues from the newline-delimited output, got %d", len(issues))
+	}
+	if issues[0].Rule != "SA4006" || issues[0].File != "main.go" || issues[0].Line != 10 {
+		t.Errorf("unexpected first issue: %+v", issues[0])
+	}
+}
+
+func TestLintersAnalyzer_ParseGofumptDiff(t *testing.T) {
+	analyzer := NewLintersAnalyzer(&config.LintersConfig{Enabled: true}, "/test", []string{"Go"})
+	linter := config.LinterConfig{Name: "gofumpt", Format: "gofumpt-diff", Severity: report.SeverityLow}
+
+	output := `diff -u main.go.orig main.go
+--- main.go.orig
++++ main.go
+@@ -1,3 +1,3 @@
+ package main
+
+-func f(){return}
++func f() { return }
+`
+
+	issues := analyzer.parseGofumptDiff(linter, output)
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue (one per file), got %d", len(issues))
+	}
+	if issues[0].File != "main.go" || issues[0].Rule != "gofumpt" {
+		t.Errorf("unexpected issue: %+v", issues[0])
+	}
+}
+
+func TestLintersAnalyzer_ParseGitleaksJSON(t *testing.T) {
+	analyzer := NewLintersAnalyzer(&config.LintersConfig{Enabled: true}, "/test", []string{"Go"})
+	linter := config.LinterConfig{Name: "gitleaks", Format: "gitleaks-json"}
+
+	output := `[{"Description":"AWS Access Key","RuleID":"aws-access-key","File":"config.go","StartLine":5,"StartColumn":10}]`
+
+	issues := analyzer.parseGitleaksJSON(linter, output)
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue, got %d", len(issues))
+	}
+	if issues[0].Category != report.CategorySecurity {
+		t.Errorf("expected a gitleaks finding to be filed under %s, got %s", report.CategorySecurity, issues[0].Category)
+	}
+	if issues[0].Severity != report.SeverityHigh {
+		t.Errorf("expected a gitleaks finding to default to %s severity, got %s", report.SeverityHigh, issues[0].Severity)
+	}
+}
+
+func TestLintersAnalyzer_Analyze_ToolMissing(t *testing.T) {
+	missing := config.LinterConfig{Name: "definitely-not-a-real-binary", Command: "definitely-not-a-real-binary-xyz", Enabled: true, Format: "line"}
+	analyzer := NewLintersAnalyzer(&config.LintersConfig{Enabled: true, Linters: []config.LinterConfig{missing}}, "/test", nil)
+
+	issues, err := analyzer.Analyze()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Rule != "tool-missing" {
+		t.Fatalf("expected a single tool-missing diagnostic, got %+v", issues)
+	}
+	if issues[0].Severity != report.SeverityLow {
+		t.Errorf("expected tool-missing to be low severity, got %s", issues[0].Severity)
+	}
+}
+
+func TestDedupeIssues_KeepsDistinctNoFileIssuesByID(t *testing.T) {
+	issues := []report.Issue{
+		{ID: "tool-missing-gosec", Rule: "tool-missing", Description: "gosec missing"},
+		{ID: "tool-missing-gitleaks", Rule: "tool-missing", Description: "gitleaks missing"},
+	}
+
+	deduped := dedupeIssues(issues)
+	if len(deduped) != 2 {
+		t.Fatalf("expected 2 distinct no-file diagnostics to survive, got %d", len(deduped))
+	}
+}
+
+func TestDedupeIssues(t *testing.T) {
+	issues := []report.Issue{
+		{File: "main.go", Line: 10, Column: 5, Rule: "go-vet", Description: "first"},
+		{File: "main.go", Line: 10, Column: 5, Rule: "go-vet", Description: "duplicate, should be dropped"},
+		{File: "main.go", Line: 10, Column: 5, Rule: "staticcheck", Description: "same position, different rule"},
+		{File: "helper.go", Line: 20, Column: 1, Rule: "go-vet", Description: "different file"},
+	}
+
+	deduped := dedupeIssues(issues)
+	if len(deduped) != 3 {
+		t.Fatalf("expected 3 deduped issues, got %d", len(deduped))
+	}
+	if deduped[0].Description != "first" {
+		t.Errorf("expected the first occurrence to be kept, got %q", deduped[0].Description)
+	}
+}
+
+func TestLintersAnalyzer_Analyze_Disabled(t *testing.T) {
+	analyzer := NewLintersAnalyzer(&config.LintersConfig{Enabled: false, Linters: []config.LinterConfig{goVetLinter()}}, "/test", []string{"Go"})
+
+	issues, err := analyzer.Analyze()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if issues != nil {
+		t.Errorf("expected no issues when the subsystem is disabled, got %d", len(issues))
+	}
+}
+
+// Integration test - requires the go toolchain to be on PATH.
+func TestLintersAnalyzer_AnalyzeIntegration(t *testing.T) {
+	if os.Getenv("CI") != "" {
+		t.Skip("Skipping integration test in CI environment")
+	}
+
+	tempDir, err := os.MkdirTemp("", "linters-test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func(path string) {
+		if err := os.RemoveAll(path); err != nil {
+			t.Fatalf("failed to clean up temp dir: %v", err)
+		}
+	}(tempDir)
+
+	goFile := filepath.Join(tempDir, "main.go")
+	content := `package main
+
+import "fmt"
+
+func main() {
+	var unused string
+	fmt.Println("Hello, World!")
+}
+`
+	if err := os.WriteFile(goFile, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(tempDir, "go.mod"), []byte("module test\n\ngo 1.19\n"), 0644); err != nil {
+		t.Fatalf("failed to write go.mod: %v", err)
+	}
+
+	cfg := &config.LintersConfig{Enabled: true, Linters: []config.LinterConfig{goVetLinter()}}
+	analyzer := NewLintersAnalyzer(cfg, tempDir, []string{"Go"})
+
+	issues, err := analyzer.Analyze()
+	if err != nil {
+		t.Fatalf("analysis failed: %v", err)
+	}
+
+	for _, issue := range issues {
+		if issue.Category != report.CategoryQuality {
+			t.Errorf("expected category %s, got %s", report.CategoryQuality, issue.Category)
+		}
+		if issue.Rule == "" {
+			t.Error("expected non-empty rule")
+		}
+		if issue.Fix == "" {
+			t.Error("expected non-empty fix")
+		}
+	}
+}
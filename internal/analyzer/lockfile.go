@@ -0,0 +1,289 @@
+package analyzer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LockedDependency is one entry resolved by a dependency lockfile: the exact
+// version - and, where the format provides one, the resolved download URL
+// and an integrity/checksum hash - that a manifest's looser requirement was
+// locked to.
+type LockedDependency struct {
+	Ecosystem string
+	Name      string
+	Version   string
+	Resolved  string
+	Integrity string
+}
+
+// Lockfile decodes one ecosystem's lockfile format into its resolved
+// dependencies.
+type Lockfile interface {
+	Parse(data []byte) ([]LockedDependency, error)
+}
+
+// goSumLockfile parses go.sum, which lists one or two hash lines per module
+// version: a content hash and, for most entries, a second hash of just the
+// go.mod file (suffixed "/go.mod" in the module column). Only the content
+// hash line is reported as a LockedDependency; the go.mod hash isn't a
+// separate dependency.
+type goSumLockfile struct{}
+
+func (goSumLockfile) Parse(data []byte) ([]LockedDependency, error) {
+	var deps []LockedDependency
+
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
+		}
+
+		module, version, hash := fields[0], fields[1], fields[2]
+		if strings.HasSuffix(version, "/go.mod") {
+			continue
+		}
+
+		deps = append(deps, LockedDependency{
+			Ecosystem: "Go",
+			Name:      module,
+			Version:   version,
+			Integrity: hash,
+		})
+	}
+
+	return deps, nil
+}
+
+// npmLockfile parses package-lock.json's "packages" map (lockfile v2/v3
+// format) into LockedDependency entries, carrying the resolved tarball URL
+// and integrity hash alongside the version.
+type npmLockfile struct{}
+
+func (npmLockfile) Parse(data []byte) ([]LockedDependency, error) {
+	var lockfile struct {
+		Packages map[string]struct {
+			Version   string `json:"version"`
+			Resolved  string `json:"resolved"`
+			Integrity string `json:"integrity"`
+		} `json:"packages"`
+	}
+	if err := json.Unmarshal(data, &lockfile); err != nil {
+		return nil, fmt.Errorf("failed to parse package-lock.json: %w", err)
+	}
+
+	deps := make([]LockedDependency, 0, len(lockfile.Packages))
+	for path, pkg := range lockfile.Packages {
+		name := strings.TrimPrefix(path, "node_modules/")
+		if name == "" || pkg.Version == "" {
+			continue
+		}
+		deps = append(deps, LockedDependency{
+			Ecosystem: "npm",
+			Name:      name,
+			Version:   pkg.Version,
+			Resolved:  pkg.Resolved,
+			Integrity: pkg.Integrity,
+		})
+	}
+
+	return deps, nil
+}
+
+// yarnLockfile parses yarn.lock's custom (non-YAML, non-JSON) format: a
+// blank-line-separated series of blocks, each starting with one or more
+// comma-separated, unindented "name@range" headers followed by indented
+// version/resolved/integrity lines.
+type yarnLockfile struct{}
+
+func (yarnLockfile) Parse(data []byte) ([]LockedDependency, error) {
+	var deps []LockedDependency
+	var current *LockedDependency
+
+	for _, rawLine := range strings.Split(string(data), "\n") {
+		line := strings.TrimRight(rawLine, "\r")
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "" || strings.HasPrefix(trimmed, "#"):
+			continue
+		case !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t"):
+			if current != nil {
+				deps = append(deps, *current)
+			}
+			current = &LockedDependency{Ecosystem: "npm", Name: yarnPackageName(trimmed)}
+		case current == nil:
+			continue
+		case strings.HasPrefix(trimmed, "version "):
+			current.Version = yarnUnquote(strings.TrimPrefix(trimmed, "version "))
+		case strings.HasPrefix(trimmed, "resolved "):
+			current.Resolved = yarnUnquote(strings.TrimPrefix(trimmed, "resolved "))
+		case strings.HasPrefix(trimmed, "integrity "):
+			current.Integrity = yarnUnquote(strings.TrimPrefix(trimmed, "integrity "))
+		}
+	}
+	if current != nil {
+		deps = append(deps, *current)
+	}
+
+	return deps, nil
+}
+
+// yarnPackageName extracts the bare package name from a yarn.lock block
+// header such as `"lodash@^4.17.20", "lodash@^4.17.0":`, taking only the
+// first alternative and stripping its trailing "@range" and colon.
+func yarnPackageName(header string) string {
+	first := yarnUnquote(strings.TrimSuffix(strings.Split(header, ",")[0], ":"))
+	if idx := strings.LastIndex(first, "@"); idx > 0 {
+		return first[:idx]
+	}
+	return first
+}
+
+func yarnUnquote(s string) string {
+	return strings.Trim(strings.TrimSpace(s), `"`)
+}
+
+// pnpmLockfile parses pnpm-lock.yaml's "packages" map into LockedDependency
+// entries. Keys encode both name and version (e.g. "/lodash@4.17.21"), with
+// an optional parenthesized peer-dependency suffix to strip.
+type pnpmLockfile struct{}
+
+func (pnpmLockfile) Parse(data []byte) ([]LockedDependency, error) {
+	var doc struct {
+		Packages map[string]struct {
+			Resolution struct {
+				Integrity string `yaml:"integrity"`
+				Tarball   string `yaml:"tarball"`
+			} `yaml:"resolution"`
+		} `yaml:"packages"`
+	}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse pnpm-lock.yaml: %w", err)
+	}
+
+	deps := make([]LockedDependency, 0, len(doc.Packages))
+	for key, pkg := range doc.Packages {
+		name, version := splitPnpmKey(key)
+		if name == "" {
+			continue
+		}
+		deps = append(deps, LockedDependency{
+			Ecosystem: "npm",
+			Name:      name,
+			Version:   version,
+			Resolved:  pkg.Resolution.Tarball,
+			Integrity: pkg.Resolution.Integrity,
+		})
+	}
+
+	return deps, nil
+}
+
+// splitPnpmKey splits a pnpm-lock.yaml package key, e.g. "/lodash@4.17.21"
+// or "/@scope/pkg@1.0.0(peer@2.0.0)", into its bare name and version,
+// discarding any parenthesized peer-dependency suffix. The split is on the
+// last "@" so scoped package names (which contain their own "@") survive.
+func splitPnpmKey(key string) (string, string) {
+	key = strings.TrimPrefix(key, "/")
+	if idx := strings.Index(key, "("); idx != -1 {
+		key = key[:idx]
+	}
+
+	idx := strings.LastIndex(key, "@")
+	if idx <= 0 {
+		return "", ""
+	}
+	return key[:idx], key[idx+1:]
+}
+
+// parseTomlPackageBlocks does a line-oriented scan for repeated "[[package]]"
+// array-of-tables - the shape both Cargo.lock and poetry.lock use - and
+// collects the simple `key = "value"` pairs each block declares. It stops
+// collecting a block's keys at the next table header, which is safe here
+// since both formats list a package's plain string fields immediately under
+// "[[package]]", before any nested sub-table.
+func parseTomlPackageBlocks(data []byte) []map[string]string {
+	var blocks []map[string]string
+	var current map[string]string
+
+	for _, rawLine := range strings.Split(string(data), "\n") {
+		line := strings.TrimSpace(rawLine)
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "[[package]]"):
+			if current != nil {
+				blocks = append(blocks, current)
+			}
+			current = make(map[string]string)
+		case strings.HasPrefix(line, "["):
+			if current != nil {
+				blocks = append(blocks, current)
+				current = nil
+			}
+		case current != nil:
+			key, value, ok := strings.Cut(line, "=")
+			if !ok {
+				continue
+			}
+			current[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"`)
+		}
+	}
+	if current != nil {
+		blocks = append(blocks, current)
+	}
+
+	return blocks
+}
+
+// cargoLockfile parses Cargo.lock's "[[package]]" tables into
+// LockedDependency entries, using the package's "checksum" field (when
+// present - crates from a git source typically lack one) as the integrity
+// hash.
+type cargoLockfile struct{}
+
+func (cargoLockfile) Parse(data []byte) ([]LockedDependency, error) {
+	var deps []LockedDependency
+	for _, block := range parseTomlPackageBlocks(data) {
+		if block["name"] == "" {
+			continue
+		}
+		deps = append(deps, LockedDependency{
+			Ecosystem: "crates.io",
+			Name:      block["name"],
+			Version:   block["version"],
+			Integrity: block["checksum"],
+		})
+	}
+	return deps, nil
+}
+
+// poetryLockfile parses poetry.lock's "[[package]]" tables into
+// LockedDependency entries. Poetry records file hashes in a separate
+// "[package.files]" array rather than a single checksum, which
+// parseTomlPackageBlocks doesn't descend into, so Integrity is left empty.
+type poetryLockfile struct{}
+
+func (poetryLockfile) Parse(data []byte) ([]LockedDependency, error) {
+	var deps []LockedDependency
+	for _, block := range parseTomlPackageBlocks(data) {
+		if block["name"] == "" {
+			continue
+		}
+		deps = append(deps, LockedDependency{
+			Ecosystem: "PyPI",
+			Name:      block["name"],
+			Version:   block["version"],
+		})
+	}
+	return deps, nil
+}
@@ -0,0 +1,163 @@
+package analyzer
+
+import "testing"
+
+func TestGoSumLockfile_Parse(t *testing.T) {
+	data := []byte(`github.com/pkg/errors v0.9.1 h1:FEBLx1zS214owpjy7qsBeixbURkuhQAwrK5UwLGTwt4=
+github.com/pkg/errors v0.9.1/go.mod h1:bwawxfHBFNV+L2hUp1rHADufV3IMtnDRdf1r5NINEl0=
+`)
+
+	deps, err := (goSumLockfile{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+	if len(deps) != 1 {
+		t.Fatalf("expected 1 dependency (go.mod hash lines excluded), got %d", len(deps))
+	}
+	if deps[0].Name != "github.com/pkg/errors" || deps[0].Version != "v0.9.1" {
+		t.Errorf("unexpected dependency: %+v", deps[0])
+	}
+	if deps[0].Integrity != "h1:FEBLx1zS214owpjy7qsBeixbURkuhQAwrK5UwLGTwt4=" {
+		t.Errorf("unexpected integrity hash: %q", deps[0].Integrity)
+	}
+}
+
+func TestNpmLockfile_Parse(t *testing.T) {
+	data := []byte(`{
+  "packages": {
+    "": {"name": "root"},
+    "node_modules/lodash": {
+      "version": "4.17.21",
+      "resolved": "https://registry.npmjs.org/lodash/-/lodash-4.17.21.tgz",
+      "integrity": "sha512-abc"
+    }
+  }
+}`)
+
+	deps, err := (npmLockfile{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+	if len(deps) != 1 {
+		t.Fatalf("expected 1 dependency, got %d", len(deps))
+	}
+	if deps[0].Name != "lodash" || deps[0].Version != "4.17.21" || deps[0].Integrity != "sha512-abc" {
+		t.Errorf("unexpected dependency: %+v", deps[0])
+	}
+}
+
+func TestYarnLockfile_Parse(t *testing.T) {
+	data := []byte(`# yarn lockfile v1
+
+"lodash@^4.17.20", "lodash@^4.17.0":
+  version "4.17.21"
+  resolved "https://registry.yarnpkg.com/lodash/-/lodash-4.17.21.tgz#abc"
+  integrity sha512-abc
+
+"@scope/pkg@^1.0.0":
+  version "1.2.3"
+  resolved "https://registry.yarnpkg.com/@scope/pkg/-/pkg-1.2.3.tgz#def"
+  integrity sha512-def
+`)
+
+	deps, err := (yarnLockfile{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+	if len(deps) != 2 {
+		t.Fatalf("expected 2 dependencies, got %d", len(deps))
+	}
+	if deps[0].Name != "lodash" || deps[0].Version != "4.17.21" || deps[0].Integrity != "sha512-abc" {
+		t.Errorf("unexpected first dependency: %+v", deps[0])
+	}
+	if deps[1].Name != "@scope/pkg" || deps[1].Version != "1.2.3" {
+		t.Errorf("unexpected second dependency: %+v", deps[1])
+	}
+}
+
+func TestPnpmLockfile_Parse(t *testing.T) {
+	data := []byte(`packages:
+  /lodash@4.17.21:
+    resolution: {integrity: sha512-abc, tarball: https://example.com/lodash-4.17.21.tgz}
+  /@scope/pkg@1.2.3(peer@2.0.0):
+    resolution: {integrity: sha512-def}
+`)
+
+	deps, err := (pnpmLockfile{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+	if len(deps) != 2 {
+		t.Fatalf("expected 2 dependencies, got %d", len(deps))
+	}
+
+	found := make(map[string]LockedDependency, len(deps))
+	for _, dep := range deps {
+		found[dep.Name] = dep
+	}
+	if found["lodash"].Version != "4.17.21" || found["lodash"].Integrity != "sha512-abc" {
+		t.Errorf("unexpected lodash entry: %+v", found["lodash"])
+	}
+	if found["@scope/pkg"].Version != "1.2.3" {
+		t.Errorf("expected peer-dependency suffix to be stripped, got %+v", found["@scope/pkg"])
+	}
+}
+
+func TestCargoLockfile_Parse(t *testing.T) {
+	data := []byte(`# This file is automatically generated by Cargo.
+
+[[package]]
+name = "serde"
+version = "1.0.130"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "d1f..."
+
+[[package]]
+name = "no-checksum"
+version = "0.1.0"
+source = "git+https://example.com/repo"
+`)
+
+	deps, err := (cargoLockfile{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+	if len(deps) != 2 {
+		t.Fatalf("expected 2 dependencies, got %d", len(deps))
+	}
+	if deps[0].Name != "serde" || deps[0].Version != "1.0.130" || deps[0].Integrity != "d1f..." {
+		t.Errorf("unexpected serde entry: %+v", deps[0])
+	}
+	if deps[1].Integrity != "" {
+		t.Errorf("expected no checksum for a git-sourced package, got %q", deps[1].Integrity)
+	}
+}
+
+func TestPoetryLockfile_Parse(t *testing.T) {
+	data := []byte(`[[package]]
+name = "requests"
+version = "2.25.1"
+description = "Python HTTP for Humans."
+
+[package.dependencies]
+certifi = ">=2017.4.17"
+
+[[package]]
+name = "flask"
+version = "1.1.2"
+`)
+
+	deps, err := (poetryLockfile{}).Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+	if len(deps) != 2 {
+		t.Fatalf("expected 2 dependencies, got %d", len(deps))
+	}
+	if deps[0].Name != "requests" || deps[0].Version != "2.25.1" {
+		t.Errorf("unexpected requests entry: %+v", deps[0])
+	}
+	if deps[1].Name != "flask" || deps[1].Version != "1.1.2" {
+		t.Errorf("unexpected flask entry: %+v", deps[1])
+	}
+}
@@ -0,0 +1,503 @@
+package analyzer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+const osvAPIURL = "https://api.osv.dev/v1/query"
+const osvBatchAPIURL = "https://api.osv.dev/v1/querybatch"
+const osvVulnAPIURLFormat = "https://api.osv.dev/v1/vulns/%s"
+
+// osvBatchSize is the largest number of queries sent in a single
+// /v1/querybatch request, matching the limit OSV documents for that endpoint.
+const osvBatchSize = 1000
+
+// vulnerabilityBackend looks up known vulnerabilities for a batch of
+// (ecosystem, name, version) modules, returning any matching advisories
+// keyed by the module they affect. Implementations: osvBackend (the OSV
+// database), githubAdvisoryBackend (GitHub's Security Advisory GraphQL API),
+// and localDBBackend (an offline, previously cached snapshot).
+type vulnerabilityBackend interface {
+	QueryBatch(modules []osvModule) (map[osvModule][]osvRecord, error)
+}
+
+// osvModule is a single (ecosystem, package, version) tuple to look up
+// against the OSV database.
+type osvModule struct {
+	Ecosystem string
+	Name      string
+	Version   string
+}
+
+// osvQuery mirrors the request body OSV's /v1/query endpoint expects.
+type osvQuery struct {
+	Version string `json:"version,omitempty"`
+	Package struct {
+		Name      string `json:"name"`
+		Ecosystem string `json:"ecosystem"`
+	} `json:"package"`
+}
+
+// osvRecord mirrors the subset of an OSV vulnerability record this analyzer
+// maps into a report.Issue.
+type osvRecord struct {
+	ID       string   `json:"id"`
+	Aliases  []string `json:"aliases"`
+	Summary  string   `json:"summary"`
+	Details  string   `json:"details"`
+	Severity []struct {
+		Type  string `json:"type"`
+		Score string `json:"score"`
+	} `json:"severity"`
+	Affected []struct {
+		Ranges []struct {
+			Type   string `json:"type"`
+			Events []struct {
+				Introduced string `json:"introduced,omitempty"`
+				Fixed      string `json:"fixed,omitempty"`
+			} `json:"events"`
+		} `json:"ranges"`
+		DatabaseSpecific struct {
+			Symbols []string `json:"symbols"`
+		} `json:"database_specific"`
+	} `json:"affected"`
+}
+
+type osvQueryResponse struct {
+	Vulns []osvRecord `json:"vulns"`
+}
+
+// osvClient looks up vulnerabilities for modules either against the live
+// OSV API or, when dbPath is set, against a locally cloned OSV JSON tree
+// (one <id>.json file per advisory, as published at
+// https://github.com/google/osv.dev under the ecosystem's directory).
+type osvClient struct {
+	dbPath string
+}
+
+func newOSVClient(dbPath string) *osvClient {
+	return &osvClient{dbPath: dbPath}
+}
+
+func (c *osvClient) query(mod osvModule) ([]osvRecord, error) {
+	if c.dbPath != "" {
+		return c.queryOffline(mod)
+	}
+	return c.queryOnline(mod)
+}
+
+func (c *osvClient) queryOnline(mod osvModule) ([]osvRecord, error) {
+	query := osvQuery{Version: mod.Version}
+	query.Package.Name = mod.Name
+	query.Package.Ecosystem = mod.Ecosystem
+
+	body, err := json.Marshal(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OSV query: %w", err)
+	}
+
+	httpReq, err := http.NewRequest(http.MethodPost, osvAPIURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build OSV query: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query OSV: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OSV API returned status %d for %s@%s", resp.StatusCode, mod.Name, mod.Version)
+	}
+
+	var result osvQueryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode OSV response: %w", err)
+	}
+
+	return result.Vulns, nil
+}
+
+// queryOffline scans dbPath/<ecosystem>/*.json for advisories affecting mod,
+// so `--osv-db` can point at a local clone of the osv.dev data tree without
+// any network access.
+func (c *osvClient) queryOffline(mod osvModule) ([]osvRecord, error) {
+	ecosystemDir := filepath.Join(c.dbPath, mod.Ecosystem)
+	entries, err := os.ReadDir(ecosystemDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read offline OSV database %s: %w", ecosystemDir, err)
+	}
+
+	var matches []osvRecord
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(ecosystemDir, entry.Name()))
+		if err != nil {
+			continue
+		}
+
+		var record osvRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			continue
+		}
+
+		if c.recordAffects(record, mod) {
+			matches = append(matches, record)
+		}
+	}
+
+	return matches, nil
+}
+
+func (c *osvClient) recordAffects(record osvRecord, mod osvModule) bool {
+	for _, affected := range record.Affected {
+		for _, rng := range affected.Ranges {
+			if versionInRange(mod.Version, rng.Events) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// versionInRange reports whether version falls inside a SEMVER-style OSV
+// range, i.e. at or after the most recent "introduced" event and before the
+// next "fixed" event. It only handles the common monotonic case; exotic
+// multi-segment ranges are treated conservatively as a match.
+func versionInRange(version string, events []struct {
+	Introduced string `json:"introduced,omitempty"`
+	Fixed      string `json:"fixed,omitempty"`
+}) bool {
+	if len(events) == 0 {
+		return true
+	}
+
+	for _, event := range events {
+		if event.Fixed != "" && compareVersions(version, event.Fixed) >= 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// firstFixedVersion returns the earliest "fixed" version across a record's
+// ranges, for the Fix suggestion on the mapped Issue.
+func firstFixedVersion(record osvRecord) string {
+	var fixed string
+	for _, affected := range record.Affected {
+		for _, rng := range affected.Ranges {
+			for _, event := range rng.Events {
+				if event.Fixed == "" {
+					continue
+				}
+				if fixed == "" || compareVersions(event.Fixed, fixed) < 0 {
+					fixed = event.Fixed
+				}
+			}
+		}
+	}
+	return fixed
+}
+
+// compareVersions does a best-effort numeric comparison of dotted version
+// strings (optionally "v"-prefixed), returning -1, 0 or 1. It is not a full
+// semver implementation, but it is sufficient to order the version strings
+// OSV ranges and go.mod/package-lock files actually contain.
+func compareVersions(a, b string) int {
+	aParts := splitVersion(a)
+	bParts := splitVersion(b)
+
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var av, bv int
+		if i < len(aParts) {
+			av = aParts[i]
+		}
+		if i < len(bParts) {
+			bv = bParts[i]
+		}
+		if av != bv {
+			if av < bv {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func splitVersion(v string) []int {
+	v = strings.TrimPrefix(v, "v")
+	if idx := strings.IndexAny(v, "-+"); idx != -1 {
+		v = v[:idx]
+	}
+
+	var parts []int
+	for _, segment := range strings.Split(v, ".") {
+		n, err := strconv.Atoi(segment)
+		if err != nil {
+			n = 0
+		}
+		parts = append(parts, n)
+	}
+	return parts
+}
+
+// cvssToSeverity maps a CVSS vector or base score string (e.g. "9.8" or
+// "CVSS:3.1/AV:N/.../C:H/I:H/A:H") from OSV's severity[] array to our
+// Severity scale, following the standard CVSS v3 qualitative rating bands.
+func cvssToSeverity(scores []struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}) report.Severity {
+	for _, s := range scores {
+		if score, ok := cvssBaseScore(s.Score); ok {
+			return severityFromCVSSScore(score)
+		}
+	}
+	return report.SeverityMedium
+}
+
+func cvssBaseScore(raw string) (float64, bool) {
+	if score, err := strconv.ParseFloat(raw, 64); err == nil {
+		return score, true
+	}
+
+	// CVSS vector string: the numeric base score isn't embedded in the
+	// vector itself, so fall back to the qualitative rating derived from
+	// the Confidentiality/Integrity/Availability impact metrics.
+	high := strings.Count(raw, "/C:H") + strings.Count(raw, "/I:H") + strings.Count(raw, "/A:H")
+	if high == 0 {
+		return 0, false
+	}
+	return float64(3 + high*2), true
+}
+
+func severityFromCVSSScore(score float64) report.Severity {
+	switch {
+	case score >= 9.0:
+		return report.SeverityCritical
+	case score >= 7.0:
+		return report.SeverityHigh
+	case score >= 4.0:
+		return report.SeverityMedium
+	default:
+		return report.SeverityLow
+	}
+}
+
+// osvIssue maps a matched OSV record for a module into a report.Issue.
+func osvIssue(mod osvModule, record osvRecord, file string) report.Issue {
+	severity := cvssToSeverity(record.Severity)
+
+	cve := record.ID
+	for _, alias := range record.Aliases {
+		if strings.HasPrefix(alias, "CVE-") {
+			cve = alias
+			break
+		}
+	}
+
+	fix := fmt.Sprintf("Upgrade %s past the vulnerable range", mod.Name)
+	fixedVersion := firstFixedVersion(record)
+	if fixedVersion != "" {
+		fix = fmt.Sprintf("Upgrade %s to %s or later", mod.Name, fixedVersion)
+	}
+
+	return report.Issue{
+		ID:          fmt.Sprintf("osv-%s-%s", record.ID, strings.ReplaceAll(mod.Name, "/", "-")),
+		Title:       fmt.Sprintf("Known vulnerability in %s: %s", mod.Name, cve),
+		Description: firstNonEmpty(record.Summary, record.Details, fmt.Sprintf("%s affects %s@%s", record.ID, mod.Name, mod.Version)),
+		Category:    report.CategorySecurity,
+		Severity:    severity,
+		File:        file,
+		Rule:        "osv-vulnerability",
+		Fix:         fix,
+		HelpURI:     fmt.Sprintf("https://osv.dev/vulnerability/%s", record.ID),
+		Remediation: &report.Remediation{
+			Type:        "go-get",
+			Package:     mod.Name,
+			FromVersion: mod.Version,
+			ToVersion:   fixedVersion,
+		},
+		CreatedAt: time.Now(),
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// recordSymbols collects the affected symbols an OSV record declares, for
+// --symbols reachability filtering.
+func recordSymbols(record osvRecord) []string {
+	var symbols []string
+	for _, affected := range record.Affected {
+		symbols = append(symbols, affected.DatabaseSpecific.Symbols...)
+	}
+	return symbols
+}
+
+// osvBatchQuery mirrors the request body OSV's /v1/querybatch endpoint
+// expects: a list of the same per-module queries /v1/query takes.
+type osvBatchQuery struct {
+	Queries []osvQuery `json:"queries"`
+}
+
+// osvBatchResponse mirrors /v1/querybatch's response: one entry per query,
+// in the same order, carrying only the bare vulnerability IDs. The full
+// record for each ID is hydrated separately via /v1/vulns/{id}.
+type osvBatchResponse struct {
+	Results []struct {
+		Vulns []struct {
+			ID string `json:"id"`
+		} `json:"vulns"`
+	} `json:"results"`
+}
+
+// osvBackend is the vulnerabilityBackend backed by the OSV database: online
+// it batches lookups through /v1/querybatch and hydrates each matched ID
+// through /v1/vulns/{id}, retrying rate-limited and server-error responses
+// with backoff; offline (dbPath set) it falls back to osvClient's per-module
+// local JSON tree lookup, which needs no batching.
+type osvBackend struct {
+	client        *osvClient
+	retrier       *httpRetrier
+	batchURL      string
+	vulnURLFormat string
+}
+
+func newOSVBackend(dbPath string) *osvBackend {
+	return &osvBackend{
+		client:        newOSVClient(dbPath),
+		retrier:       newHTTPRetrier(),
+		batchURL:      osvBatchAPIURL,
+		vulnURLFormat: osvVulnAPIURLFormat,
+	}
+}
+
+func (b *osvBackend) QueryBatch(modules []osvModule) (map[osvModule][]osvRecord, error) {
+	if b.client.dbPath != "" {
+		return b.queryBatchOffline(modules)
+	}
+	return b.queryBatchOnline(modules)
+}
+
+func (b *osvBackend) queryBatchOffline(modules []osvModule) (map[osvModule][]osvRecord, error) {
+	results := make(map[osvModule][]osvRecord)
+	for _, mod := range modules {
+		records, err := b.client.query(mod)
+		if err != nil {
+			return nil, err
+		}
+		if len(records) > 0 {
+			results[mod] = records
+		}
+	}
+	return results, nil
+}
+
+func (b *osvBackend) queryBatchOnline(modules []osvModule) (map[osvModule][]osvRecord, error) {
+	results := make(map[osvModule][]osvRecord)
+	hydrated := make(map[string]osvRecord)
+
+	for start := 0; start < len(modules); start += osvBatchSize {
+		end := start + osvBatchSize
+		if end > len(modules) {
+			end = len(modules)
+		}
+		chunk := modules[start:end]
+
+		ids, err := b.queryBatchChunk(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, mod := range chunk {
+			for _, id := range ids[i] {
+				record, ok := hydrated[id]
+				if !ok {
+					record, err = b.fetchVuln(id)
+					if err != nil {
+						return nil, err
+					}
+					hydrated[id] = record
+				}
+				results[mod] = append(results[mod], record)
+			}
+		}
+	}
+
+	return results, nil
+}
+
+func (b *osvBackend) queryBatchChunk(modules []osvModule) ([][]string, error) {
+	queries := make([]osvQuery, len(modules))
+	for i, mod := range modules {
+		queries[i].Version = mod.Version
+		queries[i].Package.Name = mod.Name
+		queries[i].Package.Ecosystem = mod.Ecosystem
+	}
+
+	body, err := json.Marshal(osvBatchQuery{Queries: queries})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OSV batch query: %w", err)
+	}
+
+	respBody, err := b.retrier.do(http.MethodPost, b.batchURL, jsonHeaders, body)
+	if err != nil {
+		return nil, fmt.Errorf("OSV batch query failed: %w", err)
+	}
+
+	var result osvBatchResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode OSV batch response: %w", err)
+	}
+
+	ids := make([][]string, len(modules))
+	for i, entry := range result.Results {
+		for _, v := range entry.Vulns {
+			ids[i] = append(ids[i], v.ID)
+		}
+	}
+	return ids, nil
+}
+
+func (b *osvBackend) fetchVuln(id string) (osvRecord, error) {
+	url := fmt.Sprintf(b.vulnURLFormat, id)
+	respBody, err := b.retrier.do(http.MethodGet, url, nil, nil)
+	if err != nil {
+		return osvRecord{}, fmt.Errorf("failed to fetch OSV vulnerability %s: %w", id, err)
+	}
+
+	var record osvRecord
+	if err := json.Unmarshal(respBody, &record); err != nil {
+		return osvRecord{}, fmt.Errorf("failed to decode OSV vulnerability %s: %w", id, err)
+	}
+	return record, nil
+}
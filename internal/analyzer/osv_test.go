@@ -0,0 +1,260 @@
+package analyzer
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"v1.2.3", "v1.2.4", -1},
+		{"1.2.3", "1.2.3", 0},
+		{"v2.0.0", "v1.9.9", 1},
+		{"1.2", "1.2.0", 0},
+		{"v1.0.0-rc1", "v1.0.0", 0},
+	}
+
+	for _, test := range tests {
+		if got := compareVersions(test.a, test.b); got != test.expected {
+			t.Errorf("compareVersions(%s, %s) = %d, expected %d", test.a, test.b, got, test.expected)
+		}
+	}
+}
+
+func TestVersionInRange(t *testing.T) {
+	events := []struct {
+		Introduced string `json:"introduced,omitempty"`
+		Fixed      string `json:"fixed,omitempty"`
+	}{
+		{Introduced: "0"},
+		{Fixed: "1.5.0"},
+	}
+
+	if !versionInRange("1.4.0", events) {
+		t.Error("expected 1.4.0 to be in the vulnerable range")
+	}
+	if versionInRange("1.5.0", events) {
+		t.Error("expected 1.5.0 (the fixed version) to be out of range")
+	}
+	if versionInRange("2.0.0", events) {
+		t.Error("expected 2.0.0 to be out of range")
+	}
+}
+
+func TestCvssToSeverity(t *testing.T) {
+	tests := []struct {
+		name     string
+		score    string
+		expected report.Severity
+	}{
+		{"critical base score", "9.8", report.SeverityCritical},
+		{"high base score", "7.5", report.SeverityHigh},
+		{"medium base score", "5.3", report.SeverityMedium},
+		{"low base score", "2.0", report.SeverityLow},
+	}
+
+	for _, test := range tests {
+		scores := []struct {
+			Type  string `json:"type"`
+			Score string `json:"score"`
+		}{{Type: "CVSS_V3", Score: test.score}}
+
+		if got := cvssToSeverity(scores); got != test.expected {
+			t.Errorf("%s: cvssToSeverity(%s) = %s, expected %s", test.name, test.score, got, test.expected)
+		}
+	}
+}
+
+func TestSymbolsReachable(t *testing.T) {
+	record := osvRecord{}
+	record.Affected = []struct {
+		Ranges []struct {
+			Type   string `json:"type"`
+			Events []struct {
+				Introduced string `json:"introduced,omitempty"`
+				Fixed      string `json:"fixed,omitempty"`
+			} `json:"events"`
+		} `json:"ranges"`
+		DatabaseSpecific struct {
+			Symbols []string `json:"symbols"`
+		} `json:"database_specific"`
+	}{
+		{},
+	}
+	record.Affected[0].DatabaseSpecific.Symbols = []string{"pkg.VulnerableFunc"}
+
+	if !symbolsReachable(record, "result := pkg.VulnerableFunc(x)") {
+		t.Error("expected symbol to be reachable when present in source")
+	}
+	if symbolsReachable(record, "result := pkg.SafeFunc(x)") {
+		t.Error("expected symbol to be unreachable when absent from source")
+	}
+}
+
+func TestOSVClientQueryOffline(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "osv_db_test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tempDir) }()
+
+	ecosystemDir := filepath.Join(tempDir, "Go")
+	if err := os.MkdirAll(ecosystemDir, 0755); err != nil {
+		t.Fatalf("failed to create ecosystem dir: %v", err)
+	}
+
+	advisory := `{
+		"id": "GHSA-test-1234",
+		"severity": [{"type": "CVSS_V3", "score": "9.8"}],
+		"affected": [{"ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "1.5.0"}]}]}]
+	}`
+	if err := os.WriteFile(filepath.Join(ecosystemDir, "GHSA-test-1234.json"), []byte(advisory), 0644); err != nil {
+		t.Fatalf("failed to write advisory: %v", err)
+	}
+
+	client := newOSVClient(tempDir)
+
+	records, err := client.query(osvModule{Ecosystem: "Go", Name: "example.com/vuln", Version: "1.0.0"})
+	if err != nil {
+		t.Fatalf("query() failed: %v", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected 1 matching record, got %d", len(records))
+	}
+
+	records, err = client.query(osvModule{Ecosystem: "Go", Name: "example.com/vuln", Version: "1.6.0"})
+	if err != nil {
+		t.Fatalf("query() failed: %v", err)
+	}
+	if len(records) != 0 {
+		t.Errorf("expected 0 matching records for a fixed version, got %d", len(records))
+	}
+}
+
+func TestOSVBackend_QueryBatchOnline(t *testing.T) {
+	mod := osvModule{Ecosystem: "Go", Name: "example.com/vuln", Version: "1.0.0"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/querybatch", func(w http.ResponseWriter, r *http.Request) {
+		var req osvBatchQuery
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode batch request: %v", err)
+		}
+		if len(req.Queries) != 1 || req.Queries[0].Package.Name != mod.Name {
+			t.Fatalf("unexpected batch request: %+v", req)
+		}
+
+		resp := osvBatchResponse{}
+		resp.Results = []struct {
+			Vulns []struct {
+				ID string `json:"id"`
+			} `json:"vulns"`
+		}{{Vulns: []struct {
+			ID string `json:"id"`
+		}{{ID: "GHSA-test-5678"}}}}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	mux.HandleFunc("/vulns/GHSA-test-5678", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"id": "GHSA-test-5678",
+			"severity": [{"type": "CVSS_V3", "score": "9.8"}],
+			"affected": [{"ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "1.5.0"}]}]}]
+		}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	backend := newOSVBackend("")
+	backend.batchURL = server.URL + "/querybatch"
+	backend.vulnURLFormat = server.URL + "/vulns/%s"
+
+	results, err := backend.QueryBatch([]osvModule{mod})
+	if err != nil {
+		t.Fatalf("QueryBatch() failed: %v", err)
+	}
+
+	records := results[mod]
+	if len(records) != 1 || records[0].ID != "GHSA-test-5678" {
+		t.Fatalf("expected 1 record for GHSA-test-5678, got %+v", records)
+	}
+}
+
+func TestOSVBackend_QueryBatchRetriesOnServerError(t *testing.T) {
+	attempts := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/querybatch", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(osvBatchResponse{Results: []struct {
+			Vulns []struct {
+				ID string `json:"id"`
+			} `json:"vulns"`
+		}{{}}})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	backend := newOSVBackend("")
+	backend.batchURL = server.URL + "/querybatch"
+	backend.retrier.initialBackoff = time.Millisecond
+
+	mod := osvModule{Ecosystem: "Go", Name: "example.com/vuln", Version: "1.0.0"}
+	results, err := backend.QueryBatch([]osvModule{mod})
+	if err != nil {
+		t.Fatalf("QueryBatch() failed after retries: %v", err)
+	}
+	if len(results) != 0 {
+		t.Errorf("expected no matches, got %+v", results)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts (2 failures + 1 success), got %d", attempts)
+	}
+}
+
+func TestOSVBackend_QueryBatchOffline(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "osv_backend_offline_test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tempDir) }()
+
+	ecosystemDir := filepath.Join(tempDir, "Go")
+	if err := os.MkdirAll(ecosystemDir, 0755); err != nil {
+		t.Fatalf("failed to create ecosystem dir: %v", err)
+	}
+
+	advisory := `{
+		"id": "GHSA-test-offline",
+		"severity": [{"type": "CVSS_V3", "score": "7.5"}],
+		"affected": [{"ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "2.0.0"}]}]}]
+	}`
+	if err := os.WriteFile(filepath.Join(ecosystemDir, "GHSA-test-offline.json"), []byte(advisory), 0644); err != nil {
+		t.Fatalf("failed to write advisory: %v", err)
+	}
+
+	backend := newOSVBackend(tempDir)
+	mod := osvModule{Ecosystem: "Go", Name: "example.com/vuln", Version: "1.0.0"}
+
+	results, err := backend.QueryBatch([]osvModule{mod})
+	if err != nil {
+		t.Fatalf("QueryBatch() failed: %v", err)
+	}
+	if len(results[mod]) != 1 {
+		t.Fatalf("expected 1 matching record, got %d", len(results[mod]))
+	}
+}
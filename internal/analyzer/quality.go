@@ -5,11 +5,11 @@ import (
 	"io"
 	"os"
 	"path/filepath"
-	"regexp"
 	"strings"
 	"time"
 
 	"github.com/githealthchecker/git-health-checker/internal/config"
+	"github.com/githealthchecker/git-health-checker/internal/filekind"
 	"github.com/githealthchecker/git-health-checker/internal/report"
 	"github.com/githealthchecker/git-health-checker/internal/scanner"
 )
@@ -17,12 +17,17 @@ import (
 type QualityAnalyzer struct {
 	config  *config.QualityConfig
 	scanner *scanner.FileScanner
+	// fileKinds classifies scanned files by regex rather than extension, so
+	// the cached-data checks recognize unconventional file names (see
+	// internal/filekind).
+	fileKinds *filekind.Resolver
 }
 
-func NewQualityAnalyzer(cfg *config.QualityConfig, fileScanner *scanner.FileScanner) *QualityAnalyzer {
+func NewQualityAnalyzer(cfg *config.QualityConfig, fileScanner *scanner.FileScanner, fileKinds *filekind.Resolver) *QualityAnalyzer {
 	return &QualityAnalyzer{
-		config:  cfg,
-		scanner: fileScanner,
+		config:    cfg,
+		scanner:   fileScanner,
+		fileKinds: fileKinds,
 	}
 }
 
@@ -72,13 +77,10 @@ func (a *QualityAnalyzer) analyzeFromCache(cachedFiles map[string]*scanner.Unifi
 // checkLargeFilesFromCache checks for large files using cached data
 func (a *QualityAnalyzer) checkLargeFilesFromCache(cachedFiles map[string]*scanner.UnifiedFileInfo) []report.Issue {
 	var issues []report.Issue
-	codeExtensions := map[string]bool{
-		".go": true, ".js": true, ".ts": true, ".py": true, ".java": true, ".rb": true, ".php": true, ".cs": true, ".cpp": true, ".c": true, ".rs": true, ".kt": true,
-	}
 
 	for _, file := range cachedFiles {
 		// Skip if not a code file
-		if !codeExtensions[file.Extension] {
+		if !a.fileKinds.IsAny(file.RelativePath, filekind.KindCode) {
 			continue
 		}
 
@@ -135,8 +137,7 @@ func (a *QualityAnalyzer) checkComplexityFromCache(cachedFiles map[string]*scann
 	var issues []report.Issue
 
 	for _, file := range cachedFiles {
-		// Only analyze Go files
-		if file.Extension != ".go" || !file.IsText {
+		if !a.fileKinds.IsAny(file.RelativePath, filekind.KindCode) || !file.IsText {
 			continue
 		}
 
@@ -153,8 +154,7 @@ func (a *QualityAnalyzer) checkComplexityFromCache(cachedFiles map[string]*scann
 			content = string(fileContent)
 		}
 
-		functionIssues := a.analyzeFunctionComplexity(content, file.RelativePath)
-		issues = append(issues, functionIssues...)
+		issues = append(issues, a.checkFunctionComplexity(content, file.RelativePath)...)
 	}
 
 	return issues, nil
@@ -163,63 +163,98 @@ func (a *QualityAnalyzer) checkComplexityFromCache(cachedFiles map[string]*scann
 func (a *QualityAnalyzer) checkComplexity() ([]report.Issue, error) {
 	var issues []report.Issue
 
-	goFiles, err := a.scanner.GetFilesByExtension([]string{".go"})
+	extensions := make([]string, 0, len(complexityPlugins))
+	for ext := range complexityPlugins {
+		extensions = append(extensions, ext)
+	}
+
+	files, err := a.scanner.GetFilesByExtension(extensions)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, file := range goFiles {
-		complexityIssues, err := a.analyzeCyclomaticComplexity(file)
+	for _, file := range files {
+		content, err := a.readFileContent(file.Path)
 		if err != nil {
-			continue // Skip files we can't analyze
+			continue // Skip files we can't read
 		}
-		issues = append(issues, complexityIssues...)
+		issues = append(issues, a.checkFunctionComplexity(string(content), file.RelativePath)...)
 	}
 
 	return issues, nil
 }
 
-func (a *QualityAnalyzer) analyzeCyclomaticComplexity(file scanner.FileInfo) ([]report.Issue, error) {
-	var issues []report.Issue
+// checkFunctionComplexity runs the complexityPlugin registered for path's
+// extension and turns its findings into report.Issues: one
+// "cyclomatic-complexity" issue per function over ComplexityThreshold, and
+// one "max-function-lines" issue per function whose line span exceeds
+// MaxFunctionLines. Files with no registered plugin, and test files (which
+// routinely need more setup and branching than production code), are
+// skipped.
+func (a *QualityAnalyzer) checkFunctionComplexity(content, relativePath string) []report.Issue {
+	if a.isTestFile(relativePath) {
+		return nil
+	}
 
-	// Find all function declarations
-	functionMatches, err := a.scanner.SearchInFiles(`func\s+(\([^)]+\)\s+)?(\w+\s*)?\(`, []string{".go"})
+	plugin, ok := complexityPlugins[strings.ToLower(filepath.Ext(relativePath))]
+	if !ok {
+		return nil
+	}
+
+	functions, err := plugin.Functions(content)
 	if err != nil {
-		return nil, err
+		return nil // unparsable file; skip rather than fail the whole scan
 	}
 
-	// Analyze each function for complexity
-	for _, match := range functionMatches {
-		if match.File == file.RelativePath {
-			// Skip test files - they often need higher complexity for setup and scenarios
-			if a.isTestFile(file.RelativePath) {
-				continue
-			}
+	var issues []report.Issue
+	for _, fn := range functions {
+		if fn.Complexity > a.config.ComplexityThreshold {
+			issues = append(issues, report.Issue{
+				ID:          fmt.Sprintf("high-complexity-%s-%d", strings.ReplaceAll(relativePath, "/", "-"), fn.StartLine),
+				Title:       "High function complexity",
+				Description: fmt.Sprintf("%s has complexity %d (threshold: %d). This function has many decision points making it harder to understand and test.", fn.Name, fn.Complexity, a.config.ComplexityThreshold),
+				Category:    report.CategoryQuality,
+				Severity:    a.determineSeverityByComplexity(fn.Complexity),
+				File:        relativePath,
+				Line:        fn.StartLine,
+				Rule:        "cyclomatic-complexity",
+				Fix:         "Break into smaller functions, reduce nested conditions, or use early returns",
+				CreatedAt:   time.Now(),
+			})
+		}
 
-			complexity, err := a.calculateFunctionComplexity(file, match.Line)
-			if err != nil {
-				continue
-			}
+		if threshold := a.config.CognitiveComplexityThreshold; threshold > 0 && fn.Cognitive > threshold {
+			issues = append(issues, report.Issue{
+				ID:          fmt.Sprintf("high-cognitive-complexity-%s-%d", strings.ReplaceAll(relativePath, "/", "-"), fn.StartLine),
+				Title:       "High cognitive complexity",
+				Description: fmt.Sprintf("%s has cognitive complexity %d (threshold: %d). Deeply nested conditionals and branching logical expressions make it harder to follow than its cyclomatic complexity alone suggests.", fn.Name, fn.Cognitive, threshold),
+				Category:    report.CategoryQuality,
+				Severity:    a.determineSeverityByThreshold(fn.Cognitive, threshold),
+				File:        relativePath,
+				Line:        fn.StartLine,
+				Rule:        "cognitive-complexity",
+				Fix:         "Flatten nested conditionals (e.g. with early returns) and split up compound boolean expressions",
+				CreatedAt:   time.Now(),
+			})
+		}
 
-			if complexity > a.config.ComplexityThreshold {
-				issue := report.Issue{
-					ID:          fmt.Sprintf("high-complexity-%s-%d", strings.ReplaceAll(file.RelativePath, "/", "-"), match.Line),
-					Title:       "High function complexity",
-					Description: fmt.Sprintf("Complexity: %d (threshold: %d). This function has many decision points making it harder to understand and test.", complexity, a.config.ComplexityThreshold),
-					Category:    report.CategoryQuality,
-					Severity:    a.determineSeverityByComplexity(complexity),
-					File:        file.RelativePath,
-					Line:        match.Line,
-					Rule:        "cyclomatic-complexity",
-					Fix:         "Break into smaller functions, reduce nested conditions, or use early returns",
-					CreatedAt:   time.Now(),
-				}
-				issues = append(issues, issue)
-			}
+		if lines := fn.EndLine - fn.StartLine + 1; lines > a.config.MaxFunctionLines {
+			issues = append(issues, report.Issue{
+				ID:          fmt.Sprintf("max-function-lines-%s-%d", strings.ReplaceAll(relativePath, "/", "-"), fn.StartLine),
+				Title:       "Function has too many lines",
+				Description: fmt.Sprintf("%s spans %d lines, exceeding the maximum of %d lines", fn.Name, lines, a.config.MaxFunctionLines),
+				Category:    report.CategoryQuality,
+				Severity:    a.determineSeverityBySize(lines),
+				File:        relativePath,
+				Line:        fn.StartLine,
+				Rule:        "max-function-lines",
+				Fix:         "Break this function into smaller, more focused functions",
+				CreatedAt:   time.Now(),
+			})
 		}
 	}
 
-	return issues, nil
+	return issues
 }
 
 func (a *QualityAnalyzer) determineSeverityBySize(lines int) report.Severity {
@@ -235,61 +270,6 @@ func (a *QualityAnalyzer) determineSeverityBySize(lines int) report.Severity {
 	}
 }
 
-func (a *QualityAnalyzer) calculateFunctionComplexity(file scanner.FileInfo, startLine int) (int, error) {
-	endLine, err := a.findFunctionEndLine(file, startLine)
-	if err != nil {
-		return 0, err
-	}
-
-	complexity := a.countComplexityPatterns(file, startLine, endLine)
-	return complexity, nil
-}
-
-func (a *QualityAnalyzer) findFunctionEndLine(file scanner.FileInfo, startLine int) (int, error) {
-	functionMatches, err := a.scanner.SearchInFiles(`func\s+(\([^)]+\)\s+)?(\w+\s*)?\(`, []string{".go"})
-	if err != nil {
-		return 0, err
-	}
-
-	endLine := file.LineCount
-	for _, match := range functionMatches {
-		if match.File == file.RelativePath && match.Line > startLine {
-			endLine = match.Line
-			break
-		}
-	}
-	return endLine, nil
-}
-
-func (a *QualityAnalyzer) countComplexityPatterns(file scanner.FileInfo, startLine, endLine int) int {
-	// Start with a baseline complexity of 1
-	complexity := 1
-
-	complexityPatterns := []string{
-		`\bif\b`, `\bfor\b`, `\brange\b`, `\bswitch\b`,
-		`\bcase\b`, `\bselect\b`, `&&`, `\|\|`, `\bgoto\b`,
-	}
-
-	for _, pattern := range complexityPatterns {
-		matches, err := a.scanner.SearchInFiles(pattern, []string{".go"})
-		if err != nil {
-			continue
-		}
-
-		for _, match := range matches {
-			if a.isMatchInFunction(match, file.RelativePath, startLine, endLine) {
-				complexity++
-			}
-		}
-	}
-
-	return complexity
-}
-
-func (a *QualityAnalyzer) isMatchInFunction(match scanner.Match, filePath string, startLine, endLine int) bool {
-	return match.File == filePath && match.Line >= startLine && match.Line < endLine
-}
-
 func (a *QualityAnalyzer) isTestFile(filePath string) bool {
 	return a.hasTestFilePattern(filePath) || a.isInTestDirectory(filePath)
 }
@@ -329,12 +309,17 @@ func (a *QualityAnalyzer) isInTestDirectory(filePath string) bool {
 }
 
 func (a *QualityAnalyzer) determineSeverityByComplexity(complexity int) report.Severity {
-	threshold := a.config.ComplexityThreshold
+	return a.determineSeverityByThreshold(complexity, a.config.ComplexityThreshold)
+}
 
+// determineSeverityByThreshold scales severity with how far value is past
+// threshold, shared by the cyclomatic- and cognitive-complexity checks since
+// both use the same "how many multiples of the threshold" scaling.
+func (a *QualityAnalyzer) determineSeverityByThreshold(value, threshold int) report.Severity {
 	switch {
-	case complexity > threshold*3: // Very high complexity
+	case value > threshold*3: // Very high
 		return report.SeverityHigh
-	case complexity > threshold*2: // Moderately high complexity
+	case value > threshold*2: // Moderately high
 		return report.SeverityMedium
 	default: // Slightly above the threshold
 		return report.SeverityLow
@@ -356,82 +341,3 @@ func (a *QualityAnalyzer) readFileContent(filePath string) ([]byte, error) {
 
 	return io.ReadAll(file)
 }
-
-// analyzeFunctionComplexity analyzes function complexity from file content
-func (a *QualityAnalyzer) analyzeFunctionComplexity(content, filePath string) []report.Issue {
-	var issues []report.Issue
-
-	// Skip test files
-	if a.isTestFile(filePath) {
-		return issues
-	}
-
-	// Find all function declarations using regex
-	funcRegex := regexp.MustCompile(`func\s+(\([^)]+\)\s+)?(\w+\s*)?\(`)
-	lines := strings.Split(content, "\n")
-
-	for lineNum, line := range lines {
-		if funcRegex.MatchString(line) {
-			// Calculate complexity for this function
-			complexity := a.calculateComplexityFromContent(content, lineNum+1)
-			if complexity > a.config.ComplexityThreshold {
-				issue := report.Issue{
-					ID:          fmt.Sprintf("high-complexity-%s-%d", strings.ReplaceAll(filePath, "/", "-"), lineNum+1),
-					Title:       "High function complexity",
-					Description: fmt.Sprintf("Complexity: %d (threshold: %d). This function has many decision points making it harder to understand and test.", complexity, a.config.ComplexityThreshold),
-					Category:    report.CategoryQuality,
-					Severity:    a.determineSeverityByComplexity(complexity),
-					File:        filePath,
-					Line:        lineNum + 1,
-					Rule:        "cyclomatic-complexity",
-					Fix:         "Break into smaller functions, reduce nested conditions, or use early returns",
-					CreatedAt:   time.Now(),
-				}
-				issues = append(issues, issue)
-			}
-		}
-	}
-
-	return issues
-}
-
-// calculateComplexityFromContent calculates cyclomatic complexity from file content
-func (a *QualityAnalyzer) calculateComplexityFromContent(content string, startLine int) int {
-	// Start with a baseline complexity of 1
-	complexity := 1
-	lines := strings.Split(content, "\n")
-
-	// Find the end of the function (next function or end of file)
-	endLine := len(lines)
-	funcRegex := regexp.MustCompile(`func\s+(\([^)]+\)\s+)?(\w+\s*)?\(`)
-	for i := startLine; i < len(lines); i++ {
-		if funcRegex.MatchString(lines[i]) {
-			endLine = i
-			break
-		}
-	}
-
-	// Count complexity patterns in the function
-	complexityPatterns := []*regexp.Regexp{
-		regexp.MustCompile(`\bif\b`),
-		regexp.MustCompile(`\bfor\b`),
-		regexp.MustCompile(`\brange\b`),
-		regexp.MustCompile(`\bswitch\b`),
-		regexp.MustCompile(`\bcase\b`),
-		regexp.MustCompile(`\bselect\b`),
-		regexp.MustCompile(`&&`),
-		regexp.MustCompile(`\|\|`),
-		regexp.MustCompile(`\bgoto\b`),
-	}
-
-	for i := startLine - 1; i < endLine && i < len(lines); i++ {
-		line := lines[i]
-		for _, pattern := range complexityPatterns {
-			if pattern.MatchString(line) {
-				complexity++
-			}
-		}
-	}
-
-	return complexity
-}
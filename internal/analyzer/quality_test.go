@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/githealthchecker/git-health-checker/internal/config"
+	"github.com/githealthchecker/git-health-checker/internal/filekind"
 	"github.com/githealthchecker/git-health-checker/internal/report"
 	"github.com/githealthchecker/git-health-checker/internal/scanner"
 )
@@ -76,7 +77,11 @@ func main() {
 	}
 
 	// Create analyzer
-	analyzer := NewQualityAnalyzer(cfg, fileScanner)
+	fileKinds, err := filekind.NewResolver(nil)
+	if err != nil {
+		t.Fatalf("Failed to create file kind resolver: %v", err)
+	}
+	analyzer := NewQualityAnalyzer(cfg, fileScanner, fileKinds)
 
 	// Run analysis
 	issues, err := analyzer.Analyze()
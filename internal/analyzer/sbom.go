@@ -0,0 +1,433 @@
+package analyzer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/module"
+
+	"github.com/githealthchecker/git-health-checker/internal/config"
+)
+
+// SBOMComponent is one resolved dependency, normalized enough to be rendered
+// into either a CycloneDX or an SPDX document.
+type SBOMComponent struct {
+	Name      string
+	Version   string
+	Ecosystem string // "Go", "npm", or "PyPI" - matches LockedDependency.Ecosystem
+	PURL      string
+	License   string // SPDX license ID, or "" if it couldn't be determined
+	Hash      string // "<algorithm>:<value>", or "" if no lockfile hash was available
+}
+
+// SBOMExporter builds a software bill of materials for a repository's
+// resolved dependencies. It reuses DependencyAnalyzer's manifest and
+// lockfile parsing rather than re-reading go.mod/package.json itself.
+type SBOMExporter struct {
+	deps *DependencyAnalyzer
+}
+
+func NewSBOMExporter(repoPath string) *SBOMExporter {
+	return &SBOMExporter{deps: NewDependencyAnalyzer(&config.DependencyConfig{}, repoPath)}
+}
+
+// Components resolves every Go and npm dependency the repository declares
+// into an SBOMComponent, preferring exact versions and hashes from go.sum /
+// the npm lockfile over the looser ranges a manifest alone provides.
+func (e *SBOMExporter) Components() ([]SBOMComponent, error) {
+	var components []SBOMComponent
+
+	if e.deps.hasGoMod() {
+		goComponents, err := e.goComponents()
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve Go components: %w", err)
+		}
+		components = append(components, goComponents...)
+	}
+
+	if e.deps.hasPackageJson() {
+		npmComponents, err := e.npmComponents()
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve npm components: %w", err)
+		}
+		components = append(components, npmComponents...)
+	}
+
+	return components, nil
+}
+
+func (e *SBOMExporter) goComponents() ([]SBOMComponent, error) {
+	modFile, err := e.deps.parseGoMod()
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := map[string]string{}
+	if data, err := os.ReadFile(filepath.Join(e.deps.repoPath, goSum)); err == nil {
+		if locked, err := (goSumLockfile{}).Parse(data); err == nil {
+			for _, dep := range locked {
+				hashes[dep.Name+"@"+dep.Version] = dep.Integrity
+			}
+		}
+	}
+
+	cacheDir := e.deps.resolveGoModCache()
+
+	components := make([]SBOMComponent, 0, len(modFile.Require))
+	for _, req := range modFile.Require {
+		component := SBOMComponent{
+			Name:      req.Mod.Path,
+			Version:   req.Mod.Version,
+			Ecosystem: "Go",
+			PURL:      purl("golang", req.Mod.Path, req.Mod.Version),
+		}
+		if hash := hashes[req.Mod.Path+"@"+req.Mod.Version]; hash != "" {
+			component.Hash = "h1:" + strings.TrimPrefix(hash, "h1:")
+		}
+		if cacheDir != "" {
+			component.License = goModuleLicense(cacheDir, req.Mod.Path, req.Mod.Version)
+		}
+		components = append(components, component)
+	}
+
+	return components, nil
+}
+
+func (e *SBOMExporter) npmComponents() ([]SBOMComponent, error) {
+	lockFile, decoder := e.deps.selectNpmLockfile()
+	if lockFile == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(e.deps.repoPath, lockFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", lockFile, err)
+	}
+
+	locked, err := decoder.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	components := make([]SBOMComponent, 0, len(locked))
+	for _, dep := range locked {
+		component := SBOMComponent{
+			Name:      dep.Name,
+			Version:   dep.Version,
+			Ecosystem: "npm",
+			PURL:      purl("npm", dep.Name, dep.Version),
+			License:   npmPackageLicense(e.deps.repoPath, dep.Name),
+		}
+		if alg, value := parseSRIHash(dep.Integrity); value != "" {
+			component.Hash = alg + ":" + value
+		}
+		components = append(components, component)
+	}
+
+	return components, nil
+}
+
+// purl builds a Package URL (https://github.com/package-url/purl-spec) for a
+// resolved dependency. It's deliberately minimal - no qualifiers or
+// subpaths - since that's all an SBOM consumer needs to look the component
+// up in its own vulnerability database.
+func purl(ecosystemType, name, version string) string {
+	return fmt.Sprintf("pkg:%s/%s@%s", ecosystemType, name, version)
+}
+
+// parseSRIHash splits an npm Subresource Integrity string ("sha512-base64...")
+// into a hash algorithm name and its base64 value. It returns ("", "") for an
+// empty or malformed integrity string.
+func parseSRIHash(integrity string) (algorithm, value string) {
+	alg, val, ok := strings.Cut(integrity, "-")
+	if !ok || val == "" {
+		return "", ""
+	}
+	return alg, val
+}
+
+// licenseFilenames are tried, in order, as the license file in an extracted
+// Go module directory.
+var licenseFilenames = []string{"LICENSE", "LICENSE.md", "LICENSE.txt", "COPYING"}
+
+// goModuleLicense looks for a license file in modPath@version's extracted
+// directory under the Go module cache and classifies it with
+// detectLicenseID. It returns "" if the module isn't extracted locally
+// (common: go.sum routinely pins far more modules than are cached) or its
+// license text isn't one detectLicenseID recognizes.
+func goModuleLicense(cacheDir, modPath, version string) string {
+	escapedPath, err := module.EscapePath(modPath)
+	if err != nil {
+		return ""
+	}
+	escapedVersion, err := module.EscapeVersion(version)
+	if err != nil {
+		return ""
+	}
+
+	moduleDir := filepath.Join(cacheDir, escapedPath+"@"+escapedVersion)
+	for _, name := range licenseFilenames {
+		content, err := os.ReadFile(filepath.Join(moduleDir, name))
+		if err != nil {
+			continue
+		}
+		return detectLicenseID(content)
+	}
+
+	return ""
+}
+
+// npmPackageLicense reads the "license" field from
+// node_modules/name/package.json. Modern packages declare it as a plain
+// SPDX string; some older ones use a legacy {"type": "..."} object instead,
+// so both shapes are tried against the same raw field. It returns "" if the
+// package isn't installed locally or declares no license.
+func npmPackageLicense(repoPath, name string) string {
+	data, err := os.ReadFile(filepath.Join(repoPath, "node_modules", name, packageJson))
+	if err != nil {
+		return ""
+	}
+
+	var manifest struct {
+		License json.RawMessage `json:"license"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil || len(manifest.License) == 0 {
+		return ""
+	}
+
+	var license string
+	if err := json.Unmarshal(manifest.License, &license); err == nil {
+		return license
+	}
+
+	var legacy struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(manifest.License, &legacy); err == nil {
+		return legacy.Type
+	}
+
+	return ""
+}
+
+// licenseSignatures maps a distinctive substring of a license's canonical
+// text to its SPDX identifier. Matching is best-effort: it's enough to
+// classify the common open-source licenses a dependency tree is built from,
+// not to replace a real license scanner.
+var licenseSignatures = []struct {
+	substring string
+	spdxID    string
+}{
+	{"Apache License", "Apache-2.0"},
+	{"MIT License", "MIT"},
+	{"Permission is hereby granted, free of charge", "MIT"},
+	{"GNU LESSER GENERAL PUBLIC LICENSE", "LGPL-3.0"},
+	{"GNU GENERAL PUBLIC LICENSE", "GPL-3.0"},
+	{"Mozilla Public License", "MPL-2.0"},
+	{"BSD 3-Clause", "BSD-3-Clause"},
+	{"BSD 2-Clause", "BSD-2-Clause"},
+	{"Redistributions in binary form", "BSD-3-Clause"},
+	{"ISC License", "ISC"},
+}
+
+// detectLicenseID classifies license file content by the first
+// licenseSignatures substring it contains, returning "" if none match.
+func detectLicenseID(content []byte) string {
+	text := string(content)
+	for _, sig := range licenseSignatures {
+		if strings.Contains(text, sig.substring) {
+			return sig.spdxID
+		}
+	}
+	return ""
+}
+
+// cycloneDXDocument is the subset of the CycloneDX 1.5 JSON schema this
+// package populates: https://cyclonedx.org/docs/1.5/json/
+type cycloneDXDocument struct {
+	BOMFormat   string               `json:"bomFormat"`
+	SpecVersion string               `json:"specVersion"`
+	Version     int                  `json:"version"`
+	Metadata    cycloneDXMetadata    `json:"metadata"`
+	Components  []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string `json:"timestamp"`
+}
+
+type cycloneDXComponent struct {
+	Type     string             `json:"type"`
+	Name     string             `json:"name"`
+	Version  string             `json:"version"`
+	PURL     string             `json:"purl"`
+	Licenses []cycloneDXLicense `json:"licenses,omitempty"`
+	Hashes   []cycloneDXHash    `json:"hashes,omitempty"`
+}
+
+type cycloneDXLicense struct {
+	License cycloneDXLicenseID `json:"license"`
+}
+
+type cycloneDXLicenseID struct {
+	ID string `json:"id"`
+}
+
+type cycloneDXHash struct {
+	Algorithm string `json:"alg"`
+	Content   string `json:"content"`
+}
+
+// CycloneDX renders the repository's resolved dependencies as a CycloneDX
+// 1.5 JSON document.
+func (e *SBOMExporter) CycloneDX() ([]byte, error) {
+	components, err := e.Components()
+	if err != nil {
+		return nil, err
+	}
+
+	doc := cycloneDXDocument{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: "1.5",
+		Version:     1,
+		Metadata:    cycloneDXMetadata{Timestamp: time.Now().UTC().Format(time.RFC3339)},
+		Components:  make([]cycloneDXComponent, 0, len(components)),
+	}
+
+	for _, c := range components {
+		component := cycloneDXComponent{
+			Type:    "library",
+			Name:    c.Name,
+			Version: c.Version,
+			PURL:    c.PURL,
+		}
+		if c.License != "" {
+			component.Licenses = []cycloneDXLicense{{License: cycloneDXLicenseID{ID: c.License}}}
+		}
+		if c.Hash != "" {
+			component.Hashes = []cycloneDXHash{{Algorithm: cycloneDXHashAlgorithm(c.Hash), Content: c.Hash}}
+		}
+		doc.Components = append(doc.Components, component)
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// cycloneDXHashAlgorithm maps the "<algorithm>:<value>" prefix SBOMComponent
+// uses internally to the alg name CycloneDX's hash-algorithm enum expects.
+func cycloneDXHashAlgorithm(hash string) string {
+	alg, _, _ := strings.Cut(hash, ":")
+	switch strings.ToLower(alg) {
+	case "sha512":
+		return "SHA-512"
+	case "sha384":
+		return "SHA-384"
+	case "sha1":
+		return "SHA-1"
+	default:
+		return "SHA-256" // go.sum's h1: hash is a SHA-256 dirhash
+	}
+}
+
+// spdxChecksumAlgorithm maps the "<algorithm>:<value>" prefix SBOMComponent
+// uses internally to the algorithm name SPDX's checksum enum expects
+// (no hyphen, unlike CycloneDX's).
+func spdxChecksumAlgorithm(hash string) string {
+	return strings.ReplaceAll(cycloneDXHashAlgorithm(hash), "-", "")
+}
+
+// spdxDocument is the subset of the SPDX 2.3 JSON schema this package
+// populates: https://spdx.github.io/spdx-spec/v2.3/
+type spdxDocument struct {
+	SPDXVersion       string           `json:"spdxVersion"`
+	DataLicense       string           `json:"dataLicense"`
+	SPDXID            string           `json:"SPDXID"`
+	Name              string           `json:"name"`
+	DocumentNamespace string           `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo `json:"creationInfo"`
+	Packages          []spdxPackage    `json:"packages"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID           string            `json:"SPDXID"`
+	Name             string            `json:"name"`
+	VersionInfo      string            `json:"versionInfo"`
+	DownloadLocation string            `json:"downloadLocation"`
+	LicenseConcluded string            `json:"licenseConcluded"`
+	LicenseDeclared  string            `json:"licenseDeclared"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+	Checksums        []spdxChecksum    `json:"checksums,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+// SPDX renders the repository's resolved dependencies as an SPDX 2.3 JSON
+// document.
+func (e *SBOMExporter) SPDX() ([]byte, error) {
+	components, err := e.Components()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	doc := spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              filepath.Base(e.deps.repoPath),
+		DocumentNamespace: fmt.Sprintf("https://githealthchecker.local/sbom/%s-%d", filepath.Base(e.deps.repoPath), now.UnixNano()),
+		CreationInfo: spdxCreationInfo{
+			Created:  now.Format(time.RFC3339),
+			Creators: []string{"Tool: githealthchecker"},
+		},
+		Packages: make([]spdxPackage, 0, len(components)),
+	}
+
+	for i, c := range components {
+		license := "NOASSERTION"
+		if c.License != "" {
+			license = c.License
+		}
+
+		pkg := spdxPackage{
+			SPDXID:           fmt.Sprintf("SPDXRef-Package-%d", i+1),
+			Name:             c.Name,
+			VersionInfo:      c.Version,
+			DownloadLocation: "NOASSERTION",
+			LicenseConcluded: license,
+			LicenseDeclared:  license,
+			ExternalRefs: []spdxExternalRef{{
+				ReferenceCategory: "PACKAGE-MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  c.PURL,
+			}},
+		}
+		if c.Hash != "" {
+			pkg.Checksums = []spdxChecksum{{
+				Algorithm:     spdxChecksumAlgorithm(c.Hash),
+				ChecksumValue: c.Hash,
+			}}
+		}
+		doc.Packages = append(doc.Packages, pkg)
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
@@ -0,0 +1,167 @@
+package analyzer
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPurl(t *testing.T) {
+	got := purl("golang", "github.com/pkg/errors", "v0.9.1")
+	want := "pkg:golang/github.com/pkg/errors@v0.9.1"
+	if got != want {
+		t.Errorf("purl() = %q, want %q", got, want)
+	}
+}
+
+func TestParseSRIHash(t *testing.T) {
+	tests := []struct {
+		integrity string
+		wantAlg   string
+		wantValue string
+	}{
+		{"sha512-abc123==", "sha512", "abc123=="},
+		{"sha1-xyz==", "sha1", "xyz=="},
+		{"", "", ""},
+		{"malformed", "", ""},
+	}
+
+	for _, tt := range tests {
+		alg, value := parseSRIHash(tt.integrity)
+		if alg != tt.wantAlg || value != tt.wantValue {
+			t.Errorf("parseSRIHash(%q) = (%q, %q), want (%q, %q)", tt.integrity, alg, value, tt.wantAlg, tt.wantValue)
+		}
+	}
+}
+
+func TestDetectLicenseID(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"MIT", "Permission is hereby granted, free of charge, to any person...", "MIT"},
+		{"Apache", "Apache License\nVersion 2.0, January 2004", "Apache-2.0"},
+		{"BSD3", "Redistributions in binary form must reproduce...", "BSD-3-Clause"},
+		{"unknown", "Some proprietary license text", ""},
+	}
+
+	for _, tt := range tests {
+		if got := detectLicenseID([]byte(tt.content)); got != tt.want {
+			t.Errorf("%s: detectLicenseID() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCycloneDXHashAlgorithm(t *testing.T) {
+	tests := []struct {
+		hash string
+		want string
+	}{
+		{"sha512:abc", "SHA-512"},
+		{"sha384:abc", "SHA-384"},
+		{"sha1:abc", "SHA-1"},
+		{"h1:abc", "SHA-256"},
+	}
+
+	for _, tt := range tests {
+		if got := cycloneDXHashAlgorithm(tt.hash); got != tt.want {
+			t.Errorf("cycloneDXHashAlgorithm(%q) = %q, want %q", tt.hash, got, tt.want)
+		}
+	}
+}
+
+func TestSpdxChecksumAlgorithm(t *testing.T) {
+	if got := spdxChecksumAlgorithm("sha512:abc"); got != "SHA512" {
+		t.Errorf("spdxChecksumAlgorithm() = %q, want %q", got, "SHA512")
+	}
+}
+
+func TestSBOMExporter_CycloneDX(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, "go.mod", "module example.com/foo\n\ngo 1.21\n\nrequire github.com/pkg/errors v0.9.1\n")
+	writeTestFile(t, dir, "go.sum", "github.com/pkg/errors v0.9.1 h1:FEBLx1zS214owpjy7qsBeixbURkuhQAwrK5UwLGTwt4=\ngithub.com/pkg/errors v0.9.1/go.mod h1:bwawxfHBFNV+L2hUp1rHADufV3IMtnDRdf1r5NINEl0=\n")
+
+	exporter := NewSBOMExporter(dir)
+	data, err := exporter.CycloneDX()
+	if err != nil {
+		t.Fatalf("CycloneDX() failed: %v", err)
+	}
+
+	var doc cycloneDXDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("CycloneDX() produced invalid JSON: %v", err)
+	}
+
+	if doc.BOMFormat != "CycloneDX" || doc.SpecVersion != "1.5" {
+		t.Errorf("unexpected document header: %+v", doc)
+	}
+	if len(doc.Components) != 1 {
+		t.Fatalf("expected 1 component, got %d", len(doc.Components))
+	}
+	component := doc.Components[0]
+	if component.Name != "github.com/pkg/errors" || component.Version != "v0.9.1" {
+		t.Errorf("unexpected component: %+v", component)
+	}
+	if component.PURL != "pkg:golang/github.com/pkg/errors@v0.9.1" {
+		t.Errorf("unexpected purl: %q", component.PURL)
+	}
+	if len(component.Hashes) != 1 || !strings.HasPrefix(component.Hashes[0].Content, "h1:") {
+		t.Errorf("expected a go.sum hash on the component, got %+v", component.Hashes)
+	}
+}
+
+func TestSBOMExporter_SPDX(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, "go.mod", "module example.com/foo\n\ngo 1.21\n\nrequire github.com/pkg/errors v0.9.1\n")
+
+	exporter := NewSBOMExporter(dir)
+	data, err := exporter.SPDX()
+	if err != nil {
+		t.Fatalf("SPDX() failed: %v", err)
+	}
+
+	var doc spdxDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("SPDX() produced invalid JSON: %v", err)
+	}
+
+	if doc.SPDXVersion != "SPDX-2.3" || doc.DataLicense != "CC0-1.0" {
+		t.Errorf("unexpected document header: %+v", doc)
+	}
+	if len(doc.Packages) != 1 {
+		t.Fatalf("expected 1 package, got %d", len(doc.Packages))
+	}
+	pkg := doc.Packages[0]
+	if pkg.Name != "github.com/pkg/errors" || pkg.VersionInfo != "v0.9.1" {
+		t.Errorf("unexpected package: %+v", pkg)
+	}
+	// LicenseConcluded falls back to "NOASSERTION" unless the module happens
+	// to be extracted in the local module cache; either is valid here.
+	if pkg.LicenseConcluded == "" {
+		t.Errorf("expected LicenseConcluded to default to NOASSERTION, got empty string")
+	}
+	if len(pkg.ExternalRefs) != 1 || pkg.ExternalRefs[0].ReferenceLocator != "pkg:golang/github.com/pkg/errors@v0.9.1" {
+		t.Errorf("unexpected external refs: %+v", pkg.ExternalRefs)
+	}
+}
+
+func TestSBOMExporter_Components_NoManifests(t *testing.T) {
+	exporter := NewSBOMExporter(t.TempDir())
+	components, err := exporter.Components()
+	if err != nil {
+		t.Fatalf("Components() failed: %v", err)
+	}
+	if len(components) != 0 {
+		t.Errorf("expected no components for a repo with no manifests, got %d", len(components))
+	}
+}
+
+func writeTestFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+}
@@ -0,0 +1,70 @@
+package analyzer
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/githealthchecker/git-health-checker/internal/config"
+)
+
+// compiledRule is a config.SecretRule with its pattern pre-compiled (for
+// regex rules) and its allowlist regexes pre-compiled, so scanStructuredRules
+// never recompiles a pattern per file.
+type compiledRule struct {
+	rule         config.SecretRule
+	regex        *regexp.Regexp
+	allowRegexes []*regexp.Regexp
+}
+
+// compileSecretRule compiles rule's pattern and allowlist regexes. An
+// invalid regex pattern is skipped silently, the same tolerance
+// compilePatterns gives the legacy SecretPatterns list.
+func compileSecretRule(rule config.SecretRule) *compiledRule {
+	cr := &compiledRule{rule: rule}
+
+	if rule.Match != config.RuleMatchSubstring {
+		if compiled, err := regexp.Compile(rule.Pattern); err == nil {
+			cr.regex = compiled
+		}
+	}
+
+	for _, allow := range rule.Allowlist.Regexes {
+		if compiled, err := regexp.Compile(allow); err == nil {
+			cr.allowRegexes = append(cr.allowRegexes, compiled)
+		}
+	}
+
+	return cr
+}
+
+// findMatches returns every substring of value the rule's pattern hits.
+func (cr *compiledRule) findMatches(value string) []string {
+	if cr.rule.Match == config.RuleMatchSubstring {
+		if strings.Contains(value, cr.rule.Pattern) {
+			return []string{cr.rule.Pattern}
+		}
+		return nil
+	}
+
+	if cr.regex == nil {
+		return nil
+	}
+	return cr.regex.FindAllString(value, -1)
+}
+
+// isAllowed reports whether relPath or value is covered by the rule's
+// allowlist, so known-safe paths or values never get flagged.
+func (cr *compiledRule) isAllowed(relPath, value string) bool {
+	for _, pattern := range cr.rule.Allowlist.Paths {
+		if matched, _ := filepath.Match(pattern, relPath); matched {
+			return true
+		}
+	}
+	for _, re := range cr.allowRegexes {
+		if re.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/githealthchecker/git-health-checker/internal/config"
+	"github.com/githealthchecker/git-health-checker/internal/filekind"
+	"github.com/githealthchecker/git-health-checker/internal/report"
+	"github.com/githealthchecker/git-health-checker/internal/scanner"
+)
+
+func TestCompileSecretRule_RegexFindsMatch(t *testing.T) {
+	rule := config.SecretRule{ID: "test-regex", Part: config.RulePartContents, Match: config.RuleMatchRegex, Pattern: `sk_test_[a-z0-9]{10}`}
+	cr := compileSecretRule(rule)
+
+	matches := cr.findMatches(`key := "sk_test_abcdef1234"`)
+	if len(matches) != 1 {
+		t.Fatalf("expected 1 match, got %v", matches)
+	}
+}
+
+func TestCompileSecretRule_SubstringFindsMatch(t *testing.T) {
+	rule := config.SecretRule{ID: "test-substring", Part: config.RulePartContents, Match: config.RuleMatchSubstring, Pattern: `"type": "service_account"`}
+	cr := compileSecretRule(rule)
+
+	if matches := cr.findMatches(`{"type": "service_account"}`); len(matches) != 1 {
+		t.Errorf("expected 1 substring match, got %v", matches)
+	}
+	if matches := cr.findMatches(`{"type": "user"}`); len(matches) != 0 {
+		t.Errorf("expected no match, got %v", matches)
+	}
+}
+
+func TestCompiledRule_IsAllowed_PathGlob(t *testing.T) {
+	rule := config.SecretRule{ID: "test-allow", Pattern: "secret", Allowlist: config.RuleAllowlist{Paths: []string{"testdata/*"}}}
+	cr := compileSecretRule(rule)
+
+	if !cr.isAllowed("testdata/fixture.go", "secret") {
+		t.Error("expected testdata/fixture.go to be allowed by the testdata/* glob")
+	}
+	if cr.isAllowed("internal/secret.go", "secret") {
+		t.Error("expected internal/secret.go not to be allowed")
+	}
+}
+
+func TestCompiledRule_IsAllowed_ValueRegex(t *testing.T) {
+	rule := config.SecretRule{ID: "test-allow-value", Pattern: "secret", Allowlist: config.RuleAllowlist{Regexes: []string{`^example-`}}}
+	cr := compileSecretRule(rule)
+
+	if !cr.isAllowed("any.go", "example-secret") {
+		t.Error("expected example-secret to be allowed by the ^example- regex")
+	}
+	if cr.isAllowed("any.go", "real-secret") {
+		t.Error("expected real-secret not to be allowed")
+	}
+}
+
+func TestSecurityAnalyzer_StructuredRules_DispatchByPart(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "structured_rules_test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	testFiles := map[string]string{
+		"id_rsa":  "not a real key, just a filename match",
+		"main.go": `package main\nconst token = "gh_token_123456789012345678901234567890"\n`,
+	}
+	for name, content := range testFiles {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	fileScanner, err := scanner.NewFileScanner(tempDir)
+	if err != nil {
+		t.Fatalf("failed to create file scanner: %v", err)
+	}
+
+	cfg := &config.SecurityConfig{
+		Rules: []config.SecretRule{
+			{ID: "private-key-filename", Part: config.RulePartFilename, Match: config.RuleMatchSubstring, Pattern: "id_rsa", Severity: report.SeverityCritical},
+			{ID: "gh-token", Part: config.RulePartContents, Match: config.RuleMatchRegex, Pattern: `gh_token_[0-9]{30,}`, Severity: report.SeverityCritical},
+		},
+	}
+
+	fileKinds, err := filekind.NewResolver(nil)
+	if err != nil {
+		t.Fatalf("failed to create file kind resolver: %v", err)
+	}
+	analyzer := NewSecurityAnalyzer(cfg, fileScanner, fileKinds)
+	issues, err := analyzer.Analyze()
+	if err != nil {
+		t.Fatalf("analysis failed: %v", err)
+	}
+
+	var foundFilenameRule, foundContentsRule bool
+	for _, issue := range issues {
+		switch issue.Rule {
+		case "private-key-filename":
+			foundFilenameRule = true
+		case "gh-token":
+			foundContentsRule = true
+		}
+	}
+
+	if !foundFilenameRule {
+		t.Error("expected the filename rule to fire for id_rsa")
+	}
+	if !foundContentsRule {
+		t.Error("expected the contents rule to fire for the GitHub token")
+	}
+}
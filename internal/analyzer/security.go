@@ -1,6 +1,7 @@
 package analyzer
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -10,9 +11,14 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gobwas/glob"
+
 	"github.com/githealthchecker/git-health-checker/internal/config"
+	"github.com/githealthchecker/git-health-checker/internal/filekind"
+	"github.com/githealthchecker/git-health-checker/internal/progress"
 	"github.com/githealthchecker/git-health-checker/internal/report"
 	"github.com/githealthchecker/git-health-checker/internal/scanner"
+	"github.com/githealthchecker/git-health-checker/internal/verifier"
 )
 
 type SecurityAnalyzer struct {
@@ -20,18 +26,72 @@ type SecurityAnalyzer struct {
 	scanner          *scanner.FileScanner
 	compiledPatterns []*regexp.Regexp
 	patternOnce      sync.Once
+	// structuredRules holds config.SecurityConfig.Rules compiled and
+	// indexed by RulePart, so scanStructuredRules can dispatch filename,
+	// extension, path and contents rules independently.
+	structuredRules map[config.RulePart][]*compiledRule
+	rulesOnce       sync.Once
+	// entropyExcludes holds config.SecurityConfig.EntropyExcludeGlobs
+	// compiled once, so entropyExcluded can skip a whole file (e.g. a lock
+	// file or testdata fixture) from entropy-based scanning without paying
+	// glob.Compile's cost per file.
+	entropyExcludes     []glob.Glob
+	entropyExcludesOnce sync.Once
+	// ignoreDirectives holds each scanned file's githealth:ignore table,
+	// populated lazily by directivesForFile the first time a file is seen.
+	ignoreDirectives map[string]map[int]*ignoreDirective
+	// verifyMode and verifyPool drive the optional --verify step: a
+	// structured rule match whose config.SecretRule.Verifier is set gets
+	// routed through verifyPool to confirm it's a live credential before
+	// the Issue is finalized.
+	verifyMode verifier.Mode
+	verifyPool *verifier.Pool
+	// fileKinds classifies scanned files by regex rather than extension, so
+	// shouldProcessCachedFile can recognize unconventional file names (see
+	// internal/filekind).
+	fileKinds *filekind.Resolver
+	// suspiciousFiles matches scanned paths against config.SuspiciousFiles,
+	// replacing the old filepath.Match + hard-coded severity list with a
+	// Syncthing-style glob matcher (see suspiciousmatcher.go).
+	suspiciousFiles *suspiciousMatcher
+	reporter        progress.Reporter
 }
 
-func NewSecurityAnalyzer(cfg *config.SecurityConfig, fileScanner *scanner.FileScanner) *SecurityAnalyzer {
+// suspiciousMatchCacheCapacity bounds suspiciousMatcher's LRU, large enough
+// to cover every file in a typical repository scan without growing
+// unbounded in a long-running process.
+const suspiciousMatchCacheCapacity = 4096
+
+func NewSecurityAnalyzer(cfg *config.SecurityConfig, fileScanner *scanner.FileScanner, fileKinds *filekind.Resolver) *SecurityAnalyzer {
+	mode, err := verifier.ParseMode(cfg.VerifyMode)
+	if err != nil {
+		mode = verifier.ModeOff
+	}
+
 	analyzer := &SecurityAnalyzer{
-		config:  cfg,
-		scanner: fileScanner,
+		config:          cfg,
+		scanner:         fileScanner,
+		verifyMode:      mode,
+		verifyPool:      verifier.NewPool(mode),
+		fileKinds:       fileKinds,
+		suspiciousFiles: newSuspiciousMatcher(cfg.SuspiciousFiles, suspiciousMatchCacheCapacity),
+		reporter:        progress.NewNoop(),
 	}
 	// Pre-compile patterns on creation
 	analyzer.compilePatterns()
+	analyzer.compileStructuredRules()
+	analyzer.compileEntropyExcludes()
 	return analyzer
 }
 
+// SetReporter wires a progress.Reporter into the secret scan so callers
+// driving long scans (e.g. the check command against a large monorepo) can
+// render a progress bar instead of appearing to hang, mirroring
+// CodeStatsAnalyzer.SetReporter.
+func (a *SecurityAnalyzer) SetReporter(reporter progress.Reporter) {
+	a.reporter = reporter
+}
+
 // compilePatterns pre-compiles all regex patterns for better performance
 func (a *SecurityAnalyzer) compilePatterns() {
 	a.patternOnce.Do(func() {
@@ -45,6 +105,45 @@ func (a *SecurityAnalyzer) compilePatterns() {
 	})
 }
 
+// compileStructuredRules compiles config.SecurityConfig.Rules and groups
+// them by RulePart, so filename/extension/path rules can be checked
+// against UnifiedFileInfo without reading file content.
+func (a *SecurityAnalyzer) compileStructuredRules() {
+	a.rulesOnce.Do(func() {
+		a.structuredRules = make(map[config.RulePart][]*compiledRule)
+		for _, rule := range a.config.Rules {
+			a.structuredRules[rule.Part] = append(a.structuredRules[rule.Part], compileSecretRule(rule))
+		}
+	})
+}
+
+// compileEntropyExcludes compiles config.SecurityConfig.EntropyExcludeGlobs,
+// skipping any pattern glob.Compile rejects the same way compilePatterns
+// tolerates an invalid regex.
+func (a *SecurityAnalyzer) compileEntropyExcludes() {
+	a.entropyExcludesOnce.Do(func() {
+		a.entropyExcludes = make([]glob.Glob, 0, len(a.config.EntropyExcludeGlobs))
+		for _, pattern := range a.config.EntropyExcludeGlobs {
+			if compiled, err := glob.Compile(pattern, '/'); err == nil {
+				a.entropyExcludes = append(a.entropyExcludes, compiled)
+			}
+		}
+	})
+}
+
+// entropyExcluded reports whether filePath matches one of
+// config.SecurityConfig.EntropyExcludeGlobs, so entropy-based checks can
+// skip a whole file (a lock file, a testdata fixture) rather than relying
+// solely on AllowedSecrets' per-value allowlist.
+func (a *SecurityAnalyzer) entropyExcluded(filePath string) bool {
+	for _, g := range a.entropyExcludes {
+		if g.Match(filePath) {
+			return true
+		}
+	}
+	return false
+}
+
 func (a *SecurityAnalyzer) Analyze() ([]report.Issue, error) {
 	var issues []report.Issue
 
@@ -54,24 +153,41 @@ func (a *SecurityAnalyzer) Analyze() ([]report.Issue, error) {
 	}
 	issues = append(issues, secretIssues...)
 
+	highEntropyIssues, err := a.scanHighEntropyStrings()
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan for high-entropy strings: %w", err)
+	}
+	issues = append(issues, highEntropyIssues...)
+
 	suspiciousIssues, err := a.scanForSuspiciousFiles()
 	if err != nil {
 		return nil, fmt.Errorf("failed to scan for suspicious files: %w", err)
 	}
 	issues = append(issues, suspiciousIssues...)
 
+	if a.config.StrictSuppressions {
+		issues = append(issues, a.unusedSuppressionIssues()...)
+	}
+
 	return issues, nil
 }
 
 func (a *SecurityAnalyzer) scanForSecrets() ([]report.Issue, error) {
-	// Use cached files if available for better performance
+	// Use cached files if available for better performance, scanning now if
+	// nothing primed the cache yet - scanStructuredRules only runs from the
+	// cache path, so without this a caller that never scanned first would
+	// silently lose structured-rule dispatch rather than just losing the
+	// cache's performance benefit.
 	cachedFiles := a.scanner.GetCachedFiles()
-	if len(cachedFiles) > 0 {
-		return a.scanSecretsFromCache(cachedFiles)
+	if len(cachedFiles) == 0 {
+		scanned, err := a.scanner.ScanAllFiles()
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan files: %w", err)
+		}
+		cachedFiles = scanned
 	}
 
-	// Fallback to original pattern-based search
-	return a.scanSecretsWithPatterns()
+	return a.scanSecretsFromCache(cachedFiles)
 }
 
 // scanSecretsWithPatterns performs pattern-based secret scanning
@@ -99,7 +215,7 @@ func (a *SecurityAnalyzer) searchPatternForSecrets(pattern string, extensions []
 
 	var issues []report.Issue
 	for _, match := range matches {
-		if issue := a.processSecretMatch(match); issue != nil {
+		if issue := a.processSecretMatch(pattern, match); issue != nil {
 			issues = append(issues, *issue)
 		}
 	}
@@ -108,7 +224,7 @@ func (a *SecurityAnalyzer) searchPatternForSecrets(pattern string, extensions []
 }
 
 // processSecretMatch processes a single secret match and returns issue if valid
-func (a *SecurityAnalyzer) processSecretMatch(match scanner.Match) *report.Issue {
+func (a *SecurityAnalyzer) processSecretMatch(pattern string, match scanner.Match) *report.Issue {
 	if a.shouldSkipSecretMatch(match) {
 		return nil
 	}
@@ -117,7 +233,19 @@ func (a *SecurityAnalyzer) processSecretMatch(match scanner.Match) *report.Issue
 		return nil
 	}
 
-	severity := a.determineSecretSeverity(match.Content)
+	var metadata map[string]string
+	if a.config.EntropyFiltering {
+		if a.entropyExcluded(match.File) {
+			return nil
+		}
+		scored := scoreEntropy(a.config, match.Content)
+		if !scored.passes {
+			return nil
+		}
+		metadata = entropyMetadata(scored)
+	}
+
+	severity := determineSecretSeverity(match.Content)
 	return &report.Issue{
 		ID:          fmt.Sprintf("secret-%s-%d", strings.ReplaceAll(match.File, "/", "-"), match.Line),
 		Title:       "Potential secret detected",
@@ -128,10 +256,37 @@ func (a *SecurityAnalyzer) processSecretMatch(match scanner.Match) *report.Issue
 		Line:        match.Line,
 		Rule:        "secret-detection",
 		Fix:         "Use environment variables or secure secret management",
+		Metadata:    metadata,
+		Rationale:   fmt.Sprintf("pattern %q matched %q", pattern, truncateString(match.Content, 80)),
+		FixGuidance: secretFixGuidance(),
 		CreatedAt:   time.Now(),
 	}
 }
 
+// secretFixGuidance is the FixGuidance every secret-detection finding
+// shares: removing a committed credential is always the same shape of fix
+// (rotate it, then scrub it from the code), regardless of which rule
+// flagged it.
+func secretFixGuidance() *report.FixGuidance {
+	return &report.FixGuidance{
+		Effort: report.EffortMedium,
+		Patch:  "Move the value into an environment variable or secret manager reference, then rotate the exposed credential",
+		DocURL: "https://github.com/githealthchecker/git-health-checker/blob/main/docs/rules/secret-detection.md",
+		Probe:  "Re-run `check` and confirm the finding no longer appears",
+	}
+}
+
+// suspiciousFileFixGuidance is the FixGuidance every suspicious-file finding
+// shares.
+func suspiciousFileFixGuidance() *report.FixGuidance {
+	return &report.FixGuidance{
+		Effort: report.EffortLow,
+		Patch:  "git rm --cached the file and add its pattern to .gitignore",
+		DocURL: "https://github.com/githealthchecker/git-health-checker/blob/main/docs/rules/suspicious-file-detection.md",
+		Probe:  "Re-run `check` and confirm the file is no longer reported",
+	}
+}
+
 // shouldSkipSecretMatch determines if a secret match should be skipped
 func (a *SecurityAnalyzer) shouldSkipSecretMatch(match scanner.Match) bool {
 	return a.isTestFile(match.File) || a.isSecurityAnalyzerFile(match.File)
@@ -140,12 +295,14 @@ func (a *SecurityAnalyzer) shouldSkipSecretMatch(match scanner.Match) bool {
 // scanSecretsFromCache scans for secrets using cached file content with pre-compiled patterns
 func (a *SecurityAnalyzer) scanSecretsFromCache(cachedFiles map[string]*scanner.UnifiedFileInfo) ([]report.Issue, error) {
 	var issues []report.Issue
-	relevantExtensions := map[string]bool{
-		".go": true, ".mod": true, ".sum": true, ".yaml": true, ".yml": true, ".json": true, ".env": true,
-	}
+
+	a.reporter.Start("security", len(cachedFiles))
+	defer a.reporter.Finish()
 
 	for _, file := range cachedFiles {
-		if !a.shouldProcessCachedFile(file, relevantExtensions) {
+		a.reporter.Increment()
+
+		if !a.shouldProcessCachedFile(file) {
 			continue
 		}
 
@@ -158,13 +315,190 @@ func (a *SecurityAnalyzer) scanSecretsFromCache(cachedFiles map[string]*scanner.
 		issues = append(issues, fileIssues...)
 	}
 
+	issues = append(issues, a.scanStructuredRules(cachedFiles)...)
+
 	return issues, nil
 }
 
+// ruleFinding pairs a structured-rule Issue with the raw matched text and
+// the rule that produced it, so verifySecrets can route it to the right
+// verifier.Verifier without the secret ever being persisted on the Issue
+// itself.
+type ruleFinding struct {
+	issue  report.Issue
+	rule   config.SecretRule
+	secret string
+}
+
+// scanStructuredRules dispatches config.SecurityConfig.Rules against every
+// cached file: filename/extension/path rules are checked against
+// UnifiedFileInfo fields without reading content, while contents rules run
+// the same line-by-line scan searchSecretsInContent uses. Matches naming a
+// verifier are confirmed live before the final issues are returned.
+func (a *SecurityAnalyzer) scanStructuredRules(cachedFiles map[string]*scanner.UnifiedFileInfo) []report.Issue {
+	var findings []ruleFinding
+
+	for _, file := range cachedFiles {
+		if a.isTestFile(file.RelativePath) || a.isSecurityAnalyzerFile(file.RelativePath) {
+			continue
+		}
+
+		findings = append(findings, a.matchFileMetadataRules(file)...)
+
+		if file.IsText {
+			if content := a.getFileContentForScanning(file); content != nil {
+				findings = append(findings, a.matchContentRules(string(content), file.RelativePath)...)
+			}
+		}
+	}
+
+	return a.verifySecrets(findings)
+}
+
+// matchFileMetadataRules checks filename/extension/path rules against file's
+// metadata, never reading its content.
+func (a *SecurityAnalyzer) matchFileMetadataRules(file *scanner.UnifiedFileInfo) []ruleFinding {
+	dispatch := []struct {
+		part  config.RulePart
+		value string
+	}{
+		{config.RulePartFilename, filepath.Base(file.RelativePath)},
+		{config.RulePartExtension, file.Extension},
+		{config.RulePartPath, file.RelativePath},
+	}
+
+	var findings []ruleFinding
+	for _, d := range dispatch {
+		for _, cr := range a.structuredRules[d.part] {
+			for _, match := range cr.findMatches(d.value) {
+				if cr.isAllowed(file.RelativePath, match) || a.isAllowedSecret(match) {
+					continue
+				}
+				if a.fileSuppressesRule(file.RelativePath, cr.rule.ID) {
+					continue
+				}
+				findings = append(findings, ruleFinding{
+					issue:  a.structuredRuleIssue(cr.rule, file.RelativePath, 0, match),
+					rule:   cr.rule,
+					secret: match,
+				})
+			}
+		}
+	}
+
+	return findings
+}
+
+// matchContentRules runs the contents-part structured rules over content
+// line by line, the same way searchSecretsInContent runs the legacy
+// SecretPatterns.
+func (a *SecurityAnalyzer) matchContentRules(content, filePath string) []ruleFinding {
+	rules := a.structuredRules[config.RulePartContents]
+	if len(rules) == 0 {
+		return nil
+	}
+
+	a.directivesForFile(filePath, content)
+
+	var findings []ruleFinding
+	for lineNum, line := range strings.Split(content, "\n") {
+		for _, cr := range rules {
+			for _, match := range cr.findMatches(line) {
+				if cr.isAllowed(filePath, match) || a.isAllowedSecret(match) {
+					continue
+				}
+				if a.isSuppressed(filePath, lineNum+1, cr.rule.ID) {
+					continue
+				}
+				findings = append(findings, ruleFinding{
+					issue:  a.structuredRuleIssue(cr.rule, filePath, lineNum+1, match),
+					rule:   cr.rule,
+					secret: match,
+				})
+			}
+		}
+	}
+
+	return findings
+}
+
+// verifySecrets confirms every finding whose rule names a verifier, via a
+// single verifier.Pool.Run batch, then folds each outcome back into its
+// Issue: a confirmed-live secret under --verify=all is force-upgraded to
+// Critical and its description records the responding identity, never the
+// secret. Findings with no verifier configured, or when --verify=off, pass
+// through unchanged.
+func (a *SecurityAnalyzer) verifySecrets(findings []ruleFinding) []report.Issue {
+	issues := make([]report.Issue, len(findings))
+	for i, f := range findings {
+		issues[i] = f.issue
+	}
+
+	if a.verifyMode == verifier.ModeOff {
+		return issues
+	}
+
+	indices := make([]int, 0, len(findings))
+	jobs := make([]verifier.Job, 0, len(findings))
+	for i, f := range findings {
+		if f.rule.Verifier == "" {
+			continue
+		}
+		indices = append(indices, i)
+		jobs = append(jobs, verifier.Job{RuleID: f.rule.ID, Verifier: f.rule.Verifier, Secret: f.secret})
+	}
+
+	if len(jobs) == 0 {
+		return issues
+	}
+
+	results := a.verifyPool.Run(context.Background(), jobs)
+	for j, result := range results {
+		i := indices[j]
+		if result.Err != nil {
+			issues[i].VerificationError = result.Err.Error()
+			continue
+		}
+
+		issues[i].Verified = result.Valid
+		if result.Valid && a.verifyMode == verifier.ModeAll {
+			issues[i].Severity = report.SeverityCritical
+			issues[i].Description = fmt.Sprintf("%s (confirmed live, identity: %s)", issues[i].Description, result.Identity)
+		}
+	}
+
+	return issues
+}
+
+// structuredRuleIssue builds the report.Issue for a structured rule match,
+// using the rule's own Severity and Fix instead of heuristically guessing
+// them from the matched text.
+func (a *SecurityAnalyzer) structuredRuleIssue(rule config.SecretRule, filePath string, line int, match string) report.Issue {
+	fix := rule.Fix
+	if fix == "" {
+		fix = "Use environment variables or a secret manager instead of committing credentials"
+	}
+
+	return report.Issue{
+		ID:          fmt.Sprintf("%s-%s-%d", rule.ID, strings.ReplaceAll(filePath, "/", "-"), line),
+		Title:       "Potential secret detected",
+		Description: fmt.Sprintf("%s: %s", rule.Name, truncateString(match, 80)),
+		Category:    report.CategorySecurity,
+		Severity:    rule.Severity,
+		File:        filePath,
+		Line:        line,
+		Rule:        rule.ID,
+		Fix:         fix,
+		Rationale:   fmt.Sprintf("rule %q (%s part) matched %q", rule.ID, rule.Part, truncateString(match, 80)),
+		FixGuidance: secretFixGuidance(),
+		CreatedAt:   time.Now(),
+	}
+}
+
 // shouldProcessCachedFile determines if a cached file should be processed for secrets
-func (a *SecurityAnalyzer) shouldProcessCachedFile(file *scanner.UnifiedFileInfo, relevantExtensions map[string]bool) bool {
+func (a *SecurityAnalyzer) shouldProcessCachedFile(file *scanner.UnifiedFileInfo) bool {
 	// Skip if not a relevant file type
-	if !relevantExtensions[file.Extension] && file.Extension != "" {
+	if !a.fileKinds.IsAny(file.RelativePath, filekind.KindGo, filekind.KindGoMod, filekind.KindYAML, filekind.KindJSON, filekind.KindEnv) && file.Extension != "" {
 		return false
 	}
 
@@ -195,6 +529,7 @@ func (a *SecurityAnalyzer) getFileContentForScanning(file *scanner.UnifiedFileIn
 func (a *SecurityAnalyzer) searchSecretsInContent(content, filePath string) []report.Issue {
 	var issues []report.Issue
 	lines := strings.Split(content, "\n")
+	a.directivesForFile(filePath, content)
 
 	for _, pattern := range a.compiledPatterns {
 		for lineNum, line := range lines {
@@ -204,7 +539,23 @@ func (a *SecurityAnalyzer) searchSecretsInContent(content, filePath string) []re
 						continue
 					}
 
-					severity := a.determineSecretSeverity(match)
+					if a.isSuppressed(filePath, lineNum+1, "secret-detection") {
+						continue
+					}
+
+					var metadata map[string]string
+					if a.config.EntropyFiltering {
+						if a.entropyExcluded(filePath) {
+							continue
+						}
+						scored := scoreEntropy(a.config, match)
+						if !scored.passes {
+							continue
+						}
+						metadata = entropyMetadata(scored)
+					}
+
+					severity := determineSecretSeverity(match)
 
 					issue := report.Issue{
 						ID:          fmt.Sprintf("secret-%s-%d", strings.ReplaceAll(filePath, "/", "-"), lineNum+1),
@@ -216,6 +567,9 @@ func (a *SecurityAnalyzer) searchSecretsInContent(content, filePath string) []re
 						Line:        lineNum + 1,
 						Rule:        "secret-detection",
 						Fix:         "Use environment variables or secure secret management",
+						Metadata:    metadata,
+						Rationale:   fmt.Sprintf("pattern %q matched %q", pattern.String(), truncateString(match, 80)),
+						FixGuidance: secretFixGuidance(),
 						CreatedAt:   time.Now(),
 					}
 					issues = append(issues, issue)
@@ -227,6 +581,92 @@ func (a *SecurityAnalyzer) searchSecretsInContent(content, filePath string) []re
 	return issues
 }
 
+// entropyMetadata renders a scored entropy result into Issue.Metadata, so
+// reports can explain why a match was (or wasn't) flagged.
+func entropyMetadata(scored entropyResult) map[string]string {
+	return map[string]string{
+		"entropy":  fmt.Sprintf("%.2f", scored.entropy),
+		"alphabet": scored.alphabet,
+	}
+}
+
+// scanHighEntropyStrings applies the standalone high-entropy-string rule:
+// unlike scanForSecrets, it doesn't require a KEY=/secret: style pattern
+// match, so a pasted credential with no recognizable label is still caught.
+// It's only active when EntropyFiltering is enabled, since it has no
+// pattern to narrow down candidates and would otherwise be noisy on its own.
+func (a *SecurityAnalyzer) scanHighEntropyStrings() ([]report.Issue, error) {
+	if !a.config.EntropyFiltering {
+		return nil, nil
+	}
+
+	var issues []report.Issue
+
+	for _, file := range a.scanner.GetCachedFiles() {
+		if !a.shouldProcessCachedFile(file) || a.entropyExcluded(file.RelativePath) {
+			continue
+		}
+
+		content := a.getFileContentForScanning(file)
+		if content == nil {
+			continue
+		}
+
+		issues = append(issues, a.findHighEntropyLiterals(string(content), file.RelativePath)...)
+	}
+
+	return issues, nil
+}
+
+// findHighEntropyLiterals scans content line by line for base64/hex runs of
+// at least MinHighEntropyLength characters whose entropy clears the
+// threshold for their alphabet.
+func (a *SecurityAnalyzer) findHighEntropyLiterals(content, filePath string) []report.Issue {
+	var issues []report.Issue
+	a.directivesForFile(filePath, content)
+
+	for lineNum, line := range strings.Split(content, "\n") {
+		for _, literal := range highEntropyLiteralPattern.FindAllString(line, -1) {
+			if len(literal) < a.config.MinHighEntropyLength || a.isAllowedSecret(literal) {
+				continue
+			}
+
+			if a.isSuppressed(filePath, lineNum+1, "high-entropy-string") {
+				continue
+			}
+
+			alphabet := classifySecretAlphabet(literal)
+			threshold, hasThreshold := entropyThreshold(a.config, alphabet)
+			if !hasThreshold {
+				continue
+			}
+
+			entropy := shannonEntropy(literal)
+			if entropy < threshold {
+				continue
+			}
+
+			issues = append(issues, report.Issue{
+				ID:          fmt.Sprintf("high-entropy-%s-%d", strings.ReplaceAll(filePath, "/", "-"), lineNum+1),
+				Title:       "High-entropy string detected",
+				Description: fmt.Sprintf("Found a high-entropy %s literal that may be an unlabeled credential: %s", alphabet, truncateString(literal, 80)),
+				Category:    report.CategorySecurity,
+				Severity:    report.SeverityLow,
+				File:        filePath,
+				Line:        lineNum + 1,
+				Rule:        "high-entropy-string",
+				Fix:         "Verify this value isn't a credential; if it is, move it to environment variables or a secret manager",
+				Metadata:    map[string]string{"entropy": fmt.Sprintf("%.2f", entropy), "alphabet": alphabet},
+				Rationale:   fmt.Sprintf("%s literal %q scored entropy %.2f, above the %.2f threshold", alphabet, truncateString(literal, 80), entropy, threshold),
+				FixGuidance: secretFixGuidance(),
+				CreatedAt:   time.Now(),
+			})
+		}
+	}
+
+	return issues
+}
+
 func (a *SecurityAnalyzer) scanForSuspiciousFiles() ([]report.Issue, error) {
 	var issues []report.Issue
 
@@ -239,8 +679,17 @@ func (a *SecurityAnalyzer) scanForSuspiciousFiles() ([]report.Issue, error) {
 				continue
 			}
 
-			if a.isSuspiciousFile(file.RelativePath) {
-				severity := a.determineSuspiciousFileSeverity(file.RelativePath)
+			if match := a.suspiciousFiles.match(file.RelativePath); match.matched {
+				if file.IsText {
+					if content := a.getFileContentForScanning(file); content != nil {
+						a.directivesForFile(file.RelativePath, string(content))
+					}
+				}
+				if a.fileSuppressesRule(file.RelativePath, "suspicious-file-detection") {
+					continue
+				}
+
+				severity := match.severity
 
 				issue := report.Issue{
 					ID:          fmt.Sprintf("suspicious-file-%s", strings.ReplaceAll(file.RelativePath, "/", "-")),
@@ -251,6 +700,8 @@ func (a *SecurityAnalyzer) scanForSuspiciousFiles() ([]report.Issue, error) {
 					File:        file.RelativePath,
 					Rule:        "suspicious-file-detection",
 					Fix:         "Remove the file from version control and add to .gitignore",
+					Rationale:   fmt.Sprintf("path matched suspicious-file pattern %q", match.pattern),
+					FixGuidance: suspiciousFileFixGuidance(),
 					CreatedAt:   time.Now(),
 				}
 				issues = append(issues, issue)
@@ -271,8 +722,8 @@ func (a *SecurityAnalyzer) scanForSuspiciousFiles() ([]report.Issue, error) {
 			continue
 		}
 
-		if a.isSuspiciousFile(file.RelativePath) {
-			severity := a.determineSuspiciousFileSeverity(file.RelativePath)
+		if match := a.suspiciousFiles.match(file.RelativePath); match.matched {
+			severity := match.severity
 
 			issue := report.Issue{
 				ID:          fmt.Sprintf("suspicious-file-%s", strings.ReplaceAll(file.RelativePath, "/", "-")),
@@ -283,6 +734,8 @@ func (a *SecurityAnalyzer) scanForSuspiciousFiles() ([]report.Issue, error) {
 				File:        file.RelativePath,
 				Rule:        "suspicious-file-detection",
 				Fix:         "Remove the file from version control and add to .gitignore",
+				Rationale:   fmt.Sprintf("path matched suspicious-file pattern %q", match.pattern),
+				FixGuidance: suspiciousFileFixGuidance(),
 				CreatedAt:   time.Now(),
 			}
 			issues = append(issues, issue)
@@ -293,8 +746,16 @@ func (a *SecurityAnalyzer) scanForSuspiciousFiles() ([]report.Issue, error) {
 }
 
 func (a *SecurityAnalyzer) isAllowedSecret(content string) bool {
+	return isAllowedSecretValue(a.config, content)
+}
+
+// isAllowedSecretValue is the allowlist check isAllowedSecret wraps, pulled
+// out as a free function so HistorySecretAnalyzer can reuse it against the
+// same config.SecurityConfig.AllowedSecrets without needing a SecurityAnalyzer
+// instance of its own.
+func isAllowedSecretValue(cfg *config.SecurityConfig, content string) bool {
 	content = strings.ToLower(content)
-	for _, allowed := range a.config.AllowedSecrets {
+	for _, allowed := range cfg.AllowedSecrets {
 		if strings.Contains(content, strings.ToLower(allowed)) {
 			return true
 		}
@@ -302,7 +763,7 @@ func (a *SecurityAnalyzer) isAllowedSecret(content string) bool {
 	return false
 }
 
-func (a *SecurityAnalyzer) determineSecretSeverity(content string) report.Severity {
+func determineSecretSeverity(content string) report.Severity {
 	content = strings.ToLower(content)
 
 	// Only check for actual assignment patterns, not just words
@@ -330,43 +791,6 @@ func (a *SecurityAnalyzer) determineSecretSeverity(content string) report.Severi
 	return report.SeverityLow
 }
 
-func (a *SecurityAnalyzer) isSuspiciousFile(filePath string) bool {
-	fileName := filepath.Base(filePath)
-
-	for _, pattern := range a.config.SuspiciousFiles {
-		if matched, _ := filepath.Match(pattern, fileName); matched {
-			return true
-		}
-		if matched, _ := filepath.Match(pattern, filePath); matched {
-			return true
-		}
-	}
-
-	return false
-}
-
-func (a *SecurityAnalyzer) determineSuspiciousFileSeverity(filePath string) report.Severity {
-	fileName := strings.ToLower(filepath.Base(filePath))
-
-	criticalFiles := []string{".env", "id_rsa", "id_dsa", "private.key", "server.key"}
-	highRiskExtensions := []string{".pem", ".key", ".p12", ".pfx", ".jks"}
-
-	for _, critical := range criticalFiles {
-		if fileName == critical || strings.HasSuffix(fileName, critical) {
-			return report.SeverityCritical
-		}
-	}
-
-	ext := strings.ToLower(filepath.Ext(filePath))
-	for _, riskExt := range highRiskExtensions {
-		if ext == riskExt {
-			return report.SeverityHigh
-		}
-	}
-
-	return report.SeverityMedium
-}
-
 func (a *SecurityAnalyzer) isTestFile(filePath string) bool {
 	return a.hasTestFilePattern(filePath) || a.isInTestDirectory(filePath)
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/githealthchecker/git-health-checker/internal/config"
+	"github.com/githealthchecker/git-health-checker/internal/filekind"
 	"github.com/githealthchecker/git-health-checker/internal/report"
 	"github.com/githealthchecker/git-health-checker/internal/scanner"
 )
@@ -52,10 +53,10 @@ func TestSecurityAnalyzer_Analyze(t *testing.T) {
 			`(?i)password[\\s]*[:=][\\s]*['\"]?[^\\s'\"]{8,}['\"]?`,
 			`(?i)secret[\\s]*[:=][\\s]*['\"]?[a-zA-Z0-9]{8,}['\"]?`,
 		},
-		SuspiciousFiles: []string{
-			"*.key",
-			"*.env",
-			"private.*",
+		SuspiciousFiles: []config.SuspiciousFileRule{
+			{Pattern: "*.key", Severity: report.SeverityHigh},
+			{Pattern: "*.env", Severity: report.SeverityCritical},
+			{Pattern: "private.*", Severity: report.SeverityCritical},
 		},
 		AllowedSecrets: []string{
 			"test_key",
@@ -64,7 +65,11 @@ func TestSecurityAnalyzer_Analyze(t *testing.T) {
 	}
 
 	// Create analyzer
-	analyzer := NewSecurityAnalyzer(cfg, fileScanner)
+	fileKinds, err := filekind.NewResolver(nil)
+	if err != nil {
+		t.Fatalf("Failed to create file kind resolver: %v", err)
+	}
+	analyzer := NewSecurityAnalyzer(cfg, fileScanner, fileKinds)
 
 	// Run analysis
 	issues, err := analyzer.Analyze()
@@ -110,9 +115,6 @@ func TestSecurityAnalyzer_Analyze(t *testing.T) {
 }
 
 func TestSecurityAnalyzer_DetermineSecretSeverity(t *testing.T) {
-	cfg := &config.SecurityConfig{}
-	analyzer := &SecurityAnalyzer{config: cfg}
-
 	tests := []struct {
 		content  string
 		expected report.Severity
@@ -126,7 +128,7 @@ func TestSecurityAnalyzer_DetermineSecretSeverity(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		result := analyzer.determineSecretSeverity(test.content)
+		result := determineSecretSeverity(test.content)
 		if result != test.expected {
 			t.Errorf("For content '%s', expected severity %s, got %s",
 				test.content, test.expected, result)
@@ -159,9 +161,57 @@ func TestSecurityAnalyzer_IsAllowedSecret(t *testing.T) {
 	}
 }
 
-func TestSecurityAnalyzer_DetermineSuspiciousFileSeverity(t *testing.T) {
-	cfg := &config.SecurityConfig{}
-	a := &SecurityAnalyzer{config: cfg}
+// TestSecurityAnalyzer_Analyze_SuffixStyleEnvFile guards against a
+// regression where scanForSecrets' cache-based dispatch only matched
+// dotfile-style .env/.env.local names, silently skipping a suffix-style
+// config.env/production.env - real env filenames this tool is meant to
+// catch - without a fallback to notice the gap.
+func TestSecurityAnalyzer_Analyze_SuffixStyleEnvFile(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "security_env_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	content := "API_SECRET=secret123456789"
+	if err := os.WriteFile(filepath.Join(tempDir, "production.env"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	fileScanner, err := scanner.NewFileScanner(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create file scanner: %v", err)
+	}
+
+	cfg := &config.SecurityConfig{
+		SecretPatterns: []string{`(?i)secret[\\s]*[:=][\\s]*['\"]?[a-zA-Z0-9]{8,}['\"]?`},
+	}
+
+	fileKinds, err := filekind.NewResolver(nil)
+	if err != nil {
+		t.Fatalf("Failed to create file kind resolver: %v", err)
+	}
+	analyzer := NewSecurityAnalyzer(cfg, fileScanner, fileKinds)
+
+	issues, err := analyzer.Analyze()
+	if err != nil {
+		t.Fatalf("Analysis failed: %v", err)
+	}
+
+	found := false
+	for _, issue := range issues {
+		if issue.Rule == "secret-detection" && issue.File == "production.env" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected a suffix-style production.env to be scanned for secrets")
+	}
+}
+
+func TestSecurityAnalyzer_SuspiciousFiles_SeverityFromMatchingPattern(t *testing.T) {
+	rules := config.DefaultConfig().Security.SuspiciousFiles
+	matcher := newSuspiciousMatcher(rules, suspiciousMatchCacheCapacity)
 
 	tests := []struct {
 		filePath string
@@ -174,14 +224,44 @@ func TestSecurityAnalyzer_DetermineSuspiciousFileSeverity(t *testing.T) {
 		{"cert.pem", report.SeverityHigh},
 		{"keystore.jks", report.SeverityHigh},
 		{"config.p12", report.SeverityHigh},
-		{"backup.sql", report.SeverityMedium},
+		{"database.sql", report.SeverityMedium},
+		{"site.backup", report.SeverityMedium},
 	}
 
 	for _, test := range tests {
-		result := a.determineSuspiciousFileSeverity(test.filePath)
-		if result != test.expected {
+		result := matcher.match(test.filePath)
+		if !result.matched {
+			t.Errorf("expected %s to be suspicious", test.filePath)
+			continue
+		}
+		if result.severity != test.expected {
 			t.Errorf("For file '%s', expected severity %s, got %s",
-				test.filePath, test.expected, result)
+				test.filePath, test.expected, result.severity)
+		}
+	}
+}
+
+func TestSecurityAnalyzer_EntropyExcluded(t *testing.T) {
+	cfg := &config.SecurityConfig{
+		EntropyExcludeGlobs: []string{"*.lock", "testdata/**"},
+	}
+	analyzer := &SecurityAnalyzer{config: cfg}
+	analyzer.compileEntropyExcludes()
+
+	tests := []struct {
+		filePath string
+		expected bool
+	}{
+		{"yarn.lock", true},
+		{"testdata/fixtures/secret.txt", true},
+		{"main.go", false},
+		{"internal/analyzer/security.go", false},
+	}
+
+	for _, test := range tests {
+		result := analyzer.entropyExcluded(test.filePath)
+		if result != test.expected {
+			t.Errorf("entropyExcluded(%q) = %v, want %v", test.filePath, result, test.expected)
 		}
 	}
 }
@@ -0,0 +1,156 @@
+package analyzer
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+// ignoreDirective records the rule globs a single `githealth:ignore` comment
+// names, and whether any finding actually matched one of them, so --strict
+// can flag suppressions that silence nothing as their own unused-ignore
+// finding.
+type ignoreDirective struct {
+	rules []string
+	used  bool
+}
+
+// ignoreDirectivePattern matches a `// githealth:ignore <rule>[,<rule>...]`
+// or `# githealth:ignore ...` comment, as a trailing comment on the same
+// line as a finding or as its own standalone comment line.
+var ignoreDirectivePattern = regexp.MustCompile(`(?://|#)\s*githealth:ignore\s+([\w*,\s-]+)`)
+
+// parseIgnoreDirectives scans content for ignore directives and returns the
+// line each applies to: a directive trailing code on a line applies to that
+// same line, while a directive that's the entire line (a standalone
+// comment) applies to the line right after it, borrowing the LineIgnore
+// approach from staticcheck.
+func parseIgnoreDirectives(content string) map[int]*ignoreDirective {
+	table := make(map[int]*ignoreDirective)
+
+	for i, line := range strings.Split(content, "\n") {
+		match := ignoreDirectivePattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		rules := splitRuleGlobs(match[1])
+		if len(rules) == 0 {
+			continue
+		}
+
+		lineNum := i + 1
+		if isWholeLineComment(line) {
+			lineNum = i + 2
+		}
+
+		if existing, ok := table[lineNum]; ok {
+			existing.rules = append(existing.rules, rules...)
+		} else {
+			table[lineNum] = &ignoreDirective{rules: rules}
+		}
+	}
+
+	return table
+}
+
+func splitRuleGlobs(raw string) []string {
+	var rules []string
+	for _, rule := range strings.Split(raw, ",") {
+		if rule = strings.TrimSpace(rule); rule != "" {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+func isWholeLineComment(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "#")
+}
+
+// directivesForFile returns filePath's ignore-directive table, parsing
+// content into it the first time the file is seen and reusing the result on
+// every later lookup for the same file.
+func (a *SecurityAnalyzer) directivesForFile(filePath, content string) map[int]*ignoreDirective {
+	if a.ignoreDirectives == nil {
+		a.ignoreDirectives = make(map[string]map[int]*ignoreDirective)
+	}
+	if table, ok := a.ignoreDirectives[filePath]; ok {
+		return table
+	}
+
+	table := parseIgnoreDirectives(content)
+	a.ignoreDirectives[filePath] = table
+	return table
+}
+
+// isSuppressed reports whether filePath's directive table silences rule on
+// line, marking the matching directive used so it won't be flagged as
+// stale. Globs are matched with filepath.Match semantics, so "secret-*"
+// silences every secret-detection-family rule on that line.
+func (a *SecurityAnalyzer) isSuppressed(filePath string, line int, rule string) bool {
+	directive, ok := a.ignoreDirectives[filePath][line]
+	if !ok {
+		return false
+	}
+	return matchAndMarkUsed(directive, rule)
+}
+
+// fileSuppressesRule reports whether any directive anywhere in filePath
+// silences rule, for findings (like suspicious-file-detection) that aren't
+// tied to a specific line.
+func (a *SecurityAnalyzer) fileSuppressesRule(filePath, rule string) bool {
+	suppressed := false
+	for _, directive := range a.ignoreDirectives[filePath] {
+		if matchAndMarkUsed(directive, rule) {
+			suppressed = true
+		}
+	}
+	return suppressed
+}
+
+func matchAndMarkUsed(directive *ignoreDirective, rule string) bool {
+	for _, glob := range directive.rules {
+		if matched, _ := filepath.Match(glob, rule); matched {
+			directive.used = true
+			return true
+		}
+	}
+	return false
+}
+
+// unusedSuppressionIssues reports one `unused-ignore` finding per
+// githealth:ignore directive that never suppressed anything, so stale
+// suppressions get cleaned up instead of accumulating silently. Only called
+// when --strict is set.
+func (a *SecurityAnalyzer) unusedSuppressionIssues() []report.Issue {
+	var issues []report.Issue
+
+	for filePath, table := range a.ignoreDirectives {
+		for line, directive := range table {
+			if directive.used {
+				continue
+			}
+
+			issues = append(issues, report.Issue{
+				ID:          fmt.Sprintf("unused-ignore-%s-%d", strings.ReplaceAll(filePath, "/", "-"), line),
+				Title:       "Unused suppression directive",
+				Description: fmt.Sprintf("githealth:ignore directive for %s on line %d never suppressed a finding", strings.Join(directive.rules, ","), line),
+				Category:    report.CategorySecurity,
+				Severity:    report.SeverityLow,
+				File:        filePath,
+				Line:        line,
+				Rule:        "unused-ignore",
+				Fix:         "Remove the stale githealth:ignore comment",
+				CreatedAt:   time.Now(),
+			})
+		}
+	}
+
+	return issues
+}
@@ -0,0 +1,68 @@
+package analyzer
+
+import "testing"
+
+func TestParseIgnoreDirectives_TrailingComment(t *testing.T) {
+	content := "line one\napiKey := \"sk_test_1234567890abcdef\" // githealth:ignore secret-detection\nline three"
+
+	table := parseIgnoreDirectives(content)
+
+	directive, ok := table[2]
+	if !ok {
+		t.Fatalf("expected a directive on line 2, got table %+v", table)
+	}
+	if len(directive.rules) != 1 || directive.rules[0] != "secret-detection" {
+		t.Errorf("expected rules [secret-detection], got %v", directive.rules)
+	}
+}
+
+func TestParseIgnoreDirectives_StandaloneCommentAppliesToNextLine(t *testing.T) {
+	content := "// githealth:ignore secret-detection,high-entropy-string\napiKey := \"sk_test_1234567890abcdef\""
+
+	table := parseIgnoreDirectives(content)
+
+	directive, ok := table[2]
+	if !ok {
+		t.Fatalf("expected a directive on line 2, got table %+v", table)
+	}
+	if len(directive.rules) != 2 {
+		t.Errorf("expected 2 rules, got %v", directive.rules)
+	}
+}
+
+func TestSecurityAnalyzer_IsSuppressed_GlobMatch(t *testing.T) {
+	a := &SecurityAnalyzer{}
+	content := "secret := \"value\" // githealth:ignore secret-*"
+	a.directivesForFile("config.go", content)
+
+	if !a.isSuppressed("config.go", 1, "secret-detection") {
+		t.Error("expected secret-detection to be suppressed by the secret-* glob")
+	}
+	if a.isSuppressed("config.go", 1, "high-entropy-string") {
+		t.Error("expected high-entropy-string not to match the secret-* glob")
+	}
+}
+
+func TestUnusedSuppressionIssues_FlagsDirectiveThatNeverMatched(t *testing.T) {
+	a := &SecurityAnalyzer{}
+	a.directivesForFile("config.go", "value := 1 // githealth:ignore secret-detection")
+
+	issues := a.unusedSuppressionIssues()
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 unused-ignore issue, got %d", len(issues))
+	}
+	if issues[0].Rule != "unused-ignore" {
+		t.Errorf("expected rule unused-ignore, got %s", issues[0].Rule)
+	}
+}
+
+func TestUnusedSuppressionIssues_SkipsUsedDirective(t *testing.T) {
+	a := &SecurityAnalyzer{}
+	a.directivesForFile("config.go", "value := 1 // githealth:ignore secret-detection")
+	a.isSuppressed("config.go", 1, "secret-detection")
+
+	issues := a.unusedSuppressionIssues()
+	if len(issues) != 0 {
+		t.Fatalf("expected 0 unused-ignore issues after the directive was used, got %d", len(issues))
+	}
+}
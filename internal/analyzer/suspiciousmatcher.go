@@ -0,0 +1,176 @@
+package analyzer
+
+import (
+	"container/list"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/gobwas/glob"
+
+	"github.com/githealthchecker/git-health-checker/internal/config"
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+// matchFlag is a bitmask describing how a compiled suspiciousPattern applies
+// to a hit, the same pattern/flags split Syncthing's .stignore engine uses:
+// a pattern is either an include or, with a leading "!", an exclude, and can
+// independently be case-folded with a leading "(?i)".
+type matchFlag uint8
+
+const (
+	flagInclude matchFlag = 1 << iota
+	flagFoldCase
+)
+
+// suspiciousPattern is one compiled SuspiciousFileRule.
+type suspiciousPattern struct {
+	glob     glob.Glob
+	flags    matchFlag
+	severity report.Severity
+	raw      string
+}
+
+// compileSuspiciousPattern parses rule.Pattern's "!" and "(?i)" prefixes and
+// compiles the remainder with gobwas/glob, which (unlike filepath.Match)
+// understands "**" as "any number of path segments". An invalid pattern is
+// skipped silently, the same tolerance compilePatterns gives SecretPatterns.
+func compileSuspiciousPattern(rule config.SuspiciousFileRule) (suspiciousPattern, bool) {
+	raw := rule.Pattern
+	flags := flagInclude
+
+	if strings.HasPrefix(raw, "!") {
+		flags &^= flagInclude
+		raw = raw[1:]
+	}
+	if strings.HasPrefix(raw, "(?i)") {
+		flags |= flagFoldCase
+		raw = raw[len("(?i)"):]
+	}
+	if flags&flagFoldCase != 0 {
+		raw = strings.ToLower(raw)
+	}
+
+	compiled, err := glob.Compile(raw, '/')
+	if err != nil {
+		return suspiciousPattern{}, false
+	}
+
+	return suspiciousPattern{glob: compiled, flags: flags, severity: rule.Severity, raw: rule.Pattern}, true
+}
+
+// suspiciousMatch is the outcome of matching a path against a
+// suspiciousMatcher: whether any pattern matched and, if so, the severity
+// and the raw SuspiciousFileRule.Pattern carried by the pattern that matched.
+type suspiciousMatch struct {
+	matched  bool
+	severity report.Severity
+	pattern  string
+}
+
+// suspiciousMatcher evaluates a file path against an ordered list of
+// suspiciousPatterns, walking them in config order and short-circuiting on
+// the first hit - later patterns never override an earlier one, the same
+// first-match-wins semantics a .gitignore/.stignore engine uses. Results are
+// cached by relative path behind an LRU, since scanForSuspiciousFiles
+// re-checks the same tree across analyzer runs.
+type suspiciousMatcher struct {
+	patterns []suspiciousPattern
+	mu       sync.Mutex
+	cache    *lruCache
+}
+
+// newSuspiciousMatcher compiles rules in order, dropping any with an invalid
+// pattern, and backs lookups with a cache of the given capacity.
+func newSuspiciousMatcher(rules []config.SuspiciousFileRule, cacheCapacity int) *suspiciousMatcher {
+	m := &suspiciousMatcher{cache: newLRUCache(cacheCapacity)}
+	for _, rule := range rules {
+		if compiled, ok := compileSuspiciousPattern(rule); ok {
+			m.patterns = append(m.patterns, compiled)
+		}
+	}
+	return m
+}
+
+// match reports whether relPath is suspicious, and if so, which severity to
+// report, checking both the bare filename and the full relative path against
+// each pattern the way the legacy filepath.Match-based isSuspiciousFile did.
+func (m *suspiciousMatcher) match(relPath string) suspiciousMatch {
+	m.mu.Lock()
+	if cached, ok := m.cache.get(relPath); ok {
+		m.mu.Unlock()
+		return cached
+	}
+	m.mu.Unlock()
+
+	result := m.evaluate(relPath)
+
+	m.mu.Lock()
+	m.cache.put(relPath, result)
+	m.mu.Unlock()
+
+	return result
+}
+
+func (m *suspiciousMatcher) evaluate(relPath string) suspiciousMatch {
+	fileName := filepath.Base(relPath)
+
+	for _, p := range m.patterns {
+		name, path := fileName, relPath
+		if p.flags&flagFoldCase != 0 {
+			name, path = strings.ToLower(name), strings.ToLower(path)
+		}
+
+		if p.glob.Match(name) || p.glob.Match(path) {
+			return suspiciousMatch{matched: p.flags&flagInclude != 0, severity: p.severity, pattern: p.raw}
+		}
+	}
+
+	return suspiciousMatch{}
+}
+
+// lruCache is a small fixed-capacity least-recently-used cache of
+// suspiciousMatch results, hand-rolled rather than pulling in a dependency
+// since it's a handful of lines on top of container/list.
+type lruCache struct {
+	capacity int
+	order    *list.List
+	items    map[string]*list.Element
+}
+
+type lruEntry struct {
+	key   string
+	value suspiciousMatch
+}
+
+func newLRUCache(capacity int) *lruCache {
+	return &lruCache{capacity: capacity, order: list.New(), items: make(map[string]*list.Element)}
+}
+
+func (c *lruCache) get(key string) (suspiciousMatch, bool) {
+	el, ok := c.items[key]
+	if !ok {
+		return suspiciousMatch{}, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*lruEntry).value, true
+}
+
+func (c *lruCache) put(key string, value suspiciousMatch) {
+	if el, ok := c.items[key]; ok {
+		el.Value.(*lruEntry).value = value
+		c.order.MoveToFront(el)
+		return
+	}
+
+	el := c.order.PushFront(&lruEntry{key: key, value: value})
+	c.items[key] = el
+
+	if c.capacity > 0 && c.order.Len() > c.capacity {
+		back := c.order.Back()
+		if back != nil {
+			c.order.Remove(back)
+			delete(c.items, back.Value.(*lruEntry).key)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package analyzer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// vulnCacheEntry is the on-disk shape of a single cached module lookup,
+// written by cachedVulnBackend and read back by both cachedVulnBackend
+// (for freshness) and localDBBackend (as an offline snapshot).
+type vulnCacheEntry struct {
+	FetchedAt time.Time   `json:"fetched_at"`
+	Records   []osvRecord `json:"records"`
+}
+
+func moduleCacheKey(mod osvModule) string {
+	return mod.Ecosystem + "|" + mod.Name + "|" + mod.Version
+}
+
+func vulnCacheEntryPath(dir string, mod osvModule) string {
+	hash := sha256.Sum256([]byte(moduleCacheKey(mod)))
+	return filepath.Join(dir, hex.EncodeToString(hash[:])+".json")
+}
+
+func readVulnCacheEntry(dir string, mod osvModule) (vulnCacheEntry, bool) {
+	data, err := os.ReadFile(vulnCacheEntryPath(dir, mod))
+	if err != nil {
+		return vulnCacheEntry{}, false
+	}
+
+	var entry vulnCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return vulnCacheEntry{}, false
+	}
+	return entry, true
+}
+
+func writeVulnCacheEntry(dir string, mod osvModule, records []osvRecord) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create vulnerability cache directory %s: %w", dir, err)
+	}
+
+	data, err := json.Marshal(vulnCacheEntry{FetchedAt: time.Now(), Records: records})
+	if err != nil {
+		return fmt.Errorf("failed to marshal vulnerability cache entry: %w", err)
+	}
+
+	if err := os.WriteFile(vulnCacheEntryPath(dir, mod), data, 0644); err != nil {
+		return fmt.Errorf("failed to write vulnerability cache entry: %w", err)
+	}
+	return nil
+}
+
+// cachedVulnBackend wraps another vulnerabilityBackend with an on-disk,
+// TTL-bound response cache keyed by (ecosystem, name, version), so repeat
+// scans of an unchanged dependency tree don't refetch the same advisories
+// from the network every run.
+type cachedVulnBackend struct {
+	inner vulnerabilityBackend
+	dir   string
+	ttl   time.Duration
+}
+
+func newCachedVulnBackend(inner vulnerabilityBackend, dir string, ttl time.Duration) *cachedVulnBackend {
+	return &cachedVulnBackend{inner: inner, dir: dir, ttl: ttl}
+}
+
+func (c *cachedVulnBackend) QueryBatch(modules []osvModule) (map[osvModule][]osvRecord, error) {
+	results := make(map[osvModule][]osvRecord)
+	var misses []osvModule
+
+	for _, mod := range modules {
+		entry, ok := readVulnCacheEntry(c.dir, mod)
+		if ok && time.Since(entry.FetchedAt) < c.ttl {
+			if len(entry.Records) > 0 {
+				results[mod] = entry.Records
+			}
+			continue
+		}
+		misses = append(misses, mod)
+	}
+
+	if len(misses) == 0 {
+		return results, nil
+	}
+
+	fresh, err := c.inner.QueryBatch(misses)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mod := range misses {
+		records := fresh[mod]
+		if err := writeVulnCacheEntry(c.dir, mod, records); err != nil {
+			return nil, err
+		}
+		if len(records) > 0 {
+			results[mod] = records
+		}
+	}
+
+	return results, nil
+}
+
+// localDBBackend serves vulnerability lookups entirely from a previously
+// populated on-disk cache (the same directory cachedVulnBackend writes),
+// without any network access. Entries are treated as valid regardless of
+// age, since there is no live source left to refresh a stale one from; a
+// module simply missing from the cache yields no results rather than an
+// error.
+type localDBBackend struct {
+	dir string
+}
+
+func newLocalDBBackend(dir string) *localDBBackend {
+	return &localDBBackend{dir: dir}
+}
+
+func (b *localDBBackend) QueryBatch(modules []osvModule) (map[osvModule][]osvRecord, error) {
+	results := make(map[osvModule][]osvRecord)
+	for _, mod := range modules {
+		entry, ok := readVulnCacheEntry(b.dir, mod)
+		if ok && len(entry.Records) > 0 {
+			results[mod] = entry.Records
+		}
+	}
+	return results, nil
+}
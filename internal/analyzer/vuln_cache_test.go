@@ -0,0 +1,100 @@
+package analyzer
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+type fakeVulnBackend struct {
+	calls   int
+	records map[osvModule][]osvRecord
+}
+
+func (f *fakeVulnBackend) QueryBatch(modules []osvModule) (map[osvModule][]osvRecord, error) {
+	f.calls++
+	results := make(map[osvModule][]osvRecord)
+	for _, mod := range modules {
+		if records, ok := f.records[mod]; ok {
+			results[mod] = records
+		}
+	}
+	return results, nil
+}
+
+func TestCachedVulnBackend_ServesFromCacheWithinTTL(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "vuln_cache_test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tempDir) }()
+
+	mod := osvModule{Ecosystem: "Go", Name: "example.com/vuln", Version: "1.0.0"}
+	inner := &fakeVulnBackend{records: map[osvModule][]osvRecord{mod: {{ID: "GHSA-cache-test"}}}}
+
+	cached := newCachedVulnBackend(inner, tempDir, time.Hour)
+
+	for i := 0; i < 2; i++ {
+		results, err := cached.QueryBatch([]osvModule{mod})
+		if err != nil {
+			t.Fatalf("QueryBatch() failed: %v", err)
+		}
+		if len(results[mod]) != 1 || results[mod][0].ID != "GHSA-cache-test" {
+			t.Fatalf("expected cached record, got %+v", results[mod])
+		}
+	}
+
+	if inner.calls != 1 {
+		t.Errorf("expected the inner backend to be queried once, got %d calls", inner.calls)
+	}
+}
+
+func TestCachedVulnBackend_RefetchesAfterTTLExpires(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "vuln_cache_ttl_test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tempDir) }()
+
+	mod := osvModule{Ecosystem: "Go", Name: "example.com/vuln", Version: "1.0.0"}
+	inner := &fakeVulnBackend{records: map[osvModule][]osvRecord{mod: {{ID: "GHSA-cache-test"}}}}
+
+	cached := newCachedVulnBackend(inner, tempDir, -time.Second) // already-expired TTL
+
+	if _, err := cached.QueryBatch([]osvModule{mod}); err != nil {
+		t.Fatalf("QueryBatch() failed: %v", err)
+	}
+	if _, err := cached.QueryBatch([]osvModule{mod}); err != nil {
+		t.Fatalf("QueryBatch() failed: %v", err)
+	}
+
+	if inner.calls != 2 {
+		t.Errorf("expected the inner backend to be queried on every call once its TTL is expired, got %d calls", inner.calls)
+	}
+}
+
+func TestLocalDBBackend_ReadsPreviouslyCachedEntries(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "local_db_backend_test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tempDir) }()
+
+	mod := osvModule{Ecosystem: "Go", Name: "example.com/vuln", Version: "1.0.0"}
+	if err := writeVulnCacheEntry(tempDir, mod, []osvRecord{{ID: "GHSA-local-test"}}); err != nil {
+		t.Fatalf("failed to seed cache entry: %v", err)
+	}
+
+	backend := newLocalDBBackend(tempDir)
+	results, err := backend.QueryBatch([]osvModule{mod, {Ecosystem: "Go", Name: "example.com/missing", Version: "1.0.0"}})
+	if err != nil {
+		t.Fatalf("QueryBatch() failed: %v", err)
+	}
+
+	if len(results[mod]) != 1 || results[mod][0].ID != "GHSA-local-test" {
+		t.Fatalf("expected 1 cached record, got %+v", results[mod])
+	}
+	if len(results) != 1 {
+		t.Errorf("expected the uncached module to be absent from results, got %+v", results)
+	}
+}
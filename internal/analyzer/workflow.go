@@ -1,11 +1,13 @@
 package analyzer
 
 import (
+	"context"
 	"fmt"
-	"regexp"
 	"strings"
 	"time"
 
+	"github.com/gobwas/glob"
+
 	"github.com/githealthchecker/git-health-checker/internal/config"
 	"github.com/githealthchecker/git-health-checker/internal/git"
 	"github.com/githealthchecker/git-health-checker/internal/report"
@@ -16,39 +18,54 @@ import (
 type WorkflowRepository interface {
 	GetBranches() ([]string, error)
 	GetCurrentBranch() (string, error)
-	GetCommitHistory(count int) ([]*object.Commit, error)
+	WalkCommits(ctx context.Context, opts git.CommitWalkOpts, visit func(*object.Commit) error) error
+	GetAllBranchTips() (map[string]*object.Commit, error)
 }
 
 type WorkflowAnalyzer struct {
-	config *config.WorkflowConfig
-	repo   WorkflowRepository
+	config      *config.WorkflowConfig
+	maintenance *config.MaintenanceConfig
+	repo        WorkflowRepository
 }
 
-func NewWorkflowAnalyzer(cfg *config.WorkflowConfig, repository *git.Repository) *WorkflowAnalyzer {
+func NewWorkflowAnalyzer(cfg *config.WorkflowConfig, maintenance *config.MaintenanceConfig, repository *git.Repository) *WorkflowAnalyzer {
 	return &WorkflowAnalyzer{
-		config: cfg,
-		repo:   repository,
+		config:      cfg,
+		maintenance: maintenance,
+		repo:        repository,
 	}
 }
 
 // NewWorkflowAnalyzerWithRepo creates a WorkflowAnalyzer with a custom repository interface (for testing)
-func NewWorkflowAnalyzerWithRepo(cfg *config.WorkflowConfig, repository WorkflowRepository) *WorkflowAnalyzer {
+func NewWorkflowAnalyzerWithRepo(cfg *config.WorkflowConfig, maintenance *config.MaintenanceConfig, repository WorkflowRepository) *WorkflowAnalyzer {
 	return &WorkflowAnalyzer{
-		config: cfg,
-		repo:   repository,
+		config:      cfg,
+		maintenance: maintenance,
+		repo:        repository,
 	}
 }
 
 func (a *WorkflowAnalyzer) Analyze() ([]report.Issue, error) {
+	return a.AnalyzeCtx(context.Background())
+}
+
+// AnalyzeCtx is Analyze with cancellation: ctx is checked before each phase
+// and inside the branch/commit loops, so a scan of a monorepo with many
+// branches or a long commit history can be aborted via Ctrl-C or --timeout.
+func (a *WorkflowAnalyzer) AnalyzeCtx(ctx context.Context) ([]report.Issue, error) {
 	var issues []report.Issue
 
-	branchIssues, err := a.checkStaleBranches()
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("workflow analysis canceled: %w", err)
+	}
+
+	branchIssues, err := a.checkStaleBranchesCtx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check stale branches: %w", err)
 	}
 	issues = append(issues, branchIssues...)
 
-	commitIssues, err := a.checkCommitMessages()
+	commitIssues, err := a.checkCommitMessagesCtx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check commit messages: %w", err)
 	}
@@ -58,6 +75,10 @@ func (a *WorkflowAnalyzer) Analyze() ([]report.Issue, error) {
 }
 
 func (a *WorkflowAnalyzer) checkStaleBranches() ([]report.Issue, error) {
+	return a.checkStaleBranchesCtx(context.Background())
+}
+
+func (a *WorkflowAnalyzer) checkStaleBranchesCtx(ctx context.Context) ([]report.Issue, error) {
 	var issues []report.Issue
 
 	branches, err := a.repo.GetBranches()
@@ -70,76 +91,187 @@ func (a *WorkflowAnalyzer) checkStaleBranches() ([]report.Issue, error) {
 		currentBranch = "unknown"
 	}
 
+	tips, err := a.repo.GetAllBranchTips()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branch tips: %w", err)
+	}
+	currentTip := tips[currentBranch]
+
+	staleDays := a.staleBranchDays()
+	threshold := time.Duration(staleDays) * 24 * time.Hour
+
 	for _, branch := range branches {
-		if a.isProtectedBranch(branch) || branch == currentBranch {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("stale branch scan canceled: %w", err)
+		}
+
+		if a.isProtectedBranch(branch) || branch == currentBranch || a.isIgnoredBranch(branch) {
 			continue
 		}
 
-		// For now, we'll mark all non-protected branches as potentially stale
-		// In a real implementation, we'd check the last commit date
-		issue := report.Issue{
-			ID:          fmt.Sprintf("stale-branch-%s", strings.ReplaceAll(branch, "/", "-")),
-			Title:       "Potentially stale branch detected",
-			Description: fmt.Sprintf("Branch '%s' may be stale and could be cleaned up", branch),
+		tip, ok := tips[branch]
+		if !ok {
+			continue // branch ref couldn't be resolved to a commit
+		}
+
+		age := time.Since(tip.Committer.When)
+		if age < threshold {
+			continue
+		}
+
+		merged := currentTip != nil && isMergedInto(tip, currentTip)
+		issues = append(issues, a.staleBranchIssue(branch, currentBranch, age, staleDays, merged))
+	}
+
+	return issues, nil
+}
+
+// staleBranchDays returns the configured staleness threshold, defaulting to
+// 90 days if the Maintenance config wasn't supplied (e.g. older callers using
+// the pre-maintenance-aware constructors).
+func (a *WorkflowAnalyzer) staleBranchDays() int {
+	if a.maintenance == nil || a.maintenance.StaleBranchDays <= 0 {
+		return 90
+	}
+	return a.maintenance.StaleBranchDays
+}
+
+// isIgnoredBranch reports whether branch matches any of
+// config.IgnoreBranchPatterns ("release/*", say), letting repositories
+// exempt branches they intentionally keep around long-term.
+func (a *WorkflowAnalyzer) isIgnoredBranch(branch string) bool {
+	for _, pattern := range a.config.IgnoreBranchPatterns {
+		compiled, err := glob.Compile(pattern, '/')
+		if err != nil {
+			continue
+		}
+		if compiled.Match(branch) {
+			return true
+		}
+	}
+	return false
+}
+
+// isMergedInto reports whether tip is reachable from target, meaning the
+// branch it belongs to has already been fully merged.
+func isMergedInto(tip, target *object.Commit) bool {
+	if tip.Hash == target.Hash {
+		return true
+	}
+	merged, err := tip.IsAncestor(target)
+	return err == nil && merged
+}
+
+// staleBranchIssue builds the Issue for a branch whose tip is older than the
+// configured threshold, scaling severity with how far past the threshold it
+// is and using a distinct rule/fix when the branch is already merged.
+func (a *WorkflowAnalyzer) staleBranchIssue(branch, currentBranch string, age time.Duration, staleDays int, merged bool) report.Issue {
+	days := int(age.Hours() / 24)
+	severity := staleSeverity(age, staleDays)
+
+	if merged {
+		return report.Issue{
+			ID:          fmt.Sprintf("merged-stale-branch-%s", strings.ReplaceAll(branch, "/", "-")),
+			Title:       "Stale branch already merged",
+			Description: fmt.Sprintf("Branch '%s' has had no commits in %d days and is already merged into '%s'", branch, days, currentBranch),
 			Category:    report.CategoryWorkflow,
-			Severity:    report.SeverityLow,
-			Rule:        "stale-branch-check",
-			Fix:         fmt.Sprintf("Review branch '%s' and delete if no longer needed", branch),
+			Severity:    severity,
+			Rule:        "merged-stale-branch",
+			Fix:         fmt.Sprintf("Delete the merged branch: git branch -d %s", branch),
 			CreatedAt:   time.Now(),
 		}
-		issues = append(issues, issue)
 	}
 
-	return issues, nil
+	return report.Issue{
+		ID:          fmt.Sprintf("stale-branch-%s", strings.ReplaceAll(branch, "/", "-")),
+		Title:       "Stale branch detected",
+		Description: fmt.Sprintf("Branch '%s' has had no commits in %d days (threshold: %d)", branch, days, staleDays),
+		Category:    report.CategoryWorkflow,
+		Severity:    severity,
+		Rule:        "stale-branch-check",
+		Fix:         fmt.Sprintf("Review branch '%s' and delete if no longer needed: git branch -d %s", branch, branch),
+		CreatedAt:   time.Now(),
+	}
+}
+
+// staleSeverity scales with how far past the staleness threshold a branch's
+// last commit is: more than 4x the threshold is High, more than 2x is
+// Medium, anything else that cleared the threshold is Low.
+func staleSeverity(age time.Duration, staleDays int) report.Severity {
+	ratio := age.Hours() / 24 / float64(staleDays)
+	switch {
+	case ratio > 4:
+		return report.SeverityHigh
+	case ratio > 2:
+		return report.SeverityMedium
+	default:
+		return report.SeverityLow
+	}
 }
 
 func (a *WorkflowAnalyzer) checkCommitMessages() ([]report.Issue, error) {
-	var issues []report.Issue
+	return a.checkCommitMessagesCtx(context.Background())
+}
+
+func (a *WorkflowAnalyzer) checkCommitMessagesCtx(ctx context.Context) ([]report.Issue, error) {
+	analysis, err := a.AnalyzeCommitMessagesCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return analysis.Issues, nil
+}
+
+// AnalyzeCommitMessages is AnalyzeCommitMessagesCtx against
+// context.Background().
+func (a *WorkflowAnalyzer) AnalyzeCommitMessages() (CommitMessageAnalysis, error) {
+	return a.AnalyzeCommitMessagesCtx(context.Background())
+}
+
+// AnalyzeCommitMessagesCtx validates the repository's most recent commits
+// against Conventional Commits v1.0.0 (see parseConventionalCommit) and
+// MaxCommitMessageLength, returning the full CommitMessageAnalysis rather
+// than just the report.Issues AnalyzeCtx folds into its aggregate - a
+// release script driving a semver bump wants BreakingChanges without
+// having to re-walk commits itself.
+func (a *WorkflowAnalyzer) AnalyzeCommitMessagesCtx(ctx context.Context) (CommitMessageAnalysis, error) {
+	var analysis CommitMessageAnalysis
 
 	if !a.config.RequireConventionalCommits {
-		return issues, nil
+		return analysis, nil
 	}
 
-	commits, err := a.repo.GetCommitHistory(10) // Check the last 10 commits
-	if err != nil {
-		return nil, err
+	recent := git.NewCommitRingBuffer(10) // Check the last 10 commits
+	if err := a.repo.WalkCommits(ctx, git.CommitWalkOpts{MaxCount: 10}, recent.Visit); err != nil {
+		return analysis, err
 	}
 
-	conventionalCommitPattern := regexp.MustCompile(`^(feat|fix|docs|style|refactor|test|chore)(\(.+\))?: .+`)
+	for _, commit := range recent.Commits() {
+		if err := ctx.Err(); err != nil {
+			return analysis, fmt.Errorf("commit message scan canceled: %w", err)
+		}
 
-	for _, commit := range commits {
-		message := strings.Split(commit.Message, "\n")[0] // Get the first line only
+		hashPrefix := commit.Hash.String()[:8]
+		header := strings.Split(commit.Message, "\n")[0]
 
-		if len(message) > a.config.MaxCommitMessageLength {
-			issue := report.Issue{
-				ID:          fmt.Sprintf("long-commit-message-%s", commit.Hash.String()[:8]),
+		if len(header) > a.config.MaxCommitMessageLength {
+			analysis.Issues = append(analysis.Issues, report.Issue{
+				ID:          fmt.Sprintf("long-commit-message-%s", hashPrefix),
 				Title:       "Commit message too long",
-				Description: fmt.Sprintf("Commit message is %d characters, exceeding maximum of %d", len(message), a.config.MaxCommitMessageLength),
+				Description: fmt.Sprintf("Commit message is %d characters, exceeding maximum of %d", len(header), a.config.MaxCommitMessageLength),
 				Category:    report.CategoryWorkflow,
 				Severity:    report.SeverityLow,
 				Rule:        "max-commit-message-length",
 				Fix:         "Keep commit messages concise and under the character limit",
 				CreatedAt:   time.Now(),
-			}
-			issues = append(issues, issue)
+			})
 		}
 
-		if !conventionalCommitPattern.MatchString(message) {
-			issue := report.Issue{
-				ID:          fmt.Sprintf("non-conventional-commit-%s", commit.Hash.String()[:8]),
-				Title:       "Non-conventional commit message",
-				Description: fmt.Sprintf("Commit message '%s' does not follow conventional commit format", truncateMessage(message, 50)),
-				Category:    report.CategoryWorkflow,
-				Severity:    report.SeverityLow,
-				Rule:        "conventional-commits",
-				Fix:         "Use conventional commit format: type(scope): description",
-				CreatedAt:   time.Now(),
-			}
-			issues = append(issues, issue)
-		}
+		commitIssues, breakingChanges := parseConventionalCommit(commit.Message, a.config, hashPrefix)
+		analysis.Issues = append(analysis.Issues, commitIssues...)
+		analysis.BreakingChanges = append(analysis.BreakingChanges, breakingChanges...)
 	}
 
-	return issues, nil
+	return analysis, nil
 }
 
 func (a *WorkflowAnalyzer) isProtectedBranch(branch string) bool {
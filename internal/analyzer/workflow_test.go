@@ -1,9 +1,12 @@
 package analyzer
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/githealthchecker/git-health-checker/internal/config"
+	"github.com/githealthchecker/git-health-checker/internal/git"
 	"github.com/githealthchecker/git-health-checker/internal/report"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
@@ -14,6 +17,7 @@ type mockRepository struct {
 	branches      []string
 	currentBranch string
 	commits       []*object.Commit
+	branchTips    map[string]*object.Commit
 }
 
 func (m *mockRepository) GetBranches() ([]string, error) {
@@ -24,64 +28,87 @@ func (m *mockRepository) GetCurrentBranch() (string, error) {
 	return m.currentBranch, nil
 }
 
-func (m *mockRepository) GetCommitHistory(count int) ([]*object.Commit, error) {
-	if count > len(m.commits) {
-		return m.commits, nil
+func (m *mockRepository) WalkCommits(ctx context.Context, opts git.CommitWalkOpts, visit func(*object.Commit) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
 	}
-	return m.commits[:count], nil
+
+	commits := m.commits
+	if opts.MaxCount > 0 && opts.MaxCount < len(commits) {
+		commits = commits[:opts.MaxCount]
+	}
+
+	for _, commit := range commits {
+		if err := visit(commit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *mockRepository) GetAllBranchTips() (map[string]*object.Commit, error) {
+	return m.branchTips, nil
 }
 
 func (m *mockRepository) GetPath() string                   { return "/mock/path" }
 func (m *mockRepository) GetCurrentCommit() (string, error) { return "abc123", nil }
 func (m *mockRepository) GetLargeFiles() ([]string, error)  { return nil, nil }
 
-func TestWorkflowAnalyzer_Analyze(t *testing.T) {
-	// Create a mock repository with test data
-	hash1 := plumbing.NewHash("1234567890abcdef1234567890abcdef12345678")
-	hash2 := plumbing.NewHash("abcdef1234567890abcdef1234567890abcdef12")
-	hash3 := plumbing.NewHash("fedcba0987654321fedcba0987654321fedcba09")
+// commitAt builds a minimal commit with the given hash and committer time,
+// enough for checkStaleBranches' age and ancestry checks.
+func commitAt(hashHex string, when time.Time) *object.Commit {
+	return &object.Commit{
+		Hash:      plumbing.NewHash(hashHex),
+		Committer: object.Signature{When: when},
+	}
+}
 
+func TestWorkflowAnalyzer_Analyze(t *testing.T) {
+	now := time.Now()
 	mockRepo := &mockRepository{
 		branches:      []string{"main", "feature/test", "hotfix/urgent", "old-branch"},
 		currentBranch: "main",
+		branchTips: map[string]*object.Commit{
+			"main":          commitAt("1111111111111111111111111111111111111111", now),
+			"feature/test":  commitAt("2222222222222222222222222222222222222222", now.AddDate(0, 0, -120)),
+			"hotfix/urgent": commitAt("3333333333333333333333333333333333333333", now.AddDate(0, 0, -200)),
+			"old-branch":    commitAt("4444444444444444444444444444444444444444", now.AddDate(0, 0, -400)),
+		},
 		commits: []*object.Commit{
 			{
-				Hash:    hash1,
+				Hash:    plumbing.NewHash("1234567890abcdef1234567890abcdef12345678"),
 				Message: "feat: add new feature",
 			},
 			{
-				Hash:    hash2,
+				Hash:    plumbing.NewHash("abcdef1234567890abcdef1234567890abcdef12"),
 				Message: "This is a very long commit message that exceeds the maximum allowed length for conventional commits and should be flagged",
 			},
 			{
-				Hash:    hash3,
+				Hash:    plumbing.NewHash("fedcba0987654321fedcba0987654321fedcba09"),
 				Message: "updated some stuff", // Non-conventional format
 			},
 		},
 	}
 
-	// Create a workflow config
 	cfg := &config.WorkflowConfig{
 		RequireConventionalCommits: true,
 		MaxCommitMessageLength:     72,
 		ProtectedBranches:          []string{"main", "master"},
 	}
+	maintenance := &config.MaintenanceConfig{StaleBranchDays: 90}
 
-	// Create analyzer with mock repo using the test constructor
-	workflowAnalyzer := NewWorkflowAnalyzerWithRepo(cfg, mockRepo)
+	workflowAnalyzer := NewWorkflowAnalyzerWithRepo(cfg, maintenance, mockRepo)
 
-	// Run analysis
 	issues, err := workflowAnalyzer.Analyze()
 	if err != nil {
 		t.Fatalf("Analysis failed: %v", err)
 	}
 
-	// Should find issues for stale branches and commit messages
 	if len(issues) == 0 {
 		t.Error("Expected to find workflow issues, but none were found")
 	}
 
-	// Check that all issues are workflow category
 	staleBranchIssues := 0
 	commitMessageIssues := 0
 
@@ -91,55 +118,168 @@ func TestWorkflowAnalyzer_Analyze(t *testing.T) {
 		}
 
 		switch issue.Rule {
-		case "stale-branch-check":
+		case "stale-branch-check", "merged-stale-branch":
 			staleBranchIssues++
 		case "max-commit-message-length", "conventional-commits":
 			commitMessageIssues++
 		}
 	}
 
-	// Should find stale branch issues (excluding protected main branch)
 	if staleBranchIssues == 0 {
 		t.Error("Expected to find stale branch issues")
 	}
 
-	// Should find commit message issues if conventional commits are required
 	if commitMessageIssues == 0 {
 		t.Error("Expected to find commit message issues")
 	}
 }
 
 func TestWorkflowAnalyzer_CheckStaleBranches(t *testing.T) {
+	now := time.Now()
 	mockRepo := &mockRepository{
 		branches:      []string{"main", "feature/old", "hotfix/urgent"},
 		currentBranch: "main",
+		branchTips: map[string]*object.Commit{
+			"main":          commitAt("1111111111111111111111111111111111111111", now),
+			"feature/old":   commitAt("2222222222222222222222222222222222222222", now.AddDate(0, 0, -100)),
+			"hotfix/urgent": commitAt("3333333333333333333333333333333333333333", now.AddDate(0, 0, -95)),
+		},
 	}
 
 	cfg := &config.WorkflowConfig{
 		ProtectedBranches: []string{"main", "master"},
 	}
+	maintenance := &config.MaintenanceConfig{StaleBranchDays: 90}
 
-	workflowAnalyzer := NewWorkflowAnalyzerWithRepo(cfg, mockRepo)
+	workflowAnalyzer := NewWorkflowAnalyzerWithRepo(cfg, maintenance, mockRepo)
 
 	issues, err := workflowAnalyzer.checkStaleBranches()
 	if err != nil {
 		t.Fatalf("checkStaleBranches failed: %v", err)
 	}
 
-	// Should find 2 stale branches (excluding protected main)
 	expectedStale := 2
 	if len(issues) != expectedStale {
 		t.Errorf("Expected %d stale branch issues, got %d", expectedStale, len(issues))
 	}
 
-	// Verify issue properties
 	for _, issue := range issues {
 		if issue.Rule != "stale-branch-check" {
 			t.Errorf("Expected rule 'stale-branch-check', got '%s'", issue.Rule)
 		}
-		if issue.Severity != report.SeverityLow {
-			t.Errorf("Expected low severity, got %s", issue.Severity)
-		}
+	}
+}
+
+func TestWorkflowAnalyzer_CheckStaleBranches_IgnoresRecentBranches(t *testing.T) {
+	now := time.Now()
+	mockRepo := &mockRepository{
+		branches:      []string{"main", "feature/fresh"},
+		currentBranch: "main",
+		branchTips: map[string]*object.Commit{
+			"main":          commitAt("1111111111111111111111111111111111111111", now),
+			"feature/fresh": commitAt("2222222222222222222222222222222222222222", now.AddDate(0, 0, -10)),
+		},
+	}
+
+	cfg := &config.WorkflowConfig{ProtectedBranches: []string{"main"}}
+	maintenance := &config.MaintenanceConfig{StaleBranchDays: 90}
+
+	workflowAnalyzer := NewWorkflowAnalyzerWithRepo(cfg, maintenance, mockRepo)
+
+	issues, err := workflowAnalyzer.checkStaleBranches()
+	if err != nil {
+		t.Fatalf("checkStaleBranches failed: %v", err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("Expected no stale issues for a recently committed branch, got %+v", issues)
+	}
+}
+
+func TestWorkflowAnalyzer_CheckStaleBranches_RespectsIgnorePatterns(t *testing.T) {
+	now := time.Now()
+	mockRepo := &mockRepository{
+		branches:      []string{"main", "release/1.0"},
+		currentBranch: "main",
+		branchTips: map[string]*object.Commit{
+			"main":        commitAt("1111111111111111111111111111111111111111", now),
+			"release/1.0": commitAt("2222222222222222222222222222222222222222", now.AddDate(0, 0, -200)),
+		},
+	}
+
+	cfg := &config.WorkflowConfig{
+		ProtectedBranches:    []string{"main"},
+		IgnoreBranchPatterns: []string{"release/*"},
+	}
+	maintenance := &config.MaintenanceConfig{StaleBranchDays: 90}
+
+	workflowAnalyzer := NewWorkflowAnalyzerWithRepo(cfg, maintenance, mockRepo)
+
+	issues, err := workflowAnalyzer.checkStaleBranches()
+	if err != nil {
+		t.Fatalf("checkStaleBranches failed: %v", err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("Expected release/1.0 to be excluded by IgnoreBranchPatterns, got %+v", issues)
+	}
+}
+
+func TestWorkflowAnalyzer_CheckStaleBranches_SeverityScalesWithAge(t *testing.T) {
+	now := time.Now()
+	mockRepo := &mockRepository{
+		branches:      []string{"main", "barely-stale", "very-stale"},
+		currentBranch: "main",
+		branchTips: map[string]*object.Commit{
+			"main":         commitAt("1111111111111111111111111111111111111111", now),
+			"barely-stale": commitAt("2222222222222222222222222222222222222222", now.AddDate(0, 0, -100)),
+			"very-stale":   commitAt("3333333333333333333333333333333333333333", now.AddDate(0, 0, -400)),
+		},
+	}
+
+	cfg := &config.WorkflowConfig{ProtectedBranches: []string{"main"}}
+	maintenance := &config.MaintenanceConfig{StaleBranchDays: 90}
+
+	workflowAnalyzer := NewWorkflowAnalyzerWithRepo(cfg, maintenance, mockRepo)
+
+	issues, err := workflowAnalyzer.checkStaleBranches()
+	if err != nil {
+		t.Fatalf("checkStaleBranches failed: %v", err)
+	}
+
+	severities := make(map[string]report.Severity)
+	for _, issue := range issues {
+		severities[issue.ID] = issue.Severity
+	}
+	if severities["stale-branch-barely-stale"] != report.SeverityLow {
+		t.Errorf("expected barely-stale branch to be low severity, got %s", severities["stale-branch-barely-stale"])
+	}
+	if severities["stale-branch-very-stale"] != report.SeverityHigh {
+		t.Errorf("expected very-stale branch (>4x threshold) to be high severity, got %s", severities["stale-branch-very-stale"])
+	}
+}
+
+func TestWorkflowAnalyzer_CheckStaleBranches_FlagsMergedBranch(t *testing.T) {
+	now := time.Now()
+	oldCommit := commitAt("1111111111111111111111111111111111111111", now.AddDate(0, 0, -200))
+	mockRepo := &mockRepository{
+		branches:      []string{"main", "merged-feature"},
+		currentBranch: "main",
+		branchTips: map[string]*object.Commit{
+			"main":           oldCommit,
+			"merged-feature": oldCommit, // already merged: same tip as main
+		},
+	}
+
+	cfg := &config.WorkflowConfig{ProtectedBranches: []string{"main"}}
+	maintenance := &config.MaintenanceConfig{StaleBranchDays: 90}
+
+	workflowAnalyzer := NewWorkflowAnalyzerWithRepo(cfg, maintenance, mockRepo)
+
+	issues, err := workflowAnalyzer.checkStaleBranches()
+	if err != nil {
+		t.Fatalf("checkStaleBranches failed: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Rule != "merged-stale-branch" {
+		t.Fatalf("expected a single merged-stale-branch issue, got %+v", issues)
 	}
 }
 
@@ -203,7 +343,7 @@ func TestWorkflowAnalyzer_CheckCommitMessages(t *testing.T) {
 				MaxCommitMessageLength:     test.maxLength,
 			}
 
-			workflowAnalyzer := NewWorkflowAnalyzerWithRepo(cfg, mockRepo)
+			workflowAnalyzer := NewWorkflowAnalyzerWithRepo(cfg, &config.MaintenanceConfig{StaleBranchDays: 90}, mockRepo)
 
 			issues, err := workflowAnalyzer.checkCommitMessages()
 			if err != nil {
@@ -217,6 +357,25 @@ func TestWorkflowAnalyzer_CheckCommitMessages(t *testing.T) {
 	}
 }
 
+func TestWorkflowAnalyzer_AnalyzeCtx_HonorsCancellation(t *testing.T) {
+	mockRepo := &mockRepository{
+		branches:      []string{"main"},
+		currentBranch: "main",
+		branchTips:    map[string]*object.Commit{},
+	}
+	cfg := &config.WorkflowConfig{ProtectedBranches: []string{"main"}}
+	maintenance := &config.MaintenanceConfig{StaleBranchDays: 90}
+
+	workflowAnalyzer := NewWorkflowAnalyzerWithRepo(cfg, maintenance, mockRepo)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := workflowAnalyzer.AnalyzeCtx(ctx); err == nil {
+		t.Error("expected AnalyzeCtx to return an error for an already-canceled context")
+	}
+}
+
 func TestWorkflowAnalyzer_IsProtectedBranch(t *testing.T) {
 	cfg := &config.WorkflowConfig{
 		ProtectedBranches: []string{"main", "master", "develop"},
@@ -245,6 +404,26 @@ func TestWorkflowAnalyzer_IsProtectedBranch(t *testing.T) {
 	}
 }
 
+func TestWorkflowAnalyzer_IsIgnoredBranch(t *testing.T) {
+	cfg := &config.WorkflowConfig{IgnoreBranchPatterns: []string{"release/*", "archive/**"}}
+	analyzer := &WorkflowAnalyzer{config: cfg}
+
+	tests := []struct {
+		branch   string
+		expected bool
+	}{
+		{"release/1.0", true},
+		{"archive/old/project", true},
+		{"feature/test", false},
+	}
+
+	for _, test := range tests {
+		if result := analyzer.isIgnoredBranch(test.branch); result != test.expected {
+			t.Errorf("isIgnoredBranch(%q) = %v, expected %v", test.branch, result, test.expected)
+		}
+	}
+}
+
 func TestTruncateMessage(t *testing.T) {
 	tests := []struct {
 		message  string
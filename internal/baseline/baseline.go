@@ -0,0 +1,317 @@
+// Package baseline implements a lockfile-style record of previously accepted
+// issues and applied dependency updates, so `check --baseline` can adopt a
+// dirty repository incrementally instead of reporting every pre-existing
+// issue on every run.
+package baseline
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+// LockfileVersion is bumped whenever the on-disk schema changes in a way
+// that isn't backward compatible.
+const LockfileVersion = "1"
+
+// Lockfile is the on-disk representation of .healthcheck.lock.
+type Lockfile struct {
+	Version           string             `json:"version"`
+	Issues            []LockedIssue      `json:"issues"`
+	DependencyUpdates []DependencyUpdate `json:"dependency_updates,omitempty"`
+}
+
+// LockedIssue records a single accepted finding by fingerprint so later runs
+// can recognize it as already-known rather than newly introduced.
+type LockedIssue struct {
+	Fingerprint string          `json:"fingerprint"`
+	Rule        string          `json:"rule"`
+	File        string          `json:"file"`
+	Line        int             `json:"line"`
+	Severity    report.Severity `json:"severity"`
+	AcceptedAt  time.Time       `json:"accepted_at"`
+}
+
+// DependencyUpdate records a single dependency bump applied by `fix`, for
+// auditability independent of the findings baseline.
+type DependencyUpdate struct {
+	Package     string    `json:"package"`
+	FromVersion string    `json:"from_version"`
+	ToVersion   string    `json:"to_version"`
+	Timestamp   time.Time `json:"timestamp"`
+	CommitSHA   string    `json:"commit_sha,omitempty"`
+}
+
+// Fingerprint derives a stable identity for an issue from its rule, file,
+// and line. Security findings also fold in the description, which carries a
+// truncated snippet of the matched secret, so two different secrets on the
+// same line don't collide.
+//
+// context, when given (see ReadContext), replaces the raw line number with
+// a hash of the surrounding source instead, so an issue that merely shifted
+// up or down a few lines because of an unrelated edit elsewhere in the file
+// still fingerprints the same and isn't reported as newly introduced.
+func Fingerprint(issue report.Issue, context ...string) string {
+	var payload string
+	if len(context) > 0 {
+		payload = fmt.Sprintf("%s|%s|%s", issue.Rule, issue.File, hashContext(context))
+	} else {
+		payload = fmt.Sprintf("%s|%s|%d", issue.Rule, issue.File, issue.Line)
+	}
+	if issue.Category == report.CategorySecurity {
+		payload += "|" + issue.Description
+	}
+
+	sum := sha256.Sum256([]byte(payload))
+	return hex.EncodeToString(sum[:])
+}
+
+// hashContext hashes context's lines after trimming each one, so trailing
+// whitespace or a re-indent doesn't change the fingerprint.
+func hashContext(context []string) string {
+	trimmed := make([]string, len(context))
+	for i, line := range context {
+		trimmed[i] = strings.TrimSpace(line)
+	}
+	sum := sha256.Sum256([]byte(strings.Join(trimmed, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// ReadContext returns the +/-radius source lines around issue.Line in
+// repoPath/issue.File, for use as Fingerprint's context argument. It
+// returns nil (no context, falling back to line-number fingerprinting)
+// when the issue has no line or the file can't be read - e.g. a
+// repository-wide finding, or a file that was deleted since the issue was
+// recorded.
+func ReadContext(repoPath string, issue report.Issue, radius int) []string {
+	if issue.File == "" || issue.Line <= 0 {
+		return nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(repoPath, issue.File))
+	if err != nil {
+		return nil
+	}
+	lines := strings.Split(string(data), "\n")
+
+	index := issue.Line - 1
+	if index >= len(lines) {
+		return nil
+	}
+
+	start := index - radius
+	if start < 0 {
+		start = 0
+	}
+	end := index + radius
+	if end >= len(lines) {
+		end = len(lines) - 1
+	}
+
+	return lines[start : end+1]
+}
+
+// Load reads the lockfile at path. A missing file is not an error; it
+// returns an empty Lockfile so first runs can start recording a baseline.
+func Load(path string) (*Lockfile, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Lockfile{Version: LockfileVersion}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lockfile %s: %w", path, err)
+	}
+
+	var lock Lockfile
+	if err := json.Unmarshal(data, &lock); err != nil {
+		return nil, fmt.Errorf("failed to parse lockfile %s: %w", path, err)
+	}
+
+	return &lock, nil
+}
+
+// Save writes the lockfile to path as indented JSON.
+func (l *Lockfile) Save(path string) error {
+	if l.Version == "" {
+		l.Version = LockfileVersion
+	}
+
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode lockfile: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write lockfile %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Has reports whether fingerprint is already recorded in the lockfile.
+func (l *Lockfile) Has(fingerprint string) bool {
+	for _, issue := range l.Issues {
+		if issue.Fingerprint == fingerprint {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterNew returns only the issues whose fingerprint isn't already recorded
+// in the lockfile, for `check --baseline`.
+func FilterNew(issues []report.Issue, lock *Lockfile) []report.Issue {
+	var fresh []report.Issue
+	for _, issue := range issues {
+		if !lock.Has(Fingerprint(issue)) {
+			fresh = append(fresh, issue)
+		}
+	}
+	return fresh
+}
+
+// FilterNewWithContext is FilterNew, fingerprinting each issue with
+// contextFor's surrounding source lines (see ReadContext) instead of its
+// raw line number, so pure line-number shifts elsewhere in the file don't
+// make an already-known issue look new.
+func FilterNewWithContext(issues []report.Issue, lock *Lockfile, contextFor func(report.Issue) []string) []report.Issue {
+	var fresh []report.Issue
+	for _, issue := range issues {
+		if !lock.Has(Fingerprint(issue, contextFor(issue)...)) {
+			fresh = append(fresh, issue)
+		}
+	}
+	return fresh
+}
+
+// Rebuild replaces the lockfile's issue set with fingerprints derived from
+// the current issues, for `check --update-baseline`. Entries whose
+// fingerprint no longer appears among the current issues are dropped, so
+// fixed issues don't linger in the lockfile forever.
+func Rebuild(lock *Lockfile, issues []report.Issue) {
+	now := time.Now()
+	existing := make(map[string]time.Time, len(lock.Issues))
+	for _, issue := range lock.Issues {
+		existing[issue.Fingerprint] = issue.AcceptedAt
+	}
+
+	rebuilt := make([]LockedIssue, 0, len(issues))
+	for _, issue := range issues {
+		fingerprint := Fingerprint(issue)
+
+		acceptedAt := now
+		if previous, ok := existing[fingerprint]; ok {
+			acceptedAt = previous
+		}
+
+		rebuilt = append(rebuilt, LockedIssue{
+			Fingerprint: fingerprint,
+			Rule:        issue.Rule,
+			File:        issue.File,
+			Line:        issue.Line,
+			Severity:    issue.Severity,
+			AcceptedAt:  acceptedAt,
+		})
+	}
+
+	lock.Issues = rebuilt
+}
+
+// RebuildWithContext is Rebuild, fingerprinting each issue with
+// contextFor's surrounding source lines (see ReadContext) instead of its
+// raw line number.
+func RebuildWithContext(lock *Lockfile, issues []report.Issue, contextFor func(report.Issue) []string) {
+	now := time.Now()
+	existing := make(map[string]time.Time, len(lock.Issues))
+	for _, issue := range lock.Issues {
+		existing[issue.Fingerprint] = issue.AcceptedAt
+	}
+
+	rebuilt := make([]LockedIssue, 0, len(issues))
+	for _, issue := range issues {
+		fingerprint := Fingerprint(issue, contextFor(issue)...)
+
+		acceptedAt := now
+		if previous, ok := existing[fingerprint]; ok {
+			acceptedAt = previous
+		}
+
+		rebuilt = append(rebuilt, LockedIssue{
+			Fingerprint: fingerprint,
+			Rule:        issue.Rule,
+			File:        issue.File,
+			Line:        issue.Line,
+			Severity:    issue.Severity,
+			AcceptedAt:  acceptedAt,
+		})
+	}
+
+	lock.Issues = rebuilt
+}
+
+// Prune removes any locked issue whose fingerprint doesn't match one of the
+// current issues, reporting how many entries were dropped. Callers use this
+// to keep a --baseline lockfile honest as issues get fixed outside of
+// --update-baseline.
+func Prune(lock *Lockfile, issues []report.Issue) int {
+	current := make(map[string]struct{}, len(issues))
+	for _, issue := range issues {
+		current[Fingerprint(issue)] = struct{}{}
+	}
+
+	kept := make([]LockedIssue, 0, len(lock.Issues))
+	pruned := 0
+	for _, locked := range lock.Issues {
+		if _, ok := current[locked.Fingerprint]; ok {
+			kept = append(kept, locked)
+			continue
+		}
+		pruned++
+	}
+
+	lock.Issues = kept
+	return pruned
+}
+
+// PruneWithContext is Prune, fingerprinting each issue with contextFor's
+// surrounding source lines (see ReadContext) instead of its raw line
+// number. Must be paired with RebuildWithContext/FilterNewWithContext using
+// the same contextFor, or every locked entry will look stale.
+func PruneWithContext(lock *Lockfile, issues []report.Issue, contextFor func(report.Issue) []string) int {
+	current := make(map[string]struct{}, len(issues))
+	for _, issue := range issues {
+		current[Fingerprint(issue, contextFor(issue)...)] = struct{}{}
+	}
+
+	kept := make([]LockedIssue, 0, len(lock.Issues))
+	pruned := 0
+	for _, locked := range lock.Issues {
+		if _, ok := current[locked.Fingerprint]; ok {
+			kept = append(kept, locked)
+			continue
+		}
+		pruned++
+	}
+
+	lock.Issues = kept
+	return pruned
+}
+
+// RecordDependencyUpdate appends an applied dependency bump to the lockfile
+// so `fix` leaves a durable audit trail across runs.
+func (l *Lockfile) RecordDependencyUpdate(pkg, fromVersion, toVersion, commitSHA string) {
+	l.DependencyUpdates = append(l.DependencyUpdates, DependencyUpdate{
+		Package:     pkg,
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Timestamp:   time.Now(),
+		CommitSHA:   commitSHA,
+	})
+}
@@ -0,0 +1,174 @@
+package baseline
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+func TestLoad_MissingFileReturnsEmptyLockfile(t *testing.T) {
+	lock, err := Load(filepath.Join(t.TempDir(), ".healthcheck.lock"))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if len(lock.Issues) != 0 {
+		t.Errorf("expected no issues in a fresh lockfile, got %d", len(lock.Issues))
+	}
+}
+
+func TestSaveAndLoad_RoundTrips(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".healthcheck.lock")
+
+	lock := &Lockfile{Version: LockfileVersion}
+	lock.RecordDependencyUpdate("github.com/spf13/cobra", "v1.0.0", "v1.1.0", "abc123")
+
+	if err := lock.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	reloaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if len(reloaded.DependencyUpdates) != 1 {
+		t.Fatalf("expected 1 dependency update, got %d", len(reloaded.DependencyUpdates))
+	}
+	if reloaded.DependencyUpdates[0].Package != "github.com/spf13/cobra" {
+		t.Errorf("unexpected package: %s", reloaded.DependencyUpdates[0].Package)
+	}
+}
+
+func TestFilterNew_ExcludesKnownIssues(t *testing.T) {
+	known := report.Issue{Rule: "large-file-check", File: "main.go", Line: 10}
+	fresh := report.Issue{Rule: "large-file-check", File: "other.go", Line: 5}
+
+	lock := &Lockfile{Issues: []LockedIssue{{Fingerprint: Fingerprint(known)}}}
+
+	result := FilterNew([]report.Issue{known, fresh}, lock)
+
+	if len(result) != 1 || result[0].File != "other.go" {
+		t.Errorf("expected only the fresh issue to remain, got %+v", result)
+	}
+}
+
+func TestRebuild_RetainsAcceptedAtForUnchangedIssues(t *testing.T) {
+	issue := report.Issue{Rule: "large-file-check", File: "main.go", Line: 10}
+	fingerprint := Fingerprint(issue)
+	acceptedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	lock := &Lockfile{Issues: []LockedIssue{{Fingerprint: fingerprint, AcceptedAt: acceptedAt}}}
+	Rebuild(lock, []report.Issue{issue})
+
+	if len(lock.Issues) != 1 {
+		t.Fatalf("expected 1 issue after rebuild, got %d", len(lock.Issues))
+	}
+	if !lock.Issues[0].AcceptedAt.Equal(acceptedAt) {
+		t.Errorf("expected AcceptedAt to be preserved, got %v", lock.Issues[0].AcceptedAt)
+	}
+}
+
+func TestPrune_DropsStaleEntries(t *testing.T) {
+	stillPresent := report.Issue{Rule: "large-file-check", File: "main.go", Line: 10}
+	fixed := report.Issue{Rule: "large-file-check", File: "gone.go", Line: 1}
+
+	lock := &Lockfile{Issues: []LockedIssue{
+		{Fingerprint: Fingerprint(stillPresent)},
+		{Fingerprint: Fingerprint(fixed)},
+	}}
+
+	pruned := Prune(lock, []report.Issue{stillPresent})
+
+	if pruned != 1 {
+		t.Errorf("expected 1 pruned entry, got %d", pruned)
+	}
+	if len(lock.Issues) != 1 || lock.Issues[0].Fingerprint != Fingerprint(stillPresent) {
+		t.Errorf("expected only the still-present issue to remain, got %+v", lock.Issues)
+	}
+}
+
+func TestFingerprint_SecurityFindingsIncludeDescription(t *testing.T) {
+	a := report.Issue{Rule: "secret-detection", File: "config.go", Line: 1, Category: report.CategorySecurity, Description: "secret A"}
+	b := report.Issue{Rule: "secret-detection", File: "config.go", Line: 1, Category: report.CategorySecurity, Description: "secret B"}
+
+	if Fingerprint(a) == Fingerprint(b) {
+		t.Error("expected different secret snippets on the same line to produce different fingerprints")
+	}
+}
+
+func TestFingerprint_ContextSurvivesLineShift(t *testing.T) {
+	before := report.Issue{Rule: "cyclomatic-complexity", File: "main.go", Line: 10}
+	after := report.Issue{Rule: "cyclomatic-complexity", File: "main.go", Line: 13}
+
+	context := []string{"func f() {", "\tif x {", "\t\treturn 1", "\t}", "}"}
+
+	if Fingerprint(before, context...) != Fingerprint(after, context...) {
+		t.Error("expected the same surrounding source to fingerprint identically regardless of line number")
+	}
+}
+
+func TestFingerprint_ContextDistinguishesDifferentCode(t *testing.T) {
+	issue := report.Issue{Rule: "cyclomatic-complexity", File: "main.go", Line: 10}
+
+	a := Fingerprint(issue, "func f() {", "return 1", "}")
+	b := Fingerprint(issue, "func g() {", "return 2", "}")
+
+	if a == b {
+		t.Error("expected different surrounding source to produce different fingerprints")
+	}
+}
+
+func TestReadContext_ReturnsLinesAroundIssue(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "main.go")
+	content := "line1\nline2\nline3\nline4\nline5\nline6\nline7\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+
+	context := ReadContext(dir, report.Issue{File: "main.go", Line: 4}, 2)
+	want := []string{"line2", "line3", "line4", "line5", "line6"}
+
+	if len(context) != len(want) {
+		t.Fatalf("expected %d lines, got %+v", len(want), context)
+	}
+	for i, line := range want {
+		if context[i] != line {
+			t.Errorf("line %d: expected %q, got %q", i, line, context[i])
+		}
+	}
+}
+
+func TestReadContext_NoFileReturnsNil(t *testing.T) {
+	if got := ReadContext(t.TempDir(), report.Issue{File: "missing.go", Line: 1}, 3); got != nil {
+		t.Errorf("expected nil context for an unreadable file, got %+v", got)
+	}
+	if got := ReadContext(t.TempDir(), report.Issue{}, 3); got != nil {
+		t.Errorf("expected nil context for an issue with no line, got %+v", got)
+	}
+}
+
+func TestFilterNewWithContext_SurvivesLineShift(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "main.go")
+	content := "package main\n\nfunc f() {\n\treturn 1\n}\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+
+	contextFor := func(issue report.Issue) []string { return ReadContext(dir, issue, 3) }
+
+	original := report.Issue{Rule: "max-function-lines", File: "main.go", Line: 3}
+	lock := &Lockfile{Issues: []LockedIssue{{Fingerprint: Fingerprint(original, contextFor(original)...)}}}
+
+	shifted := report.Issue{Rule: "max-function-lines", File: "main.go", Line: 4}
+	result := FilterNewWithContext([]report.Issue{shifted}, lock, contextFor)
+
+	if len(result) != 0 {
+		t.Errorf("expected the shifted issue to still match the baseline, got %+v", result)
+	}
+}
@@ -0,0 +1,137 @@
+package baseline
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+// HistoryBaselineVersion is bumped whenever the on-disk schema changes in a
+// way that isn't backward compatible.
+const HistoryBaselineVersion = "1"
+
+// HistoryBaseline is the on-disk representation of
+// .healthcheck-baseline.json, the detect-secrets-style baseline
+// `check --scan-history` consults so re-scanning the same git history only
+// surfaces newly introduced leaks. Unlike Lockfile, which fingerprints a
+// point-in-time issue by rule+file+line, a history finding is keyed by the
+// matched secret's content hash plus the file it was found in, since the
+// same secret can resurface at a different commit or line without being a
+// new leak.
+type HistoryBaseline struct {
+	Version  string                 `json:"version"`
+	Findings []TriagedHistorySecret `json:"findings"`
+}
+
+// TriagedHistorySecret records a single historical finding accepted into
+// the baseline.
+type TriagedHistorySecret struct {
+	ContentHash string    `json:"content_hash"`
+	File        string    `json:"file"`
+	Rule        string    `json:"rule"`
+	AcceptedAt  time.Time `json:"accepted_at"`
+}
+
+// LoadHistory reads the baseline file at path. A missing file is not an
+// error; it returns an empty HistoryBaseline so the first `--scan-history`
+// run can start recording one.
+func LoadHistory(path string) (*HistoryBaseline, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &HistoryBaseline{Version: HistoryBaselineVersion}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history baseline %s: %w", path, err)
+	}
+
+	var b HistoryBaseline
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("failed to parse history baseline %s: %w", path, err)
+	}
+
+	return &b, nil
+}
+
+// Save writes the baseline to path as indented JSON.
+func (b *HistoryBaseline) Save(path string) error {
+	if b.Version == "" {
+		b.Version = HistoryBaselineVersion
+	}
+
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode history baseline: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write history baseline %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Has reports whether a finding with this content hash was already accepted
+// for this file.
+func (b *HistoryBaseline) Has(contentHash, file string) bool {
+	for _, f := range b.Findings {
+		if f.ContentHash == contentHash && f.File == file {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterNewHistory returns only the issues whose "content_hash" Metadata
+// entry (set by analyzer.HistorySecretAnalyzer) plus File isn't already
+// recorded in the baseline. An issue with no content_hash metadata is kept,
+// since it can't be matched against the baseline at all.
+func FilterNewHistory(issues []report.Issue, b *HistoryBaseline) []report.Issue {
+	var fresh []report.Issue
+	for _, issue := range issues {
+		hash := issue.Metadata["content_hash"]
+		if hash != "" && b.Has(hash, issue.File) {
+			continue
+		}
+		fresh = append(fresh, issue)
+	}
+	return fresh
+}
+
+// RebuildHistory replaces the baseline's findings with the current set of
+// history issues, for `check --scan-history --update-history-baseline`.
+// Entries whose (content hash, file) no longer appears among the current
+// issues are dropped, so a leak that's been purged from history doesn't
+// linger in the baseline forever.
+func RebuildHistory(b *HistoryBaseline, issues []report.Issue) {
+	now := time.Now()
+	existing := make(map[string]time.Time, len(b.Findings))
+	for _, f := range b.Findings {
+		existing[f.ContentHash+"|"+f.File] = f.AcceptedAt
+	}
+
+	rebuilt := make([]TriagedHistorySecret, 0, len(issues))
+	for _, issue := range issues {
+		hash := issue.Metadata["content_hash"]
+		if hash == "" {
+			continue
+		}
+
+		key := hash + "|" + issue.File
+		acceptedAt := now
+		if previous, ok := existing[key]; ok {
+			acceptedAt = previous
+		}
+
+		rebuilt = append(rebuilt, TriagedHistorySecret{
+			ContentHash: hash,
+			File:        issue.File,
+			Rule:        issue.Rule,
+			AcceptedAt:  acceptedAt,
+		})
+	}
+
+	b.Findings = rebuilt
+}
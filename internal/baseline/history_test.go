@@ -0,0 +1,100 @@
+package baseline
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+func TestLoadHistory_MissingFileReturnsEmptyBaseline(t *testing.T) {
+	b, err := LoadHistory(filepath.Join(t.TempDir(), ".healthcheck-baseline.json"))
+	if err != nil {
+		t.Fatalf("LoadHistory failed: %v", err)
+	}
+
+	if len(b.Findings) != 0 {
+		t.Errorf("expected no findings in a fresh baseline, got %d", len(b.Findings))
+	}
+}
+
+func TestHistorySaveAndLoad_RoundTrips(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".healthcheck-baseline.json")
+
+	b := &HistoryBaseline{Version: HistoryBaselineVersion}
+	RebuildHistory(b, []report.Issue{
+		{Rule: "secret-detection", File: "config.env", Metadata: map[string]string{"content_hash": "abc123"}},
+	})
+
+	if err := b.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	reloaded, err := LoadHistory(path)
+	if err != nil {
+		t.Fatalf("LoadHistory failed: %v", err)
+	}
+
+	if len(reloaded.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d", len(reloaded.Findings))
+	}
+	if reloaded.Findings[0].ContentHash != "abc123" {
+		t.Errorf("unexpected content hash: %s", reloaded.Findings[0].ContentHash)
+	}
+}
+
+func TestFilterNewHistory_ExcludesAcceptedFindings(t *testing.T) {
+	known := report.Issue{File: "config.env", Metadata: map[string]string{"content_hash": "abc123"}}
+	fresh := report.Issue{File: "config.env", Metadata: map[string]string{"content_hash": "def456"}}
+
+	b := &HistoryBaseline{Findings: []TriagedHistorySecret{{ContentHash: "abc123", File: "config.env"}}}
+
+	result := FilterNewHistory([]report.Issue{known, fresh}, b)
+
+	if len(result) != 1 || result[0].Metadata["content_hash"] != "def456" {
+		t.Errorf("expected only the fresh finding to remain, got %+v", result)
+	}
+}
+
+func TestFilterNewHistory_KeepsFindingsWithoutContentHash(t *testing.T) {
+	issue := report.Issue{File: "config.env"}
+	b := &HistoryBaseline{}
+
+	result := FilterNewHistory([]report.Issue{issue}, b)
+
+	if len(result) != 1 {
+		t.Errorf("expected an issue with no content_hash to be kept, got %+v", result)
+	}
+}
+
+func TestRebuildHistory_RetainsAcceptedAtForUnchangedFindings(t *testing.T) {
+	acceptedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := &HistoryBaseline{Findings: []TriagedHistorySecret{{ContentHash: "abc123", File: "config.env", AcceptedAt: acceptedAt}}}
+
+	RebuildHistory(b, []report.Issue{
+		{File: "config.env", Metadata: map[string]string{"content_hash": "abc123"}},
+	})
+
+	if len(b.Findings) != 1 {
+		t.Fatalf("expected 1 finding after rebuild, got %d", len(b.Findings))
+	}
+	if !b.Findings[0].AcceptedAt.Equal(acceptedAt) {
+		t.Errorf("expected AcceptedAt to be preserved, got %v", b.Findings[0].AcceptedAt)
+	}
+}
+
+func TestRebuildHistory_DropsFindingsNoLongerPresent(t *testing.T) {
+	b := &HistoryBaseline{Findings: []TriagedHistorySecret{
+		{ContentHash: "abc123", File: "config.env"},
+		{ContentHash: "def456", File: "other.env"},
+	}}
+
+	RebuildHistory(b, []report.Issue{
+		{File: "config.env", Metadata: map[string]string{"content_hash": "abc123"}},
+	})
+
+	if len(b.Findings) != 1 || b.Findings[0].ContentHash != "abc123" {
+		t.Errorf("expected only the still-present finding to remain, got %+v", b.Findings)
+	}
+}
@@ -0,0 +1,74 @@
+package baseline
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+// PatchRanges maps a file path, as it appears in a unified diff's "+++ b/"
+// header, to the set of new-file line numbers the patch added or modified.
+type PatchRanges map[string]map[int]bool
+
+// hunkHeaderPattern matches a unified diff hunk header, e.g.
+// "@@ -12,5 +14,7 @@ func f() {", capturing the new-file starting line.
+var hunkHeaderPattern = regexp.MustCompile(`^@@ -\d+(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+
+// ParsePatch parses a unified diff (as `git diff` produces) into the set of
+// new-file lines each touched file's hunks add or modify, for
+// `check --new-from-patch`. Context and removed lines aren't included -
+// only lines that exist in the patched version and weren't already there.
+func ParsePatch(diff string) PatchRanges {
+	ranges := make(PatchRanges)
+
+	var currentFile string
+	var newLine int
+	inHunk := false
+
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+++ "):
+			currentFile = strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(line, "+++ "), "b/"), "\r")
+			inHunk = false
+		case strings.HasPrefix(line, "@@ "):
+			match := hunkHeaderPattern.FindStringSubmatch(line)
+			if match == nil {
+				inHunk = false
+				continue
+			}
+			newLine, _ = strconv.Atoi(match[1])
+			inHunk = currentFile != ""
+		case !inHunk:
+			continue
+		case strings.HasPrefix(line, "+"):
+			if ranges[currentFile] == nil {
+				ranges[currentFile] = make(map[int]bool)
+			}
+			ranges[currentFile][newLine] = true
+			newLine++
+		case strings.HasPrefix(line, "-"):
+			// removed line: present in the old file only, new-file line
+			// numbering doesn't advance.
+		default:
+			// unchanged context line
+			newLine++
+		}
+	}
+
+	return ranges
+}
+
+// FilterByPatch keeps only the issues whose File+Line the patch touched
+// (see ParsePatch), for reviewing just what a patch changed instead of
+// fixing every pre-existing finding in the files it touches.
+func FilterByPatch(issues []report.Issue, ranges PatchRanges) []report.Issue {
+	var touched []report.Issue
+	for _, issue := range issues {
+		if ranges[issue.File][issue.Line] {
+			touched = append(touched, issue)
+		}
+	}
+	return touched
+}
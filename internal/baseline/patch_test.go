@@ -0,0 +1,70 @@
+package baseline
+
+import (
+	"testing"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+func TestParsePatch_TracksAddedLines(t *testing.T) {
+	diff := `diff --git a/main.go b/main.go
+index 1111111..2222222 100644
+--- a/main.go
++++ b/main.go
+@@ -1,4 +1,5 @@
+ package main
+
++// comment
+ func f() {
+ 	return 1
+`
+
+	ranges := ParsePatch(diff)
+	if !ranges["main.go"][3] {
+		t.Errorf("expected line 3 (the added comment) to be touched, got %+v", ranges["main.go"])
+	}
+	if ranges["main.go"][1] {
+		t.Error("expected an untouched context line not to be marked as touched")
+	}
+}
+
+func TestParsePatch_MultipleFiles(t *testing.T) {
+	diff := `diff --git a/a.go b/a.go
+--- a/a.go
++++ b/a.go
+@@ -1,2 +1,3 @@
+ package a
++var X = 1
+
+diff --git a/b.go b/b.go
+--- a/b.go
++++ b/b.go
+@@ -1,2 +1,3 @@
+ package b
++var Y = 2
+
+`
+
+	ranges := ParsePatch(diff)
+	if !ranges["a.go"][2] {
+		t.Errorf("expected a.go line 2 to be touched, got %+v", ranges["a.go"])
+	}
+	if !ranges["b.go"][2] {
+		t.Errorf("expected b.go line 2 to be touched, got %+v", ranges["b.go"])
+	}
+}
+
+func TestFilterByPatch_KeepsOnlyTouchedIssues(t *testing.T) {
+	ranges := PatchRanges{"main.go": {3: true}}
+
+	issues := []report.Issue{
+		{File: "main.go", Line: 3, Rule: "cyclomatic-complexity"},
+		{File: "main.go", Line: 1, Rule: "max-file-lines"},
+		{File: "other.go", Line: 3, Rule: "max-file-lines"},
+	}
+
+	result := FilterByPatch(issues, ranges)
+	if len(result) != 1 || result[0].Line != 3 || result[0].File != "main.go" {
+		t.Errorf("expected only the touched issue to survive, got %+v", result)
+	}
+}
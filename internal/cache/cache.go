@@ -0,0 +1,131 @@
+// Package cache memoizes analyzer output across runs so performHealthCheck
+// doesn't have to re-run every analyzer over an unchanged working tree. Each
+// entry is keyed by a fingerprint of the analyzer's name, its configuration,
+// the content of the files it ran over, and the tool version, the same
+// incremental strategy golangci-lint uses for per-linter results.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+// Cache is an on-disk store of analyzer results, one file per fingerprint.
+type Cache struct {
+	dir string
+}
+
+// New creates a Cache rooted at $XDG_CACHE_HOME/githealthchecker (or the
+// platform default cache directory when XDG_CACHE_HOME isn't set).
+func New() (*Cache, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve cache directory: %w", err)
+	}
+
+	dir = filepath.Join(dir, "githealthchecker")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory %s: %w", dir, err)
+	}
+
+	return &Cache{dir: dir}, nil
+}
+
+// Fingerprint derives a stable cache key from the analyzer name, its
+// configuration, the content hash of every file it scanned, and the tool
+// version, so a change to any of those invalidates the cached result.
+func Fingerprint(analyzerName string, analyzerConfig any, fileHashes map[string]string, toolVersion string) (string, error) {
+	configBytes, err := json.Marshal(analyzerConfig)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal config for %s: %w", analyzerName, err)
+	}
+
+	paths := make([]string, 0, len(fileHashes))
+	for path := range fileHashes {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	hash := sha256.New()
+	hash.Write([]byte(analyzerName))
+	hash.Write(configBytes)
+	hash.Write([]byte(toolVersion))
+	for _, path := range paths {
+		hash.Write([]byte(path))
+		hash.Write([]byte(fileHashes[path]))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Get returns the issues cached under fingerprint, if any.
+func (c *Cache) Get(fingerprint string) ([]report.Issue, bool, error) {
+	data, err := os.ReadFile(c.entryPath(fingerprint))
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read cache entry %s: %w", fingerprint, err)
+	}
+
+	var issues []report.Issue
+	if err := json.Unmarshal(data, &issues); err != nil {
+		return nil, false, fmt.Errorf("failed to parse cache entry %s: %w", fingerprint, err)
+	}
+
+	return issues, true, nil
+}
+
+// Set stores issues under fingerprint, overwriting any existing entry.
+func (c *Cache) Set(fingerprint string, issues []report.Issue) error {
+	data, err := json.Marshal(issues)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache entry %s: %w", fingerprint, err)
+	}
+
+	if err := os.WriteFile(c.entryPath(fingerprint), data, 0644); err != nil {
+		return fmt.Errorf("failed to write cache entry %s: %w", fingerprint, err)
+	}
+
+	return nil
+}
+
+// Clean removes every cached entry, for `check cache clean`.
+func (c *Cache) Clean() (int, error) {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to read cache directory %s: %w", c.dir, err)
+	}
+
+	removed := 0
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if err := os.Remove(filepath.Join(c.dir, entry.Name())); err != nil {
+			return removed, fmt.Errorf("failed to remove cache entry %s: %w", entry.Name(), err)
+		}
+		removed++
+	}
+
+	return removed, nil
+}
+
+// Dir returns the directory the cache is rooted at, for diagnostics.
+func (c *Cache) Dir() string {
+	return c.dir
+}
+
+func (c *Cache) entryPath(fingerprint string) string {
+	return filepath.Join(c.dir, fingerprint+".json")
+}
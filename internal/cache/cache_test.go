@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+func newTestCache(t *testing.T) *Cache {
+	t.Helper()
+	return &Cache{dir: t.TempDir()}
+}
+
+func TestFingerprint_IsStableForSameInputs(t *testing.T) {
+	hashes := map[string]string{"main.go": "abc", "util.go": "def"}
+
+	a, err := Fingerprint("security", struct{ MaxFileSizeMB int }{10}, hashes, "1.0.0")
+	if err != nil {
+		t.Fatalf("Fingerprint failed: %v", err)
+	}
+	b, err := Fingerprint("security", struct{ MaxFileSizeMB int }{10}, hashes, "1.0.0")
+	if err != nil {
+		t.Fatalf("Fingerprint failed: %v", err)
+	}
+
+	if a != b {
+		t.Errorf("expected the same inputs to produce the same fingerprint, got %s and %s", a, b)
+	}
+}
+
+func TestFingerprint_ChangesWithFileContent(t *testing.T) {
+	config := struct{ MaxFileSizeMB int }{10}
+
+	a, err := Fingerprint("security", config, map[string]string{"main.go": "abc"}, "1.0.0")
+	if err != nil {
+		t.Fatalf("Fingerprint failed: %v", err)
+	}
+	b, err := Fingerprint("security", config, map[string]string{"main.go": "xyz"}, "1.0.0")
+	if err != nil {
+		t.Fatalf("Fingerprint failed: %v", err)
+	}
+
+	if a == b {
+		t.Error("expected a changed file hash to produce a different fingerprint")
+	}
+}
+
+func TestFingerprint_ChangesWithAnalyzerNameAndVersion(t *testing.T) {
+	hashes := map[string]string{"main.go": "abc"}
+	config := struct{ MaxFileSizeMB int }{10}
+
+	base, err := Fingerprint("security", config, hashes, "1.0.0")
+	if err != nil {
+		t.Fatalf("Fingerprint failed: %v", err)
+	}
+
+	byName, err := Fingerprint("quality", config, hashes, "1.0.0")
+	if err != nil {
+		t.Fatalf("Fingerprint failed: %v", err)
+	}
+	if base == byName {
+		t.Error("expected a different analyzer name to produce a different fingerprint")
+	}
+
+	byVersion, err := Fingerprint("security", config, hashes, "2.0.0")
+	if err != nil {
+		t.Fatalf("Fingerprint failed: %v", err)
+	}
+	if base == byVersion {
+		t.Error("expected a different tool version to produce a different fingerprint")
+	}
+}
+
+func TestSetAndGet_RoundTrips(t *testing.T) {
+	c := newTestCache(t)
+	issues := []report.Issue{{ID: "issue-1", Rule: "test-rule", File: "main.go"}}
+
+	if err := c.Set("fingerprint-a", issues); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+
+	got, hit, err := c.Get("fingerprint-a")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if !hit {
+		t.Fatal("expected a cache hit")
+	}
+	if len(got) != 1 || got[0].ID != "issue-1" {
+		t.Errorf("unexpected cached issues: %+v", got)
+	}
+}
+
+func TestGet_MissingEntryIsNotAnError(t *testing.T) {
+	c := newTestCache(t)
+
+	_, hit, err := c.Get("does-not-exist")
+	if err != nil {
+		t.Fatalf("expected no error for a missing entry, got %v", err)
+	}
+	if hit {
+		t.Error("expected a miss for an entry that was never set")
+	}
+}
+
+func TestClean_RemovesAllEntries(t *testing.T) {
+	c := newTestCache(t)
+
+	if err := c.Set("a", []report.Issue{{ID: "1"}}); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if err := c.Set("b", []report.Issue{{ID: "2"}}); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+
+	removed, err := c.Clean()
+	if err != nil {
+		t.Fatalf("Clean failed: %v", err)
+	}
+	if removed != 2 {
+		t.Errorf("expected 2 entries removed, got %d", removed)
+	}
+
+	if _, hit, _ := c.Get("a"); hit {
+		t.Error("expected entry 'a' to be gone after Clean")
+	}
+
+	entries, err := filepath.Glob(filepath.Join(c.dir, "*.json"))
+	if err != nil {
+		t.Fatalf("failed to glob cache dir: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("expected no cache files left on disk, found %v", entries)
+	}
+}
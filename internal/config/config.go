@@ -1,11 +1,16 @@
 package config
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
+	"github.com/githealthchecker/git-health-checker/internal/filekind"
+	"github.com/githealthchecker/git-health-checker/internal/report"
 	"github.com/spf13/viper"
 )
 
@@ -16,27 +21,316 @@ type Config struct {
 	Maintenance  MaintenanceConfig `mapstructure:"maintenance" yaml:"maintenance"`
 	Workflow     WorkflowConfig    `mapstructure:"workflow" yaml:"workflow"`
 	Dependencies DependencyConfig  `mapstructure:"dependencies" yaml:"dependencies"`
+	// FilePatterns maps a filekind.Kind to extra regexes that identify it,
+	// merging over filekind's built-in pattern pack so repositories with
+	// unconventional file names (Dockerfile.prod, values-staging.tpl, *.cfg)
+	// still get classified correctly. Every analyzer that gates on file
+	// type builds its filekind.Resolver from this list.
+	FilePatterns []filekind.Pattern `mapstructure:"file_patterns" yaml:"file_patterns"`
+	// Extends names parent profiles to layer this config over: either a
+	// path to another YAML config file, or one of the built-in profiles
+	// embedded under config/profiles ("strict", "oss", "enterprise").
+	// LoadCtx resolves the list recursively before unmarshalling, with a
+	// child's own values always winning over anything it extends.
+	Extends []string `mapstructure:"extends" yaml:"extends,omitempty"`
+	// Rules lets a profile retune or disable an individual rule by ID (the
+	// same ID report.Issue.Rule carries) without touching the <Feature>Config
+	// knob its analyzer happens to read. Consult it via RuleFor/RuleEnabled/
+	// RuleSeverity rather than reading the map directly.
+	Rules map[string]RuleOverride `mapstructure:"rules" yaml:"rules,omitempty"`
+	// Linters configures the pluggable external-linter subsystem that
+	// replaced the old hard-coded go-vet-only check.
+	Linters LintersConfig `mapstructure:"linters" yaml:"linters"`
+	// Suppressions configures the `//githealth:ignore` inline-suppression
+	// layer applied to every analyzer's combined findings.
+	Suppressions SuppressionsConfig `mapstructure:"suppressions" yaml:"suppressions,omitempty"`
+	// Severity configures golangci-lint-style severity remapping
+	// (report.ApplySeverityRules) applied to every analyzer's combined
+	// findings, so a profile's policy - not just a single rule's severity
+	// via Rules - can decide which findings move the health score.
+	Severity report.SeverityConfig `mapstructure:"severity" yaml:"severity,omitempty"`
+}
+
+// SuppressionsConfig is report.Suppressions' policy knob.
+type SuppressionsConfig struct {
+	// DisableUnusedCheck turns off the `unused-suppression` diagnostic
+	// --strict would otherwise emit for a githealth:ignore directive that
+	// never silenced a finding.
+	DisableUnusedCheck bool `mapstructure:"disable_unused_check" yaml:"disable_unused_check,omitempty"`
+}
+
+// LintersConfig is the analyzer.LintersAnalyzer subsystem's configuration:
+// whether it runs at all, how long any one linter is given to finish, and
+// the pack of linters it considers.
+type LintersConfig struct {
+	Enabled bool          `mapstructure:"enabled" yaml:"enabled"`
+	Timeout time.Duration `mapstructure:"timeout" yaml:"timeout"`
+	// IgnorePatterns excludes a finding whose File contains any of these
+	// substrings, the same filterLinterIssuesByPatterns behavior the old
+	// single-linter check offered.
+	IgnorePatterns []string       `mapstructure:"ignore_patterns" yaml:"ignore_patterns"`
+	Linters        []LinterConfig `mapstructure:"linters" yaml:"linters"`
+	// Concurrency bounds how many linters LintersAnalyzer runs at once.
+	// Zero uses its own built-in default.
+	Concurrency int `mapstructure:"concurrency" yaml:"concurrency,omitempty"`
+}
+
+// LinterConfig is one external linter LintersAnalyzer knows how to invoke:
+// which binary to run, with what arguments, against which of
+// CodeStatsAnalyzer's detected languages, and how to parse its output.
+type LinterConfig struct {
+	// Name identifies the linter and becomes report.Issue.Rule, so findings
+	// from different linters are distinguishable and individually
+	// overridable via Config.Rules.
+	Name    string   `mapstructure:"name" yaml:"name"`
+	Command string   `mapstructure:"command" yaml:"command"`
+	Args    []string `mapstructure:"args" yaml:"args"`
+	// Languages restricts this linter to repositories where
+	// CodeStatsAnalyzer detected at least one of these languages (its
+	// LanguageBreakdown keys, e.g. "Go", "Python"). Empty means always run.
+	Languages []string `mapstructure:"languages" yaml:"languages"`
+	// Format selects how LintersAnalyzer parses this linter's output: "line"
+	// (the generic file:line[:col]: message format most linters support) or
+	// "golangci-json" (golangci-lint's --out-format json).
+	Format   string          `mapstructure:"format" yaml:"format"`
+	Enabled  bool            `mapstructure:"enabled" yaml:"enabled"`
+	Severity report.Severity `mapstructure:"severity" yaml:"severity"`
+	// Timeout overrides LintersConfig.Timeout for this linter alone, for
+	// the rare linter (golangci-lint on a large module) that needs longer
+	// than the subsystem default. Zero means "use the subsystem timeout".
+	Timeout time.Duration `mapstructure:"timeout" yaml:"timeout,omitempty"`
+}
+
+// RuleOverride is one profile's adjustment to a single rule. Severity and
+// Params are left at their zero value when unset; Enabled is a pointer so a
+// profile that never mentions a rule can't accidentally re-enable one an
+// extended profile disabled.
+type RuleOverride struct {
+	Severity report.Severity `mapstructure:"severity" yaml:"severity,omitempty"`
+	Enabled  *bool           `mapstructure:"enabled" yaml:"enabled,omitempty"`
+	// Params carries analyzer-specific knobs a rule override wants to set
+	// (e.g. a custom threshold) without growing RuleOverride itself; each
+	// analyzer interprets its own keys.
+	Params map[string]any `mapstructure:"params" yaml:"params,omitempty"`
+}
+
+// RuleFor returns the resolved RuleOverride for rule id, or the zero value
+// if no profile has an opinion on it.
+func (c *Config) RuleFor(id string) RuleOverride {
+	return c.Rules[id]
+}
+
+// RuleEnabled reports whether rule id should fire, defaulting to true when
+// no profile has disabled it.
+func (c *Config) RuleEnabled(id string) bool {
+	if override, ok := c.Rules[id]; ok && override.Enabled != nil {
+		return *override.Enabled
+	}
+	return true
+}
+
+// RuleSeverity returns the profile-overridden severity for rule id, or
+// fallback when no profile set one.
+func (c *Config) RuleSeverity(id string, fallback report.Severity) report.Severity {
+	if override, ok := c.Rules[id]; ok && override.Severity != "" {
+		return override.Severity
+	}
+	return fallback
 }
 
 type SecurityConfig struct {
-	SecretPatterns  []string `mapstructure:"secret_patterns" yaml:"secret_patterns"`
-	MaxFileSizeMB   int      `mapstructure:"max_file_size_mb" yaml:"max_file_size_mb"`
-	SuspiciousFiles []string `mapstructure:"suspicious_files" yaml:"suspicious_files"`
-	AllowedSecrets  []string `mapstructure:"allowed_secrets" yaml:"allowed_secrets"`
+	SecretPatterns []string `mapstructure:"secret_patterns" yaml:"secret_patterns"`
+	MaxFileSizeMB  int      `mapstructure:"max_file_size_mb" yaml:"max_file_size_mb"`
+	// SuspiciousFiles is the ordered list of filename/path glob rules
+	// checked against every scanned file, each carrying its own severity so
+	// determineSuspiciousFileSeverity never has to re-derive it from a
+	// separate hard-coded list. Patterns are matched in order and the first
+	// hit wins; a leading "!" makes a pattern exclude rather than include,
+	// and a leading "(?i)" folds case.
+	SuspiciousFiles []SuspiciousFileRule `mapstructure:"suspicious_files" yaml:"suspicious_files"`
+	AllowedSecrets  []string             `mapstructure:"allowed_secrets" yaml:"allowed_secrets"`
+	// EntropyFiltering gates secret-pattern matches (and the standalone
+	// high-entropy-string rule) on Shannon entropy, so sample data, UUIDs,
+	// and version strings that merely match a KEY= pattern stop being
+	// reported just because they matched.
+	EntropyFiltering bool `mapstructure:"entropy_filtering" yaml:"entropy_filtering"`
+	// MinEntropyBase64/MinEntropyHex are the minimum bits-per-character a
+	// candidate value must have for its alphabet to be treated as a real
+	// secret rather than noise.
+	MinEntropyBase64 float64 `mapstructure:"min_entropy_base64" yaml:"min_entropy_base64"`
+	MinEntropyHex    float64 `mapstructure:"min_entropy_hex" yaml:"min_entropy_hex"`
+	// MinHighEntropyLength lets a long candidate survive even if its entropy
+	// falls short of the threshold, and is the minimum length the standalone
+	// high-entropy-string rule considers at all.
+	MinHighEntropyLength int `mapstructure:"min_high_entropy_length" yaml:"min_high_entropy_length"`
+	// EntropyExcludeGlobs skips the entropy-based checks (EntropyFiltering's
+	// gate on SecretPatterns/structured rules and the standalone
+	// high-entropy-string rule) for any file whose path matches one of these
+	// gobwas/glob patterns ("**" matches any number of path segments), e.g.
+	// "*.lock" or "testdata/**". Unlike AllowedSecrets, which allowlists a
+	// specific value anywhere, this allowlists an entire file by path.
+	EntropyExcludeGlobs []string `mapstructure:"entropy_exclude_globs" yaml:"entropy_exclude_globs"`
+	// StrictSuppressions promotes a githealth:ignore comment that never
+	// suppressed a finding into its own unused-ignore issue, so stale
+	// suppressions get cleaned up instead of accumulating silently.
+	StrictSuppressions bool `mapstructure:"strict_suppressions" yaml:"strict_suppressions"`
+	// Rules is the structured signature rule pack (the Deepfence
+	// SecretScanner model: part + match + pattern + severity + fix per
+	// rule) that scanStructuredRules dispatches against, in addition to the
+	// plain-regex SecretPatterns above. Defaults to DefaultSecretRules();
+	// --rules-file replaces it with an externally authored pack.
+	Rules []SecretRule `mapstructure:"rules" yaml:"rules"`
+	// VerifyMode is the --verify flag value (off, safe, all) controlling
+	// whether matched secrets are probed against their issuing service to
+	// confirm they're still live. Defaults to "off".
+	VerifyMode string `mapstructure:"verify_mode" yaml:"verify_mode"`
+}
+
+// RulePart names which part of a file a SecretRule inspects.
+type RulePart string
+
+const (
+	RulePartFilename  RulePart = "filename"
+	RulePartExtension RulePart = "extension"
+	RulePartPath      RulePart = "path"
+	RulePartContents  RulePart = "contents"
+)
+
+// RuleMatch names how a SecretRule's Pattern is applied to the chosen part.
+type RuleMatch string
+
+const (
+	RuleMatchRegex     RuleMatch = "regex"
+	RuleMatchSubstring RuleMatch = "substring"
+)
+
+// RuleAllowlist exempts known-safe paths or values a SecretRule would
+// otherwise flag, the same per-rule escape hatch Deepfence SecretScanner's
+// signature format offers.
+type RuleAllowlist struct {
+	Paths   []string `mapstructure:"paths" yaml:"paths"`
+	Regexes []string `mapstructure:"regexes" yaml:"regexes"`
+}
+
+// SecretRule is one structured secret-detection signature: where to look
+// (Part), how to look (Match), and what to report if it matches, so
+// severity and remediation text are authored alongside the pattern instead
+// of guessed heuristically from the matched text.
+type SecretRule struct {
+	ID        string          `mapstructure:"id" yaml:"id"`
+	Name      string          `mapstructure:"name" yaml:"name"`
+	Part      RulePart        `mapstructure:"part" yaml:"part"`
+	Match     RuleMatch       `mapstructure:"match" yaml:"match"`
+	Pattern   string          `mapstructure:"pattern" yaml:"pattern"`
+	Severity  report.Severity `mapstructure:"severity" yaml:"severity"`
+	Fix       string          `mapstructure:"fix" yaml:"fix"`
+	Allowlist RuleAllowlist   `mapstructure:"allowlist" yaml:"allowlist"`
+	// Verifier names a registered verifier.Verifier (e.g. "aws", "github",
+	// "slack", "stripe") that probes the issuing service to confirm a match
+	// is a live credential. Empty means the rule is never verified.
+	Verifier string `mapstructure:"verifier" yaml:"verifier"`
+}
+
+// SuspiciousFileRule is one SecurityConfig.SuspiciousFiles entry: a glob
+// (Syncthing .stignore syntax - "!" to exclude, a leading "(?i)" to fold
+// case, "**" for any number of path segments) paired with the severity to
+// report when it's the first pattern in the list to match a path.
+type SuspiciousFileRule struct {
+	Pattern  string          `mapstructure:"pattern" yaml:"pattern"`
+	Severity report.Severity `mapstructure:"severity" yaml:"severity"`
+}
+
+// secretRulePack is the on-disk shape of a --rules-file: a top-level
+// `rules:` list, the same shape DefaultSecretRules() produces.
+type secretRulePack struct {
+	Rules []SecretRule `mapstructure:"rules" yaml:"rules"`
+}
+
+// LoadSecretRules reads a structured rule pack from an external YAML file
+// for --rules-file, using the same viper-based loading Load uses for the
+// main configuration.
+func LoadSecretRules(path string) ([]SecretRule, error) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	v.SetConfigFile(path)
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read rules file %s: %w", path, err)
+	}
+
+	var pack secretRulePack
+	if err := v.Unmarshal(&pack); err != nil {
+		return nil, fmt.Errorf("failed to parse rules file %s: %w", path, err)
+	}
+
+	return pack.Rules, nil
+}
+
+// DefaultSecretRules is the rule pack shipped with the analyzer, covering
+// the credential formats most repositories actually leak.
+func DefaultSecretRules() []SecretRule {
+	return []SecretRule{
+		{ID: "aws-access-key-id", Name: "AWS Access Key ID", Part: RulePartContents, Match: RuleMatchRegex,
+			Pattern:  `(?:A3T[A-Z0-9]|AKIA|AGPA|AIDA|AROA|AIPA|ANPA|ANVA|ASIA)[A-Z0-9]{16}`,
+			Severity: report.SeverityCritical, Fix: "Revoke the AWS key and rotate credentials immediately"},
+		{ID: "aws-secret-access-key", Name: "AWS Secret Access Key", Part: RulePartContents, Match: RuleMatchRegex,
+			Pattern:  `(?i)aws(.{0,20})?['"][0-9a-zA-Z/+]{40}['"]`,
+			Severity: report.SeverityCritical, Fix: "Revoke the AWS key and rotate credentials immediately"},
+		{ID: "gcp-api-key", Name: "GCP API Key", Part: RulePartContents, Match: RuleMatchRegex,
+			Pattern:  `AIza[0-9A-Za-z\-_]{35}`,
+			Severity: report.SeverityHigh, Fix: "Revoke the GCP API key in the Google Cloud Console"},
+		{ID: "gcp-service-account", Name: "GCP Service Account Key", Part: RulePartContents, Match: RuleMatchSubstring,
+			Pattern:  `"type": "service_account"`,
+			Severity: report.SeverityCritical, Fix: "Remove the service account key file and rotate it"},
+		{ID: "azure-storage-key", Name: "Azure Storage Account Key", Part: RulePartContents, Match: RuleMatchRegex,
+			Pattern:  `(?i)AccountKey=[a-zA-Z0-9+/=]{88}`,
+			Severity: report.SeverityCritical, Fix: "Regenerate the storage account key in the Azure Portal"},
+		{ID: "stripe-api-key", Name: "Stripe API Key", Part: RulePartContents, Match: RuleMatchRegex,
+			Pattern:  `(?:sk|rk)_(?:test|live)_[0-9a-zA-Z]{24,}`,
+			Severity: report.SeverityCritical, Fix: "Roll the Stripe API key from the Stripe Dashboard"},
+		{ID: "slack-token", Name: "Slack Token", Part: RulePartContents, Match: RuleMatchRegex,
+			Pattern:  `xox[baprs]-[0-9a-zA-Z-]{10,}`,
+			Severity: report.SeverityHigh, Fix: "Revoke the Slack token and issue a new one"},
+		{ID: "github-token", Name: "GitHub Token", Part: RulePartContents, Match: RuleMatchRegex,
+			Pattern:  `gh[pousr]_[0-9A-Za-z]{36,}`,
+			Severity: report.SeverityCritical, Fix: "Revoke the GitHub token from Settings > Developer settings"},
+		{ID: "private-key-block", Name: "Private Key", Part: RulePartContents, Match: RuleMatchRegex,
+			Pattern:  `-----BEGIN (RSA |EC |DSA |OPENSSH |)PRIVATE KEY-----`,
+			Severity: report.SeverityCritical, Fix: "Remove the private key from version control and rotate it"},
+	}
 }
 
 type PerformanceConfig struct {
 	MaxRepositorySizeMB int      `mapstructure:"max_repository_size_mb" yaml:"max_repository_size_mb"`
 	LargeFileSizeMB     int      `mapstructure:"large_file_size_mb" yaml:"large_file_size_mb"`
 	BinaryExtensions    []string `mapstructure:"binary_extensions" yaml:"binary_extensions"`
+	// LFSRequiredExtensions lists binary extensions that should always be
+	// tracked through Git LFS once they cross LFSRequiredSizeMB, e.g. large
+	// media or archive formats that tend to bloat a repository's .git dir.
+	LFSRequiredExtensions []string `mapstructure:"lfs_required_extensions" yaml:"lfs_required_extensions"`
+	// LFSRequiredSizeMB is the size threshold, independent of
+	// LargeFileSizeMB, above which a file matching LFSRequiredExtensions is
+	// expected to be LFS-tracked.
+	LFSRequiredSizeMB int `mapstructure:"lfs_required_size_mb" yaml:"lfs_required_size_mb"`
 }
 
 type QualityConfig struct {
-	MaxFunctionLines     int     `mapstructure:"max_function_lines" yaml:"max_function_lines"`
-	MaxFileLines         int     `mapstructure:"max_file_lines" yaml:"max_file_lines"`
-	DuplicationThreshold int     `mapstructure:"duplication_threshold" yaml:"duplication_threshold"`
-	ComplexityThreshold  int     `mapstructure:"complexity_threshold" yaml:"complexity_threshold"`
-	MinTestCoverage      float64 `mapstructure:"min_test_coverage" yaml:"min_test_coverage"`
+	MaxFunctionLines     int `mapstructure:"max_function_lines" yaml:"max_function_lines"`
+	MaxFileLines         int `mapstructure:"max_file_lines" yaml:"max_file_lines"`
+	DuplicationThreshold int `mapstructure:"duplication_threshold" yaml:"duplication_threshold"`
+	ComplexityThreshold  int `mapstructure:"complexity_threshold" yaml:"complexity_threshold"`
+	// CognitiveComplexityThreshold is checkFunctionComplexity's threshold
+	// for the Sonar-style cognitive-complexity metric, kept independent of
+	// ComplexityThreshold since nesting-weighted cognitive complexity and
+	// flat McCabe cyclomatic complexity grow at different rates for the
+	// same function.
+	CognitiveComplexityThreshold int     `mapstructure:"cognitive_complexity_threshold" yaml:"cognitive_complexity_threshold"`
+	MinTestCoverage              float64 `mapstructure:"min_test_coverage" yaml:"min_test_coverage"`
+	// StaleCodeDays flags a quality issue whose offending line hasn't been
+	// touched (per git blame) in at least this many days, surfacing
+	// long-neglected complexity/length problems that are unlikely to be
+	// cleaned up as a side effect of other work.
+	StaleCodeDays int `mapstructure:"stale_code_days" yaml:"stale_code_days"`
 }
 
 type MaintenanceConfig struct {
@@ -49,19 +343,216 @@ type WorkflowConfig struct {
 	ProtectedBranches          []string `mapstructure:"protected_branches" yaml:"protected_branches"`
 	RequireConventionalCommits bool     `mapstructure:"require_conventional_commits" yaml:"require_conventional_commits"`
 	MaxCommitMessageLength     int      `mapstructure:"max_commit_message_length" yaml:"max_commit_message_length"`
+	PR                         PRConfig `mapstructure:"pr" yaml:"pr"`
+	// IgnoreBranchPatterns excludes branches matching any of these globs
+	// (e.g. "release/*") from stale-branch detection, on top of the
+	// exact-match ProtectedBranches list.
+	IgnoreBranchPatterns []string `mapstructure:"ignore_branch_patterns" yaml:"ignore_branch_patterns"`
+	// AllowedTypes is the Conventional Commits type vocabulary
+	// checkCommitMessagesCtx accepts (e.g. "feat", "fix"). Empty falls back
+	// to defaultConventionalCommitTypes.
+	AllowedTypes []string `mapstructure:"allowed_types" yaml:"allowed_types,omitempty"`
+	// RequireScope fails a commit whose header has no "(scope)".
+	RequireScope bool `mapstructure:"require_scope" yaml:"require_scope,omitempty"`
+	// AllowedScopes, if non-empty, restricts a commit's scope (when
+	// present) to this list.
+	AllowedScopes []string `mapstructure:"allowed_scopes" yaml:"allowed_scopes,omitempty"`
+	// RequireSignoff fails a commit missing a "Signed-off-by:" footer.
+	RequireSignoff bool `mapstructure:"require_signoff" yaml:"require_signoff,omitempty"`
+}
+
+// PRConfig configures how `fix` opens pull/merge requests for dependency updates.
+type PRConfig struct {
+	Provider     string   `mapstructure:"provider" yaml:"provider"` // "github" or "gitlab"
+	TokenEnvVar  string   `mapstructure:"token_env_var" yaml:"token_env_var"`
+	Reviewers    []string `mapstructure:"reviewers" yaml:"reviewers"`
+	BaseBranch   string   `mapstructure:"base_branch" yaml:"base_branch"`
+	BranchPrefix string   `mapstructure:"branch_prefix" yaml:"branch_prefix"`
 }
 
 type DependencyConfig struct {
-	CheckOutdated        bool     `mapstructure:"check_outdated" yaml:"check_outdated"`
-	CheckVulnerabilities bool     `mapstructure:"check_vulnerabilities" yaml:"check_vulnerabilities"`
-	MaxDaysOutdated      int      `mapstructure:"max_days_outdated" yaml:"max_days_outdated"`
-	AllowedPackages      []string `mapstructure:"allowed_packages" yaml:"allowed_packages"`
-	BlockedPackages      []string `mapstructure:"blocked_packages" yaml:"blocked_packages"`
+	CheckOutdated         bool          `mapstructure:"check_outdated" yaml:"check_outdated"`
+	CheckVulnerabilities  bool          `mapstructure:"check_vulnerabilities" yaml:"check_vulnerabilities"`
+	MaxDaysOutdated       int           `mapstructure:"max_days_outdated" yaml:"max_days_outdated"`
+	AllowedPackages       []string      `mapstructure:"allowed_packages" yaml:"allowed_packages"`
+	BlockedPackages       []string      `mapstructure:"blocked_packages" yaml:"blocked_packages"`
+	OSVDatabasePath       string        `mapstructure:"osv_database_path" yaml:"osv_database_path"` // local OSV JSON tree; empty queries api.osv.dev
+	OSVSymbolFiltering    bool          `mapstructure:"osv_symbol_filtering" yaml:"osv_symbol_filtering"`
+	VulnerabilitySource   string        `mapstructure:"vulnerability_source" yaml:"vulnerability_source"` // "osv" (default), "github", or "local"
+	GitHubToken           string        `mapstructure:"github_token" yaml:"github_token"`                 // falls back to $GITHUB_TOKEN when the "github" source is selected
+	VulnerabilityCacheTTL time.Duration `mapstructure:"vulnerability_cache_ttl" yaml:"vulnerability_cache_ttl"`
+	CheckLockfileDrift    bool          `mapstructure:"check_lockfile_drift" yaml:"check_lockfile_drift"` // cross-check manifests against lockfiles and verify go.sum hashes
+	// DisabledEcosystems lists package ecosystems (matched case-insensitively
+	// against an EcosystemAnalyzer's Ecosystem() name, e.g. "PyPI",
+	// "RubyGems") to skip during dependency analysis, for repositories that
+	// vendor a manifest format (a generated requirements.txt, say) they don't
+	// want scanned.
+	DisabledEcosystems []string `mapstructure:"disabled_ecosystems" yaml:"disabled_ecosystems"`
+	// BuildTags are extra build constraint tags (e.g. "integration", "linux")
+	// to consider satisfied when deciding whether a Go file is part of the
+	// build for getGoImports' unused-dependency scan, so a dependency only
+	// imported under a non-default build configuration isn't reported as
+	// unused.
+	BuildTags []string `mapstructure:"build_tags" yaml:"build_tags,omitempty"`
 }
 
 func Load(configPath string) (*Config, error) {
+	return LoadCtx(context.Background(), configPath)
+}
+
+// LoadCtx is Load with cancellation: ctx is checked before and after the
+// (currently local-disk) read, so a caller with a --timeout budget fails
+// fast instead of blocking, and so a future remote config source (fetched
+// over the network) has somewhere to plumb ctx.Err() through.
+func LoadCtx(ctx context.Context, configPath string) (*Config, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("config load canceled: %w", err)
+	}
+
+	config := DefaultConfig()
+
+	source, raw, err := readPrimaryConfigMap(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("config load canceled: %w", err)
+	}
+
+	if raw != nil {
+		merged, err := mergeExtends(source, raw)
+		if err != nil {
+			return nil, err
+		}
+
+		v := viper.New()
+		v.SetConfigType("yaml")
+		if err := v.MergeConfigMap(merged); err != nil {
+			return nil, fmt.Errorf("failed to merge resolved config: %w", err)
+		}
+		if err := v.Unmarshal(config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+		}
+	}
+
+	return config, nil
+}
+
+// Provenance maps a dotted config key path (e.g. "quality.stale_code_days")
+// to the source that last set its final value: a config file path, a
+// built-in profile name, or "(defaults)" for anything no config or profile
+// ever touched. It's what `config explain` renders.
+type Provenance map[string]string
+
+// ExplainCtx resolves configPath's extends chain exactly like LoadCtx, but
+// also returns the Provenance recording which file or profile contributed
+// each final value, for `healthcheck config explain`.
+func ExplainCtx(ctx context.Context, configPath string) (*Config, Provenance, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, fmt.Errorf("config load canceled: %w", err)
+	}
+
 	config := DefaultConfig()
+	prov := Provenance{}
+
+	source, raw, err := readPrimaryConfigMap(configPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if raw == nil {
+		return config, prov, nil
+	}
+
+	chain := map[string]bool{}
+	if source != "" {
+		chain[source] = true
+	}
+
+	ownLabel := source
+	if ownLabel == "" {
+		ownLabel = "(inline defaults)"
+	}
+
+	merged, err := mergeExtendsChainWithProvenance(raw, chain, ownLabel, prov)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.MergeConfigMap(merged); err != nil {
+		return nil, nil, fmt.Errorf("failed to merge resolved config: %w", err)
+	}
+	if err := v.Unmarshal(config); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	return config, prov, nil
+}
+
+// mergeExtendsChainWithProvenance is mergeExtendsChain's counterpart for
+// ExplainCtx: it performs the identical merge, but additionally records, for
+// every leaf key raw sets (directly or through its own extends chain), which
+// source last contributed it - parents are recorded first so a child's own
+// values (recorded last) correctly take provenance priority over anything it
+// extends, mirroring mergeConfigMaps' last-writer-wins merge itself.
+func mergeExtendsChainWithProvenance(raw map[string]interface{}, chain map[string]bool, ownLabel string, prov Provenance) (map[string]interface{}, error) {
+	merged := map[string]interface{}{}
+
+	for _, parentName := range stringsFromAny(raw["extends"]) {
+		if chain[parentName] {
+			return nil, fmt.Errorf("config extends cycle detected at %q", parentName)
+		}
+		chain[parentName] = true
 
+		parentRaw, err := readConfigMap(parentName)
+		if err != nil {
+			return nil, err
+		}
+
+		parentMerged, err := mergeExtendsChainWithProvenance(parentRaw, chain, parentName, prov)
+		if err != nil {
+			return nil, err
+		}
+
+		merged = mergeConfigMaps(merged, parentMerged)
+	}
+
+	recordProvenance(raw, "", ownLabel, prov)
+	return mergeConfigMaps(merged, raw), nil
+}
+
+// recordProvenance walks raw's leaves (skipping the "extends" key itself)
+// and records label as the source of each dotted path, overwriting whatever
+// an earlier call recorded for the same path.
+func recordProvenance(raw map[string]interface{}, prefix, label string, prov Provenance) {
+	for k, v := range raw {
+		if prefix == "" && k == "extends" {
+			continue
+		}
+
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+
+		if nested, ok := v.(map[string]interface{}); ok {
+			recordProvenance(nested, path, label, prov)
+			continue
+		}
+
+		prov[path] = label
+	}
+}
+
+// readPrimaryConfigMap locates and parses the user's own config file - not
+// yet merged with anything it extends - returning a nil map (and no error)
+// when none is found, the same "a config file is optional" behavior Load
+// always had. The returned source is v.ConfigFileUsed(), which doubles as
+// the cycle-detection key for mergeExtends.
+func readPrimaryConfigMap(configPath string) (string, map[string]interface{}, error) {
 	v := viper.New()
 	v.SetConfigType("yaml")
 
@@ -75,16 +566,152 @@ func Load(configPath string) (*Config, error) {
 
 	if err := v.ReadInConfig(); err != nil {
 		var configFileNotFoundError viper.ConfigFileNotFoundError
-		if !errors.As(err, &configFileNotFoundError) {
-			return nil, fmt.Errorf("failed to read config file: %w", err)
+		if errors.As(err, &configFileNotFoundError) {
+			return "", nil, nil
 		}
+		return "", nil, fmt.Errorf("failed to read config file: %w", err)
 	}
 
-	if err := v.Unmarshal(config); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	return v.ConfigFileUsed(), v.AllSettings(), nil
+}
+
+// mergeExtends resolves source's own "extends" list - file paths or
+// well-known profile names backed by the embedded profiles/*.yaml pack -
+// before merging raw on top, so the leaf config's own values always win
+// over any profile it extends.
+func mergeExtends(source string, raw map[string]interface{}) (map[string]interface{}, error) {
+	chain := map[string]bool{}
+	if source != "" {
+		chain[source] = true
 	}
+	return mergeExtendsChain(raw, chain)
+}
 
-	return config, nil
+// mergeExtendsChain merges raw's parents, in extends order, then raw itself
+// on top. chain accumulates every source visited across the whole
+// recursion, so a cycle (a extends b, b extends a) is reported as an error
+// instead of recursing forever.
+func mergeExtendsChain(raw map[string]interface{}, chain map[string]bool) (map[string]interface{}, error) {
+	merged := map[string]interface{}{}
+
+	for _, parentName := range stringsFromAny(raw["extends"]) {
+		if chain[parentName] {
+			return nil, fmt.Errorf("config extends cycle detected at %q", parentName)
+		}
+		chain[parentName] = true
+
+		parentRaw, err := readConfigMap(parentName)
+		if err != nil {
+			return nil, err
+		}
+
+		parentMerged, err := mergeExtendsChain(parentRaw, chain)
+		if err != nil {
+			return nil, err
+		}
+
+		merged = mergeConfigMaps(merged, parentMerged)
+	}
+
+	return mergeConfigMaps(merged, raw), nil
+}
+
+// readConfigMap parses one extends entry into a raw settings map: a
+// built-in profile name is read from the embedded profiles pack, anything
+// else is treated as a path to another YAML config file.
+func readConfigMap(source string) (map[string]interface{}, error) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+
+	if data, ok := builtinProfiles[source]; ok {
+		if err := v.ReadConfig(bytes.NewReader(data)); err != nil {
+			return nil, fmt.Errorf("failed to parse built-in profile %q: %w", source, err)
+		}
+		return v.AllSettings(), nil
+	}
+
+	v.SetConfigFile(source)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read extended config %q: %w", source, err)
+	}
+
+	return v.AllSettings(), nil
+}
+
+// mergeConfigMaps merges override onto base: nested maps are deep-merged
+// recursively, slices are concatenated with de-duplication, and any other
+// type simply has override's value win, the same last-writer-wins rule a
+// single YAML file already gets from viper.
+func mergeConfigMaps(base, override map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(base)+len(override))
+	for k, v := range base {
+		result[k] = v
+	}
+
+	for k, overrideVal := range override {
+		baseVal, exists := result[k]
+		if !exists {
+			result[k] = overrideVal
+			continue
+		}
+
+		switch ov := overrideVal.(type) {
+		case map[string]interface{}:
+			if bv, ok := baseVal.(map[string]interface{}); ok {
+				result[k] = mergeConfigMaps(bv, ov)
+				continue
+			}
+		case []interface{}:
+			if bv, ok := baseVal.([]interface{}); ok {
+				result[k] = concatDedupSlices(bv, ov)
+				continue
+			}
+		}
+
+		result[k] = overrideVal
+	}
+
+	return result
+}
+
+// concatDedupSlices concatenates base and override, dropping any override
+// element that's already present (by value) in base, so e.g. two profiles
+// listing the same suspicious-file pattern don't duplicate it.
+func concatDedupSlices(base, override []interface{}) []interface{} {
+	seen := make(map[string]bool, len(base))
+	out := make([]interface{}, 0, len(base)+len(override))
+
+	for _, item := range base {
+		seen[fmt.Sprintf("%v", item)] = true
+		out = append(out, item)
+	}
+	for _, item := range override {
+		key := fmt.Sprintf("%v", item)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		out = append(out, item)
+	}
+
+	return out
+}
+
+// stringsFromAny reads a YAML list value (decoded by viper as
+// []interface{}) as a []string, dropping any non-string element.
+func stringsFromAny(v interface{}) []string {
+	items, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
 }
 
 func DefaultConfig() *Config {
@@ -102,13 +729,35 @@ func DefaultConfig() *Config {
 				`(?i)connection[_-]?string[\s]*[:=][\s]*['"]?[^\s'"]+['"]?`,
 			},
 			MaxFileSizeMB: 100,
-			SuspiciousFiles: []string{
-				"*.pem", "*.key", "*.p12", "*.pfx", "*.jks",
-				".env", ".env.*", "*.env",
-				"id_rsa", "id_dsa", "id_ecdsa", "id_ed25519",
-				"*.dump", "*.backup", "database.sql", "db_dump.sql",
+			SuspiciousFiles: []SuspiciousFileRule{
+				{Pattern: ".env", Severity: report.SeverityCritical},
+				{Pattern: ".env.*", Severity: report.SeverityCritical},
+				{Pattern: "*.env", Severity: report.SeverityCritical},
+				{Pattern: "id_rsa", Severity: report.SeverityCritical},
+				{Pattern: "id_dsa", Severity: report.SeverityCritical},
+				{Pattern: "id_ecdsa", Severity: report.SeverityCritical},
+				{Pattern: "id_ed25519", Severity: report.SeverityCritical},
+				{Pattern: "private.key", Severity: report.SeverityCritical},
+				{Pattern: "server.key", Severity: report.SeverityCritical},
+				{Pattern: "*.pem", Severity: report.SeverityHigh},
+				{Pattern: "*.key", Severity: report.SeverityHigh},
+				{Pattern: "*.p12", Severity: report.SeverityHigh},
+				{Pattern: "*.pfx", Severity: report.SeverityHigh},
+				{Pattern: "*.jks", Severity: report.SeverityHigh},
+				{Pattern: "*.dump", Severity: report.SeverityMedium},
+				{Pattern: "*.backup", Severity: report.SeverityMedium},
+				{Pattern: "database.sql", Severity: report.SeverityMedium},
+				{Pattern: "db_dump.sql", Severity: report.SeverityMedium},
 			},
-			AllowedSecrets: []string{},
+			AllowedSecrets:       []string{},
+			EntropyFiltering:     false,
+			MinEntropyBase64:     4.5,
+			MinEntropyHex:        3.0,
+			MinHighEntropyLength: 20,
+			EntropyExcludeGlobs:  []string{"*.lock", "testdata/**"},
+			StrictSuppressions:   false,
+			Rules:                DefaultSecretRules(),
+			VerifyMode:           "off",
 		},
 		Performance: PerformanceConfig{
 			MaxRepositorySizeMB: 1000,
@@ -120,13 +769,21 @@ func DefaultConfig() *Config {
 				".mp3", ".mp4", ".avi", ".mov", ".wmv", ".flv",
 				".pdf", ".doc", ".docx", ".xls", ".xlsx", ".ppt", ".pptx",
 			},
+			LFSRequiredExtensions: []string{
+				".zip", ".tar", ".gz", ".bz2", ".xz", ".7z",
+				".mp3", ".mp4", ".avi", ".mov", ".wmv", ".flv",
+				".psd", ".ai", ".sketch",
+			},
+			LFSRequiredSizeMB: 5,
 		},
 		Quality: QualityConfig{
-			MaxFunctionLines:     200,  // Increased - focus on complexity instead
-			MaxFileLines:         2000, // Increased - focus on file cohesion instead
-			DuplicationThreshold: 10,
-			ComplexityThreshold:  10, // Cyclomatic complexity threshold
-			MinTestCoverage:      80.0,
+			MaxFunctionLines:             200,  // Increased - focus on complexity instead
+			MaxFileLines:                 2000, // Increased - focus on file cohesion instead
+			DuplicationThreshold:         10,
+			ComplexityThreshold:          10, // Cyclomatic complexity threshold
+			CognitiveComplexityThreshold: 15,
+			MinTestCoverage:              80.0,
+			StaleCodeDays:                180,
 		},
 		Maintenance: MaintenanceConfig{
 			StaleBranchDays: 90,
@@ -143,17 +800,70 @@ func DefaultConfig() *Config {
 			ProtectedBranches:          []string{"main", "master", "develop"},
 			RequireConventionalCommits: false,
 			MaxCommitMessageLength:     72,
+			IgnoreBranchPatterns:       []string{},
+			PR: PRConfig{
+				Provider:     "github",
+				TokenEnvVar:  "GITHUB_TOKEN",
+				Reviewers:    []string{},
+				BaseBranch:   "main",
+				BranchPrefix: "githealthchecker",
+			},
 		},
 		Dependencies: DependencyConfig{
-			CheckOutdated:        true,
-			CheckVulnerabilities: true,
-			MaxDaysOutdated:      90, // 3 months - more reasonable threshold
-			AllowedPackages:      []string{},
-			BlockedPackages:      []string{},
+			CheckOutdated:         true,
+			CheckVulnerabilities:  true,
+			MaxDaysOutdated:       90, // 3 months - more reasonable threshold
+			AllowedPackages:       []string{},
+			BlockedPackages:       []string{},
+			OSVDatabasePath:       "",
+			OSVSymbolFiltering:    false,
+			VulnerabilitySource:   "osv",
+			GitHubToken:           "",
+			VulnerabilityCacheTTL: 0,
+			CheckLockfileDrift:    true,
+			DisabledEcosystems:    []string{},
+		},
+		Linters: LintersConfig{
+			Enabled:        true,
+			Timeout:        2 * time.Minute,
+			IgnorePatterns: []string{},
+			Linters:        defaultLinters(),
 		},
 	}
 }
 
+// defaultLinters is the built-in linter pack: go vet ships enabled since
+// the Go toolchain is always available when githealthchecker itself builds;
+// everything else requires a binary most repos won't have installed, so it
+// ships disabled and opt-in via a profile or config's linters.linters list.
+func defaultLinters() []LinterConfig {
+	return []LinterConfig{
+		{Name: "go-vet", Command: "go", Args: []string{"vet", "./..."}, Languages: []string{"Go"}, Format: "line", Enabled: true, Severity: report.SeverityMedium},
+		{Name: "staticcheck", Command: "staticcheck", Args: []string{"-f", "json", "./..."}, Languages: []string{"Go"}, Format: "staticcheck-json", Enabled: false, Severity: report.SeverityMedium},
+		{Name: "ineffassign", Command: "ineffassign", Args: []string{"./..."}, Languages: []string{"Go"}, Format: "line", Enabled: false, Severity: report.SeverityLow},
+		{Name: "errcheck", Command: "errcheck", Args: []string{"./..."}, Languages: []string{"Go"}, Format: "line", Enabled: false, Severity: report.SeverityMedium},
+		{Name: "golangci-lint", Command: "golangci-lint", Args: []string{"run", "--out-format", "json"}, Languages: []string{"Go"}, Format: "golangci-json", Enabled: false, Severity: report.SeverityMedium},
+		{Name: "gosec", Command: "gosec", Args: []string{"-fmt", "json", "./..."}, Languages: []string{"Go"}, Format: "gosec-json", Enabled: false, Severity: report.SeverityMedium},
+		// gofumpt prints nothing for already-formatted files, so a clean
+		// repo yields no issues at all rather than one per file.
+		{Name: "gofumpt", Command: "gofumpt", Args: []string{"-l", "-d", "."}, Languages: []string{"Go"}, Format: "gofumpt-diff", Enabled: false, Severity: report.SeverityLow},
+		// gitleaks writes its report to --report-path rather than stdout;
+		// "-" (supported since gitleaks 8.18) targets stdout so
+		// LintersAnalyzer can capture it the same way as every other tool.
+		{Name: "gitleaks", Command: "gitleaks", Args: []string{"detect", "--report-format", "json", "--report-path", "-"}, Format: "gitleaks-json", Enabled: false, Severity: report.SeverityHigh},
+		{Name: "ruff", Command: "ruff", Args: []string{"check", "--output-format", "concise", "."}, Languages: []string{"Python"}, Format: "line", Enabled: false, Severity: report.SeverityMedium},
+		{Name: "eslint", Command: "eslint", Args: []string{".", "--format", "unix"}, Languages: []string{"JavaScript", "TypeScript"}, Format: "line", Enabled: false, Severity: report.SeverityMedium},
+		{Name: "pylint", Command: "pylint", Args: []string{".", "--output-format=parseable"}, Languages: []string{"Python"}, Format: "line", Enabled: false, Severity: report.SeverityMedium},
+		// shellcheck doesn't recurse a directory on its own; repositories
+		// enabling it need to override Args with their own shell script
+		// paths or glob, e.g. ["-f", "gcc", "scripts/*.sh"].
+		{Name: "shellcheck", Command: "shellcheck", Args: []string{"-f", "gcc"}, Languages: []string{"Shell"}, Format: "line", Enabled: false, Severity: report.SeverityMedium},
+		// hadolint only looks at the single file it's given; this default
+		// assumes a root Dockerfile, same caveat as shellcheck above.
+		{Name: "hadolint", Command: "hadolint", Args: []string{"-f", "gcc", "Dockerfile"}, Languages: []string{"Dockerfile"}, Format: "line", Enabled: false, Severity: report.SeverityMedium},
+	}
+}
+
 func (c *Config) Validate() error {
 	if err := c.validateSecurity(); err != nil {
 		return err
@@ -170,13 +880,19 @@ func (c *Config) Validate() error {
 	if err := c.validateWorkflow(); err != nil {
 		return err
 	}
-	return c.validateDependencies()
+	if err := c.validateDependencies(); err != nil {
+		return err
+	}
+	return c.validateLinters()
 }
 
 func (c *Config) validateSecurity() error {
 	if c.Security.MaxFileSizeMB <= 0 {
 		return fmt.Errorf("security.max_file_size_mb must be positive")
 	}
+	if c.Security.MinHighEntropyLength < 0 {
+		return fmt.Errorf("security.min_high_entropy_length must be non-negative")
+	}
 	return nil
 }
 
@@ -184,6 +900,9 @@ func (c *Config) validatePerformance() error {
 	if c.Performance.LargeFileSizeMB < 0 {
 		return fmt.Errorf("performance.large_file_size_mb must be non-negative")
 	}
+	if c.Performance.LFSRequiredSizeMB < 0 {
+		return fmt.Errorf("performance.lfs_required_size_mb must be non-negative")
+	}
 	return nil
 }
 
@@ -200,6 +919,9 @@ func (c *Config) validateQuality() error {
 	if c.Quality.MinTestCoverage < 0 || c.Quality.MinTestCoverage > 100 {
 		return fmt.Errorf("quality.min_test_coverage must be between 0 and 100")
 	}
+	if c.Quality.StaleCodeDays < 0 {
+		return fmt.Errorf("quality.stale_code_days must be non-negative")
+	}
 	return nil
 }
 
@@ -214,6 +936,9 @@ func (c *Config) validateWorkflow() error {
 	if c.Workflow.MaxCommitMessageLength <= 0 {
 		return fmt.Errorf("workflow.max_commit_message_length must be positive")
 	}
+	if c.Workflow.PR.Provider != "" && c.Workflow.PR.Provider != "github" && c.Workflow.PR.Provider != "gitlab" {
+		return fmt.Errorf("workflow.pr.provider must be 'github' or 'gitlab'")
+	}
 	return nil
 }
 
@@ -221,6 +946,29 @@ func (c *Config) validateDependencies() error {
 	if c.Dependencies.MaxDaysOutdated <= 0 {
 		return fmt.Errorf("dependencies.max_days_outdated must be positive")
 	}
+	switch c.Dependencies.VulnerabilitySource {
+	case "", "osv", "github", "local":
+	default:
+		return fmt.Errorf("dependencies.vulnerability_source must be 'osv', 'github', or 'local'")
+	}
+	if c.Dependencies.VulnerabilityCacheTTL < 0 {
+		return fmt.Errorf("dependencies.vulnerability_cache_ttl must be non-negative")
+	}
+	return nil
+}
+
+func (c *Config) validateLinters() error {
+	if c.Linters.Timeout < 0 {
+		return fmt.Errorf("linters.timeout must be non-negative")
+	}
+	for _, linter := range c.Linters.Linters {
+		if linter.Name == "" {
+			return fmt.Errorf("linters.linters entries must have a name")
+		}
+		if linter.Command == "" {
+			return fmt.Errorf("linters.linters[%s].command must not be empty", linter.Name)
+		}
+	}
 	return nil
 }
 
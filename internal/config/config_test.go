@@ -58,8 +58,10 @@ security:
     - "custom_pattern_1"
     - "custom_pattern_2"
   suspicious_files:
-    - "*.secret"
-    - "private.*"
+    - pattern: "*.secret"
+      severity: "high"
+    - pattern: "private.*"
+      severity: "critical"
   allowed_secrets:
     - "test_secret"
 
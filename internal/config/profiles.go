@@ -0,0 +1,38 @@
+package config
+
+import (
+	"embed"
+	"strings"
+)
+
+//go:embed profiles/*.yaml
+var profilesFS embed.FS
+
+// builtinProfiles maps a well-known profile name (e.g. "strict") to its
+// embedded YAML content, resolved once at startup so extends: [strict]
+// never touches the filesystem.
+var builtinProfiles = loadBuiltinProfiles()
+
+func loadBuiltinProfiles() map[string][]byte {
+	entries, err := profilesFS.ReadDir("profiles")
+	if err != nil {
+		return map[string][]byte{}
+	}
+
+	profiles := make(map[string][]byte, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		data, err := profilesFS.ReadFile("profiles/" + entry.Name())
+		if err != nil {
+			continue
+		}
+
+		name := strings.TrimSuffix(entry.Name(), ".yaml")
+		profiles[name] = data
+	}
+
+	return profiles
+}
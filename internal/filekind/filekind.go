@@ -0,0 +1,118 @@
+// Package filekind classifies files by regex patterns matched against their
+// path, instead of a hard-coded extension list, so repositories with
+// unconventional names (Dockerfile.prod, values-staging.tpl, *.cfg) still
+// get routed to the right analyzer. This is the approach Trivy/fanal takes
+// for its builtin file classifiers.
+package filekind
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Kind names a category of file an analyzer cares about.
+type Kind string
+
+const (
+	KindGo    Kind = "go"
+	KindGoMod Kind = "gomod"
+	KindYAML  Kind = "yaml"
+	KindJSON  Kind = "json"
+	KindEnv   Kind = "env"
+	KindCode  Kind = "code"
+)
+
+// defaultPatterns is the built-in pattern pack, one or more regexes per
+// Kind. A path can match more than one Kind (a .go file is both KindGo and
+// KindCode).
+func defaultPatterns() map[Kind][]string {
+	return map[Kind][]string{
+		KindGo:    {`\.go$`},
+		KindGoMod: {`(^|/)go\.(mod|sum)$`},
+		KindYAML:  {`\.ya?ml$`},
+		KindJSON:  {`\.json$`},
+		KindEnv:   {`(^|/)\.env(\..*)?$`, `\.env$`},
+		KindCode:  {`\.(go|js|ts|py|java|rb|php|cs|cpp|c|rs|kt)$`},
+	}
+}
+
+// kindPriority orders FileKind's single-kind resolution: more specific
+// kinds (go.mod over the generic "code" bucket) win when a path matches
+// more than one.
+var kindPriority = []Kind{KindGoMod, KindGo, KindYAML, KindJSON, KindEnv, KindCode}
+
+// Pattern is one user-supplied override, parsed from a `file_patterns:`
+// config entry or a repeated --file-patterns flag value.
+type Pattern struct {
+	Kind    string `mapstructure:"kind" yaml:"kind"`
+	Pattern string `mapstructure:"pattern" yaml:"pattern"`
+}
+
+// Resolver matches file paths against a merged set of default and
+// user-supplied patterns per Kind.
+type Resolver struct {
+	rules map[Kind][]*regexp.Regexp
+}
+
+// NewResolver compiles the default pattern pack plus overrides, which merge
+// over (rather than replace) the defaults: an override for an existing Kind
+// adds another way to match it, and an override naming a new Kind
+// introduces it.
+func NewResolver(overrides []Pattern) (*Resolver, error) {
+	r := &Resolver{rules: make(map[Kind][]*regexp.Regexp)}
+
+	for kind, patterns := range defaultPatterns() {
+		for _, pattern := range patterns {
+			compiled, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compile built-in pattern %q for kind %q: %w", pattern, kind, err)
+			}
+			r.rules[kind] = append(r.rules[kind], compiled)
+		}
+	}
+
+	for _, override := range overrides {
+		compiled, err := regexp.Compile(override.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile file-patterns override %q for kind %q: %w", override.Pattern, override.Kind, err)
+		}
+		kind := Kind(override.Kind)
+		r.rules[kind] = append(r.rules[kind], compiled)
+	}
+
+	return r, nil
+}
+
+// Is reports whether path matches kind's patterns.
+func (r *Resolver) Is(path string, kind Kind) bool {
+	for _, re := range r.rules[kind] {
+		if re.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAny reports whether path matches any of the given kinds, the
+// replacement for an analyzer's old `relevantExtensions[file.Extension]`
+// lookup.
+func (r *Resolver) IsAny(path string, kinds ...Kind) bool {
+	for _, kind := range kinds {
+		if r.Is(path, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// FileKind returns path's highest-priority matching Kind. It's for callers
+// that need a single classification rather than a set membership test; ok
+// is false if path matches no known Kind.
+func (r *Resolver) FileKind(path string) (kind Kind, ok bool) {
+	for _, kind := range kindPriority {
+		if r.Is(path, kind) {
+			return kind, true
+		}
+	}
+	return "", false
+}
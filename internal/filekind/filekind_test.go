@@ -0,0 +1,76 @@
+package filekind
+
+import "testing"
+
+func TestResolver_Is_DefaultPatterns(t *testing.T) {
+	r, err := NewResolver(nil)
+	if err != nil {
+		t.Fatalf("NewResolver: %v", err)
+	}
+
+	if !r.Is("internal/analyzer/security.go", KindGo) {
+		t.Error("expected security.go to match KindGo")
+	}
+	if !r.Is("go.sum", KindGoMod) {
+		t.Error("expected go.sum to match KindGoMod")
+	}
+	if r.Is("README.md", KindGo) {
+		t.Error("expected README.md not to match KindGo")
+	}
+	if !r.Is(".env", KindEnv) {
+		t.Error("expected .env to match KindEnv")
+	}
+	if !r.Is("config.env", KindEnv) {
+		t.Error("expected a suffix-style config.env to match KindEnv")
+	}
+}
+
+func TestResolver_IsAny(t *testing.T) {
+	r, err := NewResolver(nil)
+	if err != nil {
+		t.Fatalf("NewResolver: %v", err)
+	}
+
+	if !r.IsAny("config.yaml", KindJSON, KindYAML) {
+		t.Error("expected config.yaml to match KindYAML via IsAny")
+	}
+	if r.IsAny("config.yaml", KindJSON, KindEnv) {
+		t.Error("expected config.yaml not to match either KindJSON or KindEnv")
+	}
+}
+
+func TestResolver_FileKind_PrefersMostSpecific(t *testing.T) {
+	r, err := NewResolver(nil)
+	if err != nil {
+		t.Fatalf("NewResolver: %v", err)
+	}
+
+	kind, ok := r.FileKind("go.mod")
+	if !ok || kind != KindGoMod {
+		t.Errorf("expected go.mod to resolve to KindGoMod, got %q (ok=%v)", kind, ok)
+	}
+
+	if _, ok := r.FileKind("LICENSE"); ok {
+		t.Error("expected LICENSE to match no known kind")
+	}
+}
+
+func TestResolver_Overrides_MergeOverDefaults(t *testing.T) {
+	r, err := NewResolver([]Pattern{{Kind: "go", Pattern: `\.tmpl\.go$`}, {Kind: "terraform", Pattern: `\.tf$`}})
+	if err != nil {
+		t.Fatalf("NewResolver: %v", err)
+	}
+
+	if !r.Is("handler.go", KindGo) {
+		t.Error("expected built-in .go pattern to still match after merging an override")
+	}
+	if !r.Is("main.tf", Kind("terraform")) {
+		t.Error("expected override to introduce a new kind")
+	}
+}
+
+func TestResolver_InvalidPattern(t *testing.T) {
+	if _, err := NewResolver([]Pattern{{Kind: "broken", Pattern: "("}}); err == nil {
+		t.Error("expected an error for an invalid override regex")
+	}
+}
@@ -0,0 +1,167 @@
+package git
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// PullRequestRequest describes a pull/merge request to open for a branch.
+type PullRequestRequest struct {
+	Owner     string
+	Repo      string
+	Title     string
+	Body      string
+	Head      string
+	Base      string
+	Reviewers []string
+}
+
+// PullRequestClient opens a pull/merge request against a provider's REST API.
+type PullRequestClient interface {
+	OpenPullRequest(req PullRequestRequest) (url string, err error)
+}
+
+// NewPullRequestClient returns a client for the given provider ("github" or
+// "gitlab"), reading the auth token from tokenEnvVar.
+func NewPullRequestClient(provider, tokenEnvVar string) (PullRequestClient, error) {
+	token := os.Getenv(tokenEnvVar)
+	if token == "" {
+		return nil, fmt.Errorf("environment variable %s is not set", tokenEnvVar)
+	}
+
+	switch strings.ToLower(provider) {
+	case "github", "":
+		return &githubPRClient{token: token}, nil
+	case "gitlab":
+		return &gitlabPRClient{token: token}, nil
+	default:
+		return nil, fmt.Errorf("unsupported PR provider: %s", provider)
+	}
+}
+
+type githubPRClient struct {
+	token string
+}
+
+func (c *githubPRClient) OpenPullRequest(req PullRequestRequest) (string, error) {
+	payload := map[string]interface{}{
+		"title": req.Title,
+		"body":  req.Body,
+		"head":  req.Head,
+		"base":  req.Base,
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal pull request payload: %w", err)
+	}
+
+	url := fmt.Sprintf("https://api.github.com/repos/%s/%s/pulls", req.Owner, req.Repo)
+	httpReq, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to build pull request: %w", err)
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+c.token)
+	httpReq.Header.Set("Accept", "application/vnd.github+json")
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("failed to open pull request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("GitHub API returned status %d opening pull request", resp.StatusCode)
+	}
+
+	var result struct {
+		Number  int    `json:"number"`
+		HTMLURL string `json:"html_url"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode pull request response: %w", err)
+	}
+
+	if len(req.Reviewers) > 0 {
+		// Best-effort: reviewer assignment failures shouldn't fail PR creation.
+		_ = c.requestReviewers(req, result.Number)
+	}
+
+	return result.HTMLURL, nil
+}
+
+func (c *githubPRClient) requestReviewers(req PullRequestRequest, prNumber int) error {
+	payload := map[string]interface{}{"reviewers": req.Reviewers}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal reviewers payload: %w", err)
+	}
+
+	url := fmt.Sprintf("https://api.github.com/repos/%s/%s/pulls/%d/requested_reviewers", req.Owner, req.Repo, prNumber)
+	httpReq, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build reviewer request: %w", err)
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+c.token)
+	httpReq.Header.Set("Accept", "application/vnd.github+json")
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to request reviewers: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return nil
+}
+
+type gitlabPRClient struct {
+	token string
+}
+
+func (c *gitlabPRClient) OpenPullRequest(req PullRequestRequest) (string, error) {
+	payload := map[string]interface{}{
+		"title":         req.Title,
+		"description":   req.Body,
+		"source_branch": req.Head,
+		"target_branch": req.Base,
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal merge request payload: %w", err)
+	}
+
+	projectID := fmt.Sprintf("%s%%2F%s", req.Owner, req.Repo)
+	url := fmt.Sprintf("https://gitlab.com/api/v4/projects/%s/merge_requests", projectID)
+	httpReq, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to build merge request: %w", err)
+	}
+	httpReq.Header.Set("PRIVATE-TOKEN", c.token)
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("failed to open merge request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("GitLab API returned status %d opening merge request", resp.StatusCode)
+	}
+
+	var result struct {
+		WebURL string `json:"web_url"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode merge request response: %w", err)
+	}
+
+	return result.WebURL, nil
+}
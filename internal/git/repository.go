@@ -1,21 +1,174 @@
 package git
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
 )
 
-const reachedMaxCountError = "reached max count"
-
 const failedToGetHeadError = "failed to get HEAD:"
 
+// ErrStopWalk is the sentinel a WalkCommits visit func returns to end the
+// walk early without treating it as a failure. It's go-git's storer.ErrStop
+// under the hood, re-exported so callers never need to import the storer
+// package just to stop a walk.
+var ErrStopWalk = storer.ErrStop
+
+// CommitWalkOrder selects the traversal order WalkCommits asks go-git's Log
+// for, mirroring the handful of gogit.LogOrder values callers actually need.
+type CommitWalkOrder int
+
+const (
+	// WalkOrderDefault is go-git's own default order (reverse chronological
+	// from From, following first-parent-then-merge like `git log`).
+	WalkOrderDefault CommitWalkOrder = iota
+	// WalkOrderTopo visits commits in dependency order (a commit always
+	// after its parents), matching `git log --topo-order`.
+	WalkOrderTopo
+)
+
+func (o CommitWalkOrder) logOrder() gogit.LogOrder {
+	if o == WalkOrderTopo {
+		return gogit.LogOrderDFS
+	}
+	return gogit.LogOrderDefault
+}
+
+// CommitWalkOpts narrows a WalkCommits traversal with the predicates go-git's
+// Log already supports natively (Since/Until/PathFilter/Order), plus Author
+// and MaxCount applied while walking, so none of it requires buffering the
+// full history first.
+type CommitWalkOpts struct {
+	Since time.Time
+	Until time.Time
+	// Author matches a commit if it's a substring of either the commit
+	// author's name or email.
+	Author string
+	// PathFilter limits the walk to commits touching one of these paths
+	// (exact match or path-prefix), the same filter `git log -- <paths>` applies.
+	PathFilter []string
+	// MaxCount stops the walk once this many commits have been visited.
+	// Zero (the default) walks the entire history.
+	MaxCount int
+	Order    CommitWalkOrder
+}
+
+func (o CommitWalkOpts) pathFilterFunc() func(string) bool {
+	if len(o.PathFilter) == 0 {
+		return nil
+	}
+	paths := o.PathFilter
+	return func(path string) bool {
+		for _, p := range paths {
+			if path == p || strings.HasPrefix(path, p+"/") {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func (o CommitWalkOpts) matchesAuthor(commit *object.Commit) bool {
+	if o.Author == "" {
+		return true
+	}
+	return strings.Contains(commit.Author.Name, o.Author) || strings.Contains(commit.Author.Email, o.Author)
+}
+
+// WalkCommits streams commits reachable from HEAD through visit, in bounded
+// memory regardless of history size - unlike GetCommitHistory/GetFileHistory
+// it never materializes a slice of its own. Returning ErrStopWalk (or any
+// error wrapping it, via errors.Is) from visit ends the walk cleanly; any
+// other error aborts it and is returned to the caller.
+func (r *Repository) WalkCommits(ctx context.Context, opts CommitWalkOpts, visit func(*object.Commit) error) error {
+	head, err := r.repo.Head()
+	if err != nil {
+		return fmt.Errorf(failedToGetHeadError+" %w", err)
+	}
+
+	logOpts := &gogit.LogOptions{
+		From:       head.Hash(),
+		Order:      opts.Order.logOrder(),
+		PathFilter: opts.pathFilterFunc(),
+	}
+	if !opts.Since.IsZero() {
+		since := opts.Since
+		logOpts.Since = &since
+	}
+	if !opts.Until.IsZero() {
+		until := opts.Until
+		logOpts.Until = &until
+	}
+
+	commitIter, err := r.repo.Log(logOpts)
+	if err != nil {
+		return fmt.Errorf("failed to get commit log: %w", err)
+	}
+	defer commitIter.Close()
+
+	count := 0
+	err = commitIter.ForEach(func(commit *object.Commit) error {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("commit walk canceled: %w", err)
+		}
+		if !opts.matchesAuthor(commit) {
+			return nil
+		}
+		if opts.MaxCount > 0 && count >= opts.MaxCount {
+			return ErrStopWalk
+		}
+		count++
+		return visit(commit)
+	})
+
+	if err != nil && !errors.Is(err, ErrStopWalk) {
+		return fmt.Errorf("failed to iterate commits: %w", err)
+	}
+
+	return nil
+}
+
+// CommitRingBuffer collects up to size commits visited through WalkCommits,
+// evicting the oldest-visited commit once full, for the callers that
+// genuinely want a bounded slice back (e.g. "the last 10 commits") instead
+// of consuming the walk themselves.
+type CommitRingBuffer struct {
+	commits []*object.Commit
+	size    int
+}
+
+// NewCommitRingBuffer returns a buffer that retains at most size commits.
+func NewCommitRingBuffer(size int) *CommitRingBuffer {
+	return &CommitRingBuffer{size: size}
+}
+
+// Visit is a WalkCommits visitor that appends commit to the buffer, evicting
+// the oldest entry once size is exceeded.
+func (b *CommitRingBuffer) Visit(commit *object.Commit) error {
+	b.commits = append(b.commits, commit)
+	if len(b.commits) > b.size {
+		b.commits = b.commits[len(b.commits)-b.size:]
+	}
+	return nil
+}
+
+// Commits returns the buffered commits, oldest first.
+func (b *CommitRingBuffer) Commits() []*object.Commit {
+	return b.commits
+}
+
 type Repository struct {
 	repo     *gogit.Repository
 	workTree *gogit.Worktree
@@ -32,6 +185,10 @@ type Cache struct {
 	commitHistory []*object.Commit
 	batchedData   *BatchedGitData
 	cacheValid    bool
+	// blameResults caches a file's full blame, keyed by "<HEAD hash>:<path>",
+	// since gogit.Blame walks the file's entire history and analyzers call
+	// BlameLine once per issue line, often several lines in the same file.
+	blameResults map[string]*gogit.BlameResult
 }
 
 // BatchedGitData contains results from batched Git operations
@@ -123,33 +280,22 @@ func (r *Repository) GetCurrentCommit() (string, error) {
 }
 
 func (r *Repository) GetCommitHistory(maxCount int) ([]*object.Commit, error) {
-	head, err := r.repo.Head()
-	if err != nil {
-		return nil, fmt.Errorf(failedToGetHeadError+" %w", err)
-	}
-
-	commitIter, err := r.repo.Log(&gogit.LogOptions{
-		From: head.Hash(),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to get commit log: %w", err)
-	}
-	defer commitIter.Close()
+	return r.GetCommitHistoryCtx(context.Background(), maxCount)
+}
 
+// GetCommitHistoryCtx is GetCommitHistory with cancellation: ctx is checked
+// on every commit visited, so a scan of a repository with a very long
+// history can be aborted via Ctrl-C or --timeout instead of running to
+// completion.
+func (r *Repository) GetCommitHistoryCtx(ctx context.Context, maxCount int) ([]*object.Commit, error) {
 	var commits []*object.Commit
-	count := 0
 
-	err = commitIter.ForEach(func(commit *object.Commit) error {
-		if maxCount > 0 && count >= maxCount {
-			return fmt.Errorf(reachedMaxCountError)
-		}
+	err := r.WalkCommits(ctx, CommitWalkOpts{MaxCount: maxCount}, func(commit *object.Commit) error {
 		commits = append(commits, commit)
-		count++
 		return nil
 	})
-
-	if err != nil && !strings.Contains(err.Error(), reachedMaxCountError) {
-		return nil, fmt.Errorf("failed to iterate commits: %w", err)
+	if err != nil {
+		return nil, err
 	}
 
 	return commits, nil
@@ -194,34 +340,20 @@ func (r *Repository) GetStatus() (gogit.Status, error) {
 }
 
 func (r *Repository) GetFileHistory(filePath string, maxCount int) ([]*object.Commit, error) {
-	head, err := r.repo.Head()
-	if err != nil {
-		return nil, fmt.Errorf(failedToGetHeadError+" %w", err)
-	}
-
-	commitIter, err := r.repo.Log(&gogit.LogOptions{
-		From:     head.Hash(),
-		FileName: &filePath,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to get file history: %w", err)
-	}
-	defer commitIter.Close()
+	return r.GetFileHistoryCtx(context.Background(), filePath, maxCount)
+}
 
+// GetFileHistoryCtx is GetFileHistory with cancellation; see GetCommitHistoryCtx.
+func (r *Repository) GetFileHistoryCtx(ctx context.Context, filePath string, maxCount int) ([]*object.Commit, error) {
 	var commits []*object.Commit
-	count := 0
 
-	err = commitIter.ForEach(func(commit *object.Commit) error {
-		if maxCount > 0 && count >= maxCount {
-			return fmt.Errorf(reachedMaxCountError)
-		}
+	opts := CommitWalkOpts{MaxCount: maxCount, PathFilter: []string{filePath}}
+	err := r.WalkCommits(ctx, opts, func(commit *object.Commit) error {
 		commits = append(commits, commit)
-		count++
 		return nil
 	})
-
-	if err != nil && !strings.Contains(err.Error(), reachedMaxCountError) {
-		return nil, fmt.Errorf("failed to iterate file commits: %w", err)
+	if err != nil {
+		return nil, err
 	}
 
 	return commits, nil
@@ -229,6 +361,12 @@ func (r *Repository) GetFileHistory(filePath string, maxCount int) ([]*object.Co
 
 // BatchGitOperations performs multiple Git operations in one call for efficiency
 func (r *Repository) BatchGitOperations() (*BatchedGitData, error) {
+	return r.BatchGitOperationsCtx(context.Background())
+}
+
+// BatchGitOperationsCtx is BatchGitOperations with cancellation, checked
+// between each of the underlying Git calls.
+func (r *Repository) BatchGitOperationsCtx(ctx context.Context) (*BatchedGitData, error) {
 	r.cache.mu.Lock()
 	defer r.cache.mu.Unlock()
 
@@ -237,6 +375,10 @@ func (r *Repository) BatchGitOperations() (*BatchedGitData, error) {
 		return r.cache.batchedData, nil
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("batched git operations canceled: %w", err)
+	}
+
 	batchedData := &BatchedGitData{}
 
 	// Get HEAD reference once
@@ -256,6 +398,10 @@ func (r *Repository) BatchGitOperations() (*BatchedGitData, error) {
 	// Get current commit
 	batchedData.CurrentCommit = head.Hash().String()
 
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("batched git operations canceled: %w", err)
+	}
+
 	// Get branches
 	branches, err := r.getBranchesInternal()
 	if err == nil {
@@ -304,6 +450,78 @@ func (r *Repository) getBranchesInternal() ([]string, error) {
 	return branchNames, nil
 }
 
+// GetBranchLastCommit returns the commit at the tip of the named local
+// branch.
+func (r *Repository) GetBranchLastCommit(branch string) (*object.Commit, error) {
+	ref, err := r.repo.Reference(plumbing.NewBranchReferenceName(branch), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve branch %s: %w", branch, err)
+	}
+
+	commit, err := r.repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit for branch %s: %w", branch, err)
+	}
+
+	return commit, nil
+}
+
+// ResolveRevision resolves rev (a branch, tag, short or full hash, or any
+// other revision go-git's parser accepts, e.g. "HEAD~5") to a commit hash.
+func (r *Repository) ResolveRevision(rev string) (plumbing.Hash, error) {
+	hash, err := r.repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to resolve revision %s: %w", rev, err)
+	}
+	return *hash, nil
+}
+
+// GetAllBranchTips returns every local branch's tip commit, keyed by branch
+// name, in a single pass over the repository's references rather than one
+// lookup per branch.
+func (r *Repository) GetAllBranchTips() (map[string]*object.Commit, error) {
+	refs, err := r.repo.References()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get references: %w", err)
+	}
+	defer refs.Close()
+
+	tips := make(map[string]*object.Commit)
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		if !ref.Name().IsBranch() {
+			return nil
+		}
+
+		commit, err := r.repo.CommitObject(ref.Hash())
+		if err != nil {
+			return nil // skip refs that don't resolve to a commit
+		}
+
+		tips[ref.Name().Short()] = commit
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate references: %w", err)
+	}
+
+	return tips, nil
+}
+
+// GetRemoteURL returns the first configured fetch URL for the named remote.
+func (r *Repository) GetRemoteURL(remoteName string) (string, error) {
+	remote, err := r.repo.Remote(remoteName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get remote %s: %w", remoteName, err)
+	}
+
+	urls := remote.Config().URLs
+	if len(urls) == 0 {
+		return "", fmt.Errorf("remote %s has no configured URL", remoteName)
+	}
+
+	return urls[0], nil
+}
+
 // getRemotesInternal is the internal implementation without caching logic
 func (r *Repository) getRemotesInternal() ([]string, error) {
 	remotes, err := r.repo.Remotes()
@@ -325,16 +543,117 @@ func (r *Repository) InvalidateCache() {
 	defer r.cache.mu.Unlock()
 	r.cache.cacheValid = false
 	r.cache.batchedData = nil
+	r.cache.blameResults = nil
+}
+
+// CreateBranch creates a new branch from the current HEAD and checks it out.
+func (r *Repository) CreateBranch(name string) error {
+	head, err := r.repo.Head()
+	if err != nil {
+		return fmt.Errorf(failedToGetHeadError+" %w", err)
+	}
+
+	branchRef := plumbing.NewBranchReferenceName(name)
+	ref := plumbing.NewHashReference(branchRef, head.Hash())
+	if err := r.repo.Storer.SetReference(ref); err != nil {
+		return fmt.Errorf("failed to create branch %s: %w", name, err)
+	}
+
+	if err := r.workTree.Checkout(&gogit.CheckoutOptions{Branch: branchRef}); err != nil {
+		return fmt.Errorf("failed to checkout branch %s: %w", name, err)
+	}
+
+	r.InvalidateCache()
+	return nil
+}
+
+// CommitAll stages all changes in the worktree and commits them using the
+// author identity configured in the repository's Git config.
+func (r *Repository) CommitAll(message string) (plumbing.Hash, error) {
+	if _, err := r.workTree.Add("."); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to stage changes: %w", err)
+	}
+
+	author, err := r.signature()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	hash, err := r.workTree.Commit(message, &gogit.CommitOptions{Author: author})
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to commit changes: %w", err)
+	}
+
+	r.InvalidateCache()
+	return hash, nil
+}
+
+// signature builds a commit author/signature from the repository's local Git config.
+func (r *Repository) signature() (*object.Signature, error) {
+	cfg, err := r.repo.Config()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read git config: %w", err)
+	}
+
+	name := cfg.User.Name
+	email := cfg.User.Email
+	if name == "" {
+		name = "githealthchecker"
+	}
+	if email == "" {
+		email = "githealthchecker@localhost"
+	}
+
+	return &object.Signature{
+		Name:  name,
+		Email: email,
+		When:  time.Now(),
+	}, nil
+}
+
+// PushBranch pushes the named branch to the given remote.
+func (r *Repository) PushBranch(remoteName, branch string) error {
+	branchRef := plumbing.NewBranchReferenceName(branch)
+	refSpec := config.RefSpec(fmt.Sprintf("%s:%s", branchRef, branchRef))
+
+	err := r.repo.Push(&gogit.PushOptions{
+		RemoteName: remoteName,
+		RefSpecs:   []config.RefSpec{refSpec},
+	})
+	if err != nil && !errors.Is(err, gogit.NoErrAlreadyUpToDate) {
+		return fmt.Errorf("failed to push branch %s to remote %s: %w", branch, remoteName, err)
+	}
+
+	return nil
+}
+
+// LargeFile is one oversized path found by GetLargeFiles, annotated with
+// whether HEAD stores it as a Git-LFS pointer rather than the real blob, so
+// callers can avoid double-counting an LFS-managed binary (whose pointer
+// file is ~130 bytes) as a raw file that blew past the size threshold.
+type LargeFile struct {
+	Path         string
+	IsLFSPointer bool
+}
+
+func (r *Repository) GetLargeFiles(minSizeBytes int64) ([]LargeFile, error) {
+	return r.GetLargeFilesCtx(context.Background(), minSizeBytes)
 }
 
-func (r *Repository) GetLargeFiles(minSizeBytes int64) ([]string, error) {
-	var largeFiles []string
+// GetLargeFilesCtx is GetLargeFiles with cancellation: ctx is checked on
+// every file visited, so walking a large monorepo can be aborted.
+func (r *Repository) GetLargeFilesCtx(ctx context.Context, minSizeBytes int64) ([]LargeFile, error) {
+	var largeFiles []LargeFile
 
 	err := filepath.Walk(r.path, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("large file scan canceled: %w", err)
+		}
+
 		if strings.Contains(path, ".git") {
 			if info.IsDir() {
 				return filepath.SkipDir
@@ -347,7 +666,12 @@ func (r *Repository) GetLargeFiles(minSizeBytes int64) ([]string, error) {
 			if err != nil {
 				return err
 			}
-			largeFiles = append(largeFiles, relPath)
+
+			isPointer, _, err := r.IsLFSPointer(relPath)
+			if err != nil {
+				isPointer = false
+			}
+			largeFiles = append(largeFiles, LargeFile{Path: relPath, IsLFSPointer: isPointer})
 		}
 
 		return nil
@@ -359,3 +683,187 @@ func (r *Repository) GetLargeFiles(minSizeBytes int64) ([]string, error) {
 
 	return largeFiles, nil
 }
+
+// lfsPointerHeader is the first line every Git-LFS pointer file starts
+// with, per the spec at https://github.com/git-lfs/git-lfs/blob/main/docs/spec.md.
+const lfsPointerHeader = "version https://git-lfs.github.com/spec/v1"
+
+// lfsPointerMaxBytes caps how much of a blob IsLFSPointer reads: real LFS
+// pointer files are ~130 bytes, so anything bigger is read just far enough
+// to rule out the header without pulling a multi-gigabyte blob into memory.
+const lfsPointerMaxBytes = 1024
+
+// IsLFSPointer reports whether the blob at path in HEAD's tree is a
+// Git-LFS pointer file - a small text blob carrying the LFS spec header
+// plus "oid sha256:" and "size" lines - rather than the real binary
+// content LFS stores out-of-band. It returns the blob's hash regardless of
+// the outcome, so callers can tell "not a pointer" apart from "path not
+// found in HEAD".
+func (r *Repository) IsLFSPointer(path string) (bool, plumbing.Hash, error) {
+	head, err := r.repo.Head()
+	if err != nil {
+		return false, plumbing.ZeroHash, fmt.Errorf(failedToGetHeadError+" %w", err)
+	}
+
+	commit, err := r.repo.CommitObject(head.Hash())
+	if err != nil {
+		return false, plumbing.ZeroHash, fmt.Errorf("failed to get HEAD commit: %w", err)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return false, plumbing.ZeroHash, fmt.Errorf("failed to get commit tree: %w", err)
+	}
+
+	entry, err := tree.FindEntry(path)
+	if err != nil {
+		return false, plumbing.ZeroHash, fmt.Errorf("failed to find %s in HEAD tree: %w", path, err)
+	}
+
+	blob, err := r.repo.BlobObject(entry.Hash)
+	if err != nil {
+		return false, entry.Hash, fmt.Errorf("failed to get blob for %s: %w", path, err)
+	}
+
+	reader, err := blob.Reader()
+	if err != nil {
+		return false, entry.Hash, fmt.Errorf("failed to read blob for %s: %w", path, err)
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(io.LimitReader(reader, lfsPointerMaxBytes))
+	if err != nil {
+		return false, entry.Hash, fmt.Errorf("failed to read blob content for %s: %w", path, err)
+	}
+
+	return isLFSPointerContent(content), entry.Hash, nil
+}
+
+// isLFSPointerContent checks a blob's leading bytes against the pointer
+// spec rather than parsing every line, since the header is what
+// distinguishes a pointer from arbitrary text content of similar size.
+func isLFSPointerContent(content []byte) bool {
+	text := string(content)
+	if !strings.HasPrefix(text, lfsPointerHeader) {
+		return false
+	}
+	return strings.Contains(text, "\noid sha256:") && strings.Contains(text, "\nsize ")
+}
+
+// ParseGitAttributesLFS reads .gitattributes at the repository root and
+// returns the set of patterns marked "filter=lfs", so callers can tell
+// which paths are expected to be stored via Git LFS without re-parsing the
+// file themselves. A missing .gitattributes is not an error: it simply
+// means no path is LFS-tracked.
+func (r *Repository) ParseGitAttributesLFS() (map[string]bool, error) {
+	data, err := os.ReadFile(filepath.Join(r.path, ".gitattributes"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]bool{}, nil
+		}
+		return nil, fmt.Errorf("failed to read .gitattributes: %w", err)
+	}
+
+	patterns := make(map[string]bool)
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		for _, attr := range fields[1:] {
+			if attr == "filter=lfs" {
+				patterns[fields[0]] = true
+				break
+			}
+		}
+	}
+
+	return patterns, nil
+}
+
+// BlameInfo attributes a single line to whoever last touched it, as
+// reported by git blame.
+type BlameInfo struct {
+	Author       string
+	AuthorEmail  string
+	LastModified time.Time
+	CommitHash   string
+}
+
+// BlameLine returns blame attribution for the given 1-based line of path as
+// of HEAD. It returns (nil, nil) when path doesn't exist in HEAD's tree
+// (e.g. a file that's new or only present as an uncommitted change), since
+// there's no history yet to attribute the line to.
+func (r *Repository) BlameLine(path string, line int) (*BlameInfo, error) {
+	head, err := r.repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf(failedToGetHeadError+" %w", err)
+	}
+
+	commit, err := r.repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get HEAD commit: %w", err)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit tree: %w", err)
+	}
+
+	if _, err := tree.FindEntry(path); err != nil {
+		return nil, nil
+	}
+
+	result, err := r.blameFile(commit, head.Hash(), path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to blame %s: %w", path, err)
+	}
+
+	if line < 1 || line > len(result.Lines) {
+		return nil, fmt.Errorf("line %d out of range for %s (%d lines)", line, path, len(result.Lines))
+	}
+
+	blamedLine := result.Lines[line-1]
+
+	authorEmail := ""
+	if authorCommit, err := r.repo.CommitObject(blamedLine.Hash); err == nil {
+		authorEmail = authorCommit.Author.Email
+	}
+
+	return &BlameInfo{
+		Author:       blamedLine.Author,
+		AuthorEmail:  authorEmail,
+		LastModified: blamedLine.Date,
+		CommitHash:   blamedLine.Hash.String(),
+	}, nil
+}
+
+// blameFile returns the cached BlameResult for path at headHash, computing
+// it on first use: gogit.Blame walks every commit that touched the file, so
+// it's cached per (HEAD hash, path) rather than re-run for every blamed line.
+func (r *Repository) blameFile(commit *object.Commit, headHash plumbing.Hash, path string) (*gogit.BlameResult, error) {
+	key := headHash.String() + ":" + path
+
+	r.cache.mu.RLock()
+	cached, ok := r.cache.blameResults[key]
+	r.cache.mu.RUnlock()
+	if ok {
+		return cached, nil
+	}
+
+	result, err := gogit.Blame(commit, path)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.mu.Lock()
+	if r.cache.blameResults == nil {
+		r.cache.blameResults = make(map[string]*gogit.BlameResult)
+	}
+	r.cache.blameResults[key] = result
+	r.cache.mu.Unlock()
+
+	return result, nil
+}
@@ -0,0 +1,189 @@
+package git
+
+import (
+	"fmt"
+	iofs "io/fs"
+	"path"
+	"sort"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// TreeFS returns an fs.FS view of the repository's tree at hash, letting
+// callers read (and fs.WalkDir) a specific commit's files without checking
+// it out - e.g. scanner.NewFileScannerFS, to scan a historical revision.
+func (r *Repository) TreeFS(hash plumbing.Hash) (iofs.FS, error) {
+	commit, err := r.repo.CommitObject(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit %s: %w", hash, err)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tree for commit %s: %w", hash, err)
+	}
+
+	return &treeFS{repo: r.repo, tree: tree}, nil
+}
+
+// treeFS implements iofs.FS (and iofs.ReadDirFS, for fs.WalkDir) over a
+// single go-git *object.Tree, without checking the tree out to disk.
+type treeFS struct {
+	repo *gogit.Repository
+	tree *object.Tree
+}
+
+func (t *treeFS) Open(name string) (iofs.File, error) {
+	if !iofs.ValidPath(name) {
+		return nil, &iofs.PathError{Op: "open", Path: name, Err: iofs.ErrInvalid}
+	}
+	if name == "." {
+		return &treeDir{name: "."}, nil
+	}
+
+	entry, err := t.tree.FindEntry(name)
+	if err != nil {
+		return nil, &iofs.PathError{Op: "open", Path: name, Err: iofs.ErrNotExist}
+	}
+
+	if entry.Mode == filemode.Dir {
+		return &treeDir{name: path.Base(name)}, nil
+	}
+
+	blob, err := t.repo.BlobObject(entry.Hash)
+	if err != nil {
+		return nil, &iofs.PathError{Op: "open", Path: name, Err: err}
+	}
+
+	return &treeFile{name: path.Base(name), blob: blob}, nil
+}
+
+// ReadDir implements iofs.ReadDirFS, so fs.WalkDir lists a tree's entries
+// directly instead of falling back to Open plus a ReadDirFile type assertion.
+func (t *treeFS) ReadDir(name string) ([]iofs.DirEntry, error) {
+	subtree := t.tree
+	if name != "." {
+		var err error
+		subtree, err = t.tree.Tree(name)
+		if err != nil {
+			return nil, &iofs.PathError{Op: "readdir", Path: name, Err: iofs.ErrNotExist}
+		}
+	}
+
+	entries := make([]iofs.DirEntry, 0, len(subtree.Entries))
+	for _, e := range subtree.Entries {
+		entries = append(entries, treeDirEntry{repo: t.repo, entry: e})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return entries, nil
+}
+
+// treeDirEntry adapts one object.TreeEntry to iofs.DirEntry.
+type treeDirEntry struct {
+	repo  *gogit.Repository
+	entry object.TreeEntry
+}
+
+func (e treeDirEntry) Name() string { return e.entry.Name }
+func (e treeDirEntry) IsDir() bool  { return e.entry.Mode == filemode.Dir }
+
+func (e treeDirEntry) Type() iofs.FileMode {
+	if e.IsDir() {
+		return iofs.ModeDir
+	}
+	return 0
+}
+
+func (e treeDirEntry) Info() (iofs.FileInfo, error) {
+	if e.IsDir() {
+		return treeFileInfo{name: e.entry.Name, isDir: true}, nil
+	}
+
+	blob, err := e.repo.BlobObject(e.entry.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blob for %s: %w", e.entry.Name, err)
+	}
+	return treeFileInfo{name: e.entry.Name, size: blob.Size}, nil
+}
+
+// treeFile implements iofs.File over a go-git blob, opening the blob's
+// reader lazily so a WalkDir pass that only stats entries never reads blob
+// content it doesn't need.
+type treeFile struct {
+	name string
+	blob *object.Blob
+	r    plumbingReader
+}
+
+// plumbingReader is the subset of object.Blob.Reader's return value treeFile
+// needs, named so the field above doesn't have to spell out the go-git
+// plumbing import just for an io.ReadCloser.
+type plumbingReader = interface {
+	Read(p []byte) (int, error)
+	Close() error
+}
+
+func (f *treeFile) Stat() (iofs.FileInfo, error) {
+	return treeFileInfo{name: f.name, size: f.blob.Size}, nil
+}
+
+func (f *treeFile) Read(p []byte) (int, error) {
+	if f.r == nil {
+		r, err := f.blob.Reader()
+		if err != nil {
+			return 0, err
+		}
+		f.r = r
+	}
+	return f.r.Read(p)
+}
+
+func (f *treeFile) Close() error {
+	if f.r == nil {
+		return nil
+	}
+	return f.r.Close()
+}
+
+// treeDir implements iofs.File for a directory entry; its content is
+// listed via treeFS.ReadDir rather than Read.
+type treeDir struct {
+	name string
+}
+
+func (d *treeDir) Stat() (iofs.FileInfo, error) {
+	return treeFileInfo{name: d.name, isDir: true}, nil
+}
+
+func (d *treeDir) Read([]byte) (int, error) {
+	return 0, &iofs.PathError{Op: "read", Path: d.name, Err: iofs.ErrInvalid}
+}
+
+func (d *treeDir) Close() error { return nil }
+
+// treeFileInfo implements iofs.FileInfo. Git trees don't record mtimes, so
+// ModTime is always zero; callers that need a revision's commit time should
+// use Repository.CommitObject instead.
+type treeFileInfo struct {
+	name  string
+	size  int64
+	isDir bool
+}
+
+func (i treeFileInfo) Name() string { return i.name }
+func (i treeFileInfo) Size() int64  { return i.size }
+
+func (i treeFileInfo) Mode() iofs.FileMode {
+	if i.isDir {
+		return iofs.ModeDir
+	}
+	return 0
+}
+
+func (i treeFileInfo) ModTime() time.Time { return time.Time{} }
+func (i treeFileInfo) IsDir() bool        { return i.isDir }
+func (i treeFileInfo) Sys() any           { return nil }
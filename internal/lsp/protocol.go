@@ -0,0 +1,156 @@
+// Package lsp implements just enough of the Language Server Protocol to
+// drive githealthchecker's analyzer pipeline from an editor: a stdio
+// JSON-RPC transport, diagnostics published per text document, and code
+// actions surfaced from the existing report.Issue.Fix strings.
+package lsp
+
+import (
+	"encoding/json"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+// DiagnosticSeverity mirrors the LSP DiagnosticSeverity enum.
+type DiagnosticSeverity int
+
+const (
+	SeverityError       DiagnosticSeverity = 1
+	SeverityWarning     DiagnosticSeverity = 2
+	SeverityInformation DiagnosticSeverity = 3
+	SeverityHint        DiagnosticSeverity = 4
+)
+
+// severityFromIssue maps our four-level Severity scale onto LSP's scale.
+func severityFromIssue(severity report.Severity) DiagnosticSeverity {
+	switch severity {
+	case report.SeverityCritical, report.SeverityHigh:
+		return SeverityError
+	case report.SeverityMedium:
+		return SeverityWarning
+	default:
+		return SeverityInformation
+	}
+}
+
+type Position struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type Range struct {
+	Start Position `json:"start"`
+	End   Position `json:"end"`
+}
+
+// DiagnosticData carries the fields a Diagnostic doesn't otherwise have
+// room for but a later textDocument/codeAction request needs back, namely
+// the issue's suggested Fix text.
+type DiagnosticData struct {
+	Fix string `json:"fix,omitempty"`
+}
+
+type Diagnostic struct {
+	Range    Range              `json:"range"`
+	Severity DiagnosticSeverity `json:"severity"`
+	Code     string             `json:"code,omitempty"`
+	Source   string             `json:"source,omitempty"`
+	Message  string             `json:"message"`
+	Data     *DiagnosticData    `json:"data,omitempty"`
+}
+
+// diagnosticFromIssue converts a report.Issue into an LSP Diagnostic. Line
+// and Column are 1-based in report.Issue (0 when an analyzer didn't
+// attribute a specific location); LSP positions are 0-based, so an
+// unattributed issue is anchored to the top of the file.
+func diagnosticFromIssue(issue report.Issue) Diagnostic {
+	line := issue.Line - 1
+	if line < 0 {
+		line = 0
+	}
+	column := issue.Column - 1
+	if column < 0 {
+		column = 0
+	}
+
+	pos := Position{Line: line, Character: column}
+	diagnostic := Diagnostic{
+		Range:    Range{Start: pos, End: pos},
+		Severity: severityFromIssue(issue.Severity),
+		Code:     issue.Rule,
+		Source:   string(issue.Category),
+		Message:  issue.Title + ": " + issue.Description,
+	}
+
+	if issue.Fix != "" {
+		diagnostic.Data = &DiagnosticData{Fix: issue.Fix}
+	}
+
+	return diagnostic
+}
+
+type PublishDiagnosticsParams struct {
+	URI         string       `json:"uri"`
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+type TextDocumentIdentifier struct {
+	URI string `json:"uri"`
+}
+
+type DidSaveTextDocumentParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+type DidOpenTextDocumentParams struct {
+	TextDocument TextDocumentItem `json:"textDocument"`
+}
+
+type TextDocumentItem struct {
+	URI string `json:"uri"`
+}
+
+type InitializeParams struct {
+	RootURI  string `json:"rootUri"`
+	RootPath string `json:"rootPath"`
+}
+
+type InitializeResult struct {
+	Capabilities ServerCapabilities `json:"capabilities"`
+}
+
+type ServerCapabilities struct {
+	TextDocumentSync   int  `json:"textDocumentSync"`
+	CodeActionProvider bool `json:"codeActionProvider"`
+}
+
+type CodeActionParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+	Range        Range                  `json:"range"`
+	Context      CodeActionContext      `json:"context"`
+}
+
+type CodeActionContext struct {
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+type CodeAction struct {
+	Title       string       `json:"title"`
+	Kind        string       `json:"kind"`
+	Diagnostics []Diagnostic `json:"diagnostics,omitempty"`
+}
+
+// rpcMessage is the wire shape shared by requests, responses and
+// notifications; ID is nil for notifications.
+type rpcMessage struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
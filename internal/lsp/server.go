@@ -0,0 +1,328 @@
+package lsp
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+// AnalyzeFunc runs the full health-check pipeline against rootPath and
+// returns every issue found. The server re-derives per-URI diagnostics from
+// this on every initialize/didSave, the same way runAnalyses does for the
+// `check` command.
+type AnalyzeFunc func(rootPath string) (*report.Report, error)
+
+// Server is a minimal LSP server communicating over stdio. It tracks the
+// last-published diagnostic set per (URI, category) so unchanged results
+// aren't re-sent, mirroring gopls' diagnostic-report deduplication.
+type Server struct {
+	reader *bufio.Reader
+	writer io.Writer
+
+	rootPath string
+	analyze  AnalyzeFunc
+
+	// published holds a hash of the last diagnostics array sent for a given
+	// (URI, category) pair, so an unchanged result isn't re-published.
+	published map[string]map[report.Category][32]byte
+}
+
+// NewServer returns a Server reading LSP frames from in and writing
+// responses/notifications to out (typically os.Stdin/os.Stdout).
+func NewServer(in io.Reader, out io.Writer, analyze AnalyzeFunc) *Server {
+	return &Server{
+		reader:    bufio.NewReader(in),
+		writer:    out,
+		analyze:   analyze,
+		published: make(map[string]map[report.Category][32]byte),
+	}
+}
+
+// Serve reads and dispatches messages until the client sends "exit" or the
+// input stream closes.
+func (s *Server) Serve() error {
+	for {
+		msg, err := s.readMessage()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("failed to read LSP message: %w", err)
+		}
+
+		if msg.Method == "exit" {
+			return nil
+		}
+
+		if err := s.dispatch(msg); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) dispatch(msg rpcMessage) error {
+	switch msg.Method {
+	case "initialize":
+		return s.handleInitialize(msg)
+	case "initialized", "shutdown":
+		return s.respondEmpty(msg)
+	case "textDocument/didOpen", "textDocument/didSave":
+		return s.handleDocumentChanged()
+	case "textDocument/codeAction":
+		return s.handleCodeAction(msg)
+	default:
+		// Unknown notifications/requests are ignored; an unknown request
+		// still gets an empty response so the client doesn't hang on it.
+		if len(msg.ID) > 0 {
+			return s.respondEmpty(msg)
+		}
+		return nil
+	}
+}
+
+func (s *Server) handleInitialize(msg rpcMessage) error {
+	var params InitializeParams
+	_ = json.Unmarshal(msg.Params, &params)
+
+	s.rootPath = params.RootPath
+	if s.rootPath == "" {
+		s.rootPath = uriToPath(params.RootURI)
+	}
+
+	result := InitializeResult{
+		Capabilities: ServerCapabilities{
+			TextDocumentSync:   1, // full document sync
+			CodeActionProvider: true,
+		},
+	}
+
+	if err := s.respond(msg.ID, result); err != nil {
+		return err
+	}
+
+	return s.publishWorkspaceDiagnostics()
+}
+
+func (s *Server) handleDocumentChanged() error {
+	return s.publishWorkspaceDiagnostics()
+}
+
+// publishWorkspaceDiagnostics runs the full analyzer pipeline and publishes
+// the resulting diagnostics per URI, then publishes an empty diagnostics
+// list for any URI that previously had findings but no longer does.
+func (s *Server) publishWorkspaceDiagnostics() error {
+	healthReport, err := s.analyze(s.rootPath)
+	if err != nil {
+		return fmt.Errorf("analysis failed: %w", err)
+	}
+
+	byURI := make(map[string][]report.Issue)
+	for _, issue := range healthReport.Issues {
+		if issue.File == "" {
+			continue
+		}
+		uri := s.pathToURI(issue.File)
+		byURI[uri] = append(byURI[uri], issue)
+	}
+
+	for uri, issues := range byURI {
+		if err := s.publishForURI(uri, issues); err != nil {
+			return err
+		}
+	}
+
+	for uri := range s.published {
+		if _, stillHasIssues := byURI[uri]; !stillHasIssues {
+			if err := s.publishForURI(uri, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// publishForURI groups issues by category, diffs each group's hash against
+// what was last published for that (URI, category), and sends a single
+// publishDiagnostics notification carrying every still-relevant category.
+func (s *Server) publishForURI(uri string, issues []report.Issue) error {
+	byCategory := make(map[report.Category][]report.Issue)
+	for _, issue := range issues {
+		byCategory[issue.Category] = append(byCategory[issue.Category], issue)
+	}
+
+	if s.published[uri] == nil {
+		s.published[uri] = make(map[report.Category][32]byte)
+	}
+
+	changed := false
+	var diagnostics []Diagnostic
+	seenCategories := make(map[report.Category]bool)
+
+	for category, categoryIssues := range byCategory {
+		seenCategories[category] = true
+		hash := hashIssues(categoryIssues)
+		if s.published[uri][category] != hash {
+			changed = true
+		}
+		s.published[uri][category] = hash
+
+		for _, issue := range categoryIssues {
+			diagnostics = append(diagnostics, diagnosticFromIssue(issue))
+		}
+	}
+
+	for category := range s.published[uri] {
+		if !seenCategories[category] {
+			delete(s.published[uri], category)
+			changed = true
+		}
+	}
+
+	if len(s.published[uri]) == 0 {
+		delete(s.published, uri)
+	}
+
+	if !changed {
+		return nil
+	}
+
+	if diagnostics == nil {
+		diagnostics = []Diagnostic{}
+	}
+
+	return s.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: diagnostics,
+	})
+}
+
+func hashIssues(issues []report.Issue) [32]byte {
+	var builder strings.Builder
+	for _, issue := range issues {
+		builder.WriteString(issue.ID)
+		builder.WriteByte('\x00')
+		builder.WriteString(string(issue.Severity))
+		builder.WriteByte('\x00')
+		builder.WriteString(issue.Fix)
+		builder.WriteByte('\x01')
+	}
+	return sha256.Sum256([]byte(builder.String()))
+}
+
+// handleCodeAction surfaces each diagnostic's originating issue's Fix text
+// as a quick-fix code action; editors show these in response to a
+// textDocument/codeAction request scoped to the cursor's range.
+func (s *Server) handleCodeAction(msg rpcMessage) error {
+	var params CodeActionParams
+	_ = json.Unmarshal(msg.Params, &params)
+
+	actions := make([]CodeAction, 0, len(params.Context.Diagnostics))
+	for _, diag := range params.Context.Diagnostics {
+		title := diag.Message
+		if diag.Data != nil && diag.Data.Fix != "" {
+			title = diag.Data.Fix
+		}
+		actions = append(actions, CodeAction{
+			Title:       title,
+			Kind:        "quickfix",
+			Diagnostics: []Diagnostic{diag},
+		})
+	}
+
+	return s.respond(msg.ID, actions)
+}
+
+func (s *Server) respondEmpty(msg rpcMessage) error {
+	if len(msg.ID) == 0 {
+		return nil
+	}
+	return s.respond(msg.ID, nil)
+}
+
+func (s *Server) respond(id json.RawMessage, result interface{}) error {
+	return s.write(rpcMessage{JSONRPC: "2.0", ID: id, Result: result})
+}
+
+func (s *Server) notify(method string, params interface{}) error {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s params: %w", method, err)
+	}
+	return s.write(rpcMessage{JSONRPC: "2.0", Method: method, Params: body})
+}
+
+func (s *Server) write(msg rpcMessage) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal LSP message: %w", err)
+	}
+
+	if _, err := fmt.Fprintf(s.writer, "Content-Length: %d\r\n\r\n", len(body)); err != nil {
+		return err
+	}
+	_, err = s.writer.Write(body)
+	return err
+}
+
+func (s *Server) readMessage() (rpcMessage, error) {
+	contentLength := -1
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			return rpcMessage{}, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			break
+		}
+		if strings.HasPrefix(line, "Content-Length:") {
+			n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "Content-Length:")))
+			if err != nil {
+				return rpcMessage{}, fmt.Errorf("invalid Content-Length header %q: %w", line, err)
+			}
+			contentLength = n
+		}
+	}
+
+	if contentLength < 0 {
+		return rpcMessage{}, fmt.Errorf("LSP frame missing Content-Length header")
+	}
+
+	body := make([]byte, contentLength)
+	if _, err := io.ReadFull(s.reader, body); err != nil {
+		return rpcMessage{}, err
+	}
+
+	var msg rpcMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return rpcMessage{}, fmt.Errorf("failed to parse LSP message: %w", err)
+	}
+
+	return msg, nil
+}
+
+// pathToURI turns a repo-relative file path (as report.Issue.File holds it)
+// into an absolute file:// URI under the workspace root.
+func (s *Server) pathToURI(path string) string {
+	if filepath.IsAbs(path) {
+		return "file://" + filepath.ToSlash(path)
+	}
+	return "file://" + filepath.ToSlash(filepath.Join(s.rootPath, path))
+}
+
+func uriToPath(uri string) string {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return strings.TrimPrefix(uri, "file://")
+	}
+	return parsed.Path
+}
@@ -0,0 +1,134 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+func writeFrame(t *testing.T, buf *bytes.Buffer, method string, id string, params interface{}) {
+	t.Helper()
+
+	body, err := json.Marshal(params)
+	if err != nil {
+		t.Fatalf("failed to marshal params: %v", err)
+	}
+
+	msg := map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": json.RawMessage(body)}
+	if id != "" {
+		msg["id"] = json.RawMessage(id)
+	}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatalf("failed to marshal message: %v", err)
+	}
+
+	fmt.Fprintf(buf, "Content-Length: %d\r\n\r\n%s", len(payload), payload)
+}
+
+func readFrames(t *testing.T, r *bufio.Reader) []rpcMessage {
+	t.Helper()
+
+	var messages []rpcMessage
+	for {
+		msg, err := (&Server{reader: r}).readMessage()
+		if err != nil {
+			break
+		}
+		messages = append(messages, msg)
+	}
+	return messages
+}
+
+func TestServerInitializeAndDidSave(t *testing.T) {
+	var input bytes.Buffer
+	writeFrame(t, &input, "initialize", "1", InitializeParams{RootPath: "/repo"})
+	writeFrame(t, &input, "textDocument/didSave", "", DidSaveTextDocumentParams{TextDocument: TextDocumentIdentifier{URI: "file:///repo/main.go"}})
+	writeFrame(t, &input, "exit", "", nil)
+
+	calls := 0
+	analyze := func(rootPath string) (*report.Report, error) {
+		calls++
+		if rootPath != "/repo" {
+			t.Errorf("expected rootPath /repo, got %s", rootPath)
+		}
+		return &report.Report{
+			Issues: []report.Issue{
+				{ID: "x", File: "main.go", Category: report.CategoryQuality, Severity: report.SeverityHigh, Title: "t", Description: "d"},
+			},
+		}, nil
+	}
+
+	var output bytes.Buffer
+	server := NewServer(&input, &output, analyze)
+
+	if err := server.Serve(); err != nil {
+		t.Fatalf("Serve() failed: %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("expected analyze to run twice (initialize + didSave), got %d", calls)
+	}
+
+	messages := readFrames(t, bufio.NewReader(&output))
+	var sawPublish bool
+	for _, msg := range messages {
+		if msg.Method == "textDocument/publishDiagnostics" {
+			sawPublish = true
+		}
+	}
+	if !sawPublish {
+		t.Error("expected at least one textDocument/publishDiagnostics notification")
+	}
+}
+
+func TestPublishForURISkipsUnchangedDiagnostics(t *testing.T) {
+	server := NewServer(strings.NewReader(""), &bytes.Buffer{}, nil)
+
+	issues := []report.Issue{
+		{ID: "x", Severity: report.SeverityHigh, Category: report.CategoryQuality, Fix: "do the thing"},
+	}
+
+	if err := server.publishForURI("file:///repo/main.go", issues); err != nil {
+		t.Fatalf("publishForURI() failed: %v", err)
+	}
+
+	var out bytes.Buffer
+	server.writer = &out
+
+	if err := server.publishForURI("file:///repo/main.go", issues); err != nil {
+		t.Fatalf("publishForURI() failed: %v", err)
+	}
+
+	if out.Len() != 0 {
+		t.Errorf("expected no re-publish for an unchanged diagnostic set, got %q", out.String())
+	}
+}
+
+func TestHandleCodeActionReturnsQuickFixes(t *testing.T) {
+	server := NewServer(strings.NewReader(""), &bytes.Buffer{}, nil)
+
+	params := CodeActionParams{
+		Context: CodeActionContext{
+			Diagnostics: []Diagnostic{{Message: "issue found", Code: "rule-1", Data: &DiagnosticData{Fix: "run go mod tidy"}}},
+		},
+	}
+	paramsJSON, _ := json.Marshal(params)
+
+	var out bytes.Buffer
+	server.writer = &out
+
+	if err := server.handleCodeAction(rpcMessage{ID: json.RawMessage(`1`), Params: paramsJSON}); err != nil {
+		t.Fatalf("handleCodeAction() failed: %v", err)
+	}
+
+	if !strings.Contains(out.String(), "run go mod tidy") {
+		t.Errorf("expected code action title to use the diagnostic's Fix text, got %q", out.String())
+	}
+}
@@ -0,0 +1,123 @@
+package progress
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// AnalyzerProgress is one update published for a single analyzer while
+// runAnalyses works through the enabled set, so a multi-analyzer renderer
+// can draw a bar (or log line) per analyzer instead of just one.
+type AnalyzerProgress struct {
+	Name         string
+	FilesScanned int
+	TotalFiles   int
+	Elapsed      time.Duration
+}
+
+// MultiRenderer consumes AnalyzerProgress events, published one per
+// analyzer start/finish, until the channel is closed.
+type MultiRenderer interface {
+	Render(events <-chan AnalyzerProgress)
+}
+
+// NewMultiRenderer returns the MultiRenderer matching mode: "tty" for a
+// redrawn multi-line display, "json" for structured progress lines
+// (useful for CI), anything else ("none") discards every event.
+func NewMultiRenderer(mode string, out io.Writer) MultiRenderer {
+	switch mode {
+	case "tty":
+		return &ttyMultiRenderer{out: out}
+	case "json":
+		return &jsonMultiRenderer{out: out}
+	default:
+		return noopMultiRenderer{}
+	}
+}
+
+// IsTerminal reports whether f is attached to a character device, the same
+// check report.isTerminal uses for os.Stdout, exported here so callers can
+// resolve a "--progress=auto" flag against an arbitrary stream.
+func IsTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+type noopMultiRenderer struct{}
+
+func (noopMultiRenderer) Render(events <-chan AnalyzerProgress) {
+	for range events {
+	}
+}
+
+// ttyMultiRenderer redraws one line per analyzer seen so far, moving the
+// cursor back up over its previous lines before reprinting, so multiple
+// analyzers' progress stays visible as a small multi-bar block instead of
+// scrolling the terminal.
+type ttyMultiRenderer struct {
+	out   io.Writer
+	order []string
+	last  map[string]AnalyzerProgress
+}
+
+func (r *ttyMultiRenderer) Render(events <-chan AnalyzerProgress) {
+	r.last = make(map[string]AnalyzerProgress)
+
+	for ev := range events {
+		if _, seen := r.last[ev.Name]; !seen {
+			r.order = append(r.order, ev.Name)
+		}
+		r.last[ev.Name] = ev
+		r.redraw()
+	}
+}
+
+func (r *ttyMultiRenderer) redraw() {
+	if len(r.order) > 1 {
+		fmt.Fprintf(r.out, "\033[%dA", len(r.order)-1)
+	}
+
+	for _, name := range r.order {
+		p := r.last[name]
+		fmt.Fprint(r.out, "\033[K")
+		if p.TotalFiles > 0 {
+			fmt.Fprintf(r.out, "%s: %d/%d files (%s)\n", name, p.FilesScanned, p.TotalFiles, p.Elapsed.Round(time.Millisecond))
+		} else {
+			fmt.Fprintf(r.out, "%s: running (%s)\n", name, p.Elapsed.Round(time.Millisecond))
+		}
+	}
+}
+
+// jsonMultiRenderer emits one JSON event per update, suitable for CI log
+// capture, mirroring jsonReporter's single-phase event shape.
+type jsonMultiRenderer struct {
+	out io.Writer
+}
+
+type multiProgressEvent struct {
+	Name         string `json:"name"`
+	FilesScanned int    `json:"files_scanned"`
+	TotalFiles   int    `json:"total_files"`
+	ElapsedMs    int64  `json:"elapsed_ms"`
+}
+
+func (r *jsonMultiRenderer) Render(events <-chan AnalyzerProgress) {
+	for ev := range events {
+		data, err := json.Marshal(multiProgressEvent{
+			Name:         ev.Name,
+			FilesScanned: ev.FilesScanned,
+			TotalFiles:   ev.TotalFiles,
+			ElapsedMs:    ev.Elapsed.Milliseconds(),
+		})
+		if err != nil {
+			continue
+		}
+		fmt.Fprintln(r.out, string(data))
+	}
+}
@@ -0,0 +1,100 @@
+package progress
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewMultiRenderer_SelectsByMode(t *testing.T) {
+	var buf bytes.Buffer
+
+	if _, ok := NewMultiRenderer("tty", &buf).(*ttyMultiRenderer); !ok {
+		t.Errorf("expected *ttyMultiRenderer for mode %q", "tty")
+	}
+	if _, ok := NewMultiRenderer("json", &buf).(*jsonMultiRenderer); !ok {
+		t.Errorf("expected *jsonMultiRenderer for mode %q", "json")
+	}
+	if _, ok := NewMultiRenderer("none", &buf).(noopMultiRenderer); !ok {
+		t.Errorf("expected noopMultiRenderer for mode %q", "none")
+	}
+}
+
+func TestTTYMultiRenderer_RendersOneLinePerAnalyzer(t *testing.T) {
+	var buf bytes.Buffer
+	renderer := NewMultiRenderer("tty", &buf)
+
+	events := make(chan AnalyzerProgress)
+	done := make(chan struct{})
+	go func() {
+		renderer.Render(events)
+		close(done)
+	}()
+
+	events <- AnalyzerProgress{Name: "security", TotalFiles: 10}
+	events <- AnalyzerProgress{Name: "quality", TotalFiles: 10}
+	events <- AnalyzerProgress{Name: "security", FilesScanned: 10, TotalFiles: 10, Elapsed: time.Millisecond}
+	close(events)
+	<-done
+
+	output := buf.String()
+	if !strings.Contains(output, "security") || !strings.Contains(output, "quality") {
+		t.Errorf("expected output to mention both analyzers, got %q", output)
+	}
+}
+
+func TestJSONMultiRenderer_EmitsOneEventPerUpdate(t *testing.T) {
+	var buf bytes.Buffer
+	renderer := NewMultiRenderer("json", &buf)
+
+	events := make(chan AnalyzerProgress)
+	done := make(chan struct{})
+	go func() {
+		renderer.Render(events)
+		close(done)
+	}()
+
+	events <- AnalyzerProgress{Name: "security", FilesScanned: 5, TotalFiles: 10, Elapsed: 2 * time.Millisecond}
+	close(events)
+	<-done
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 JSON event, got %d: %q", len(lines), buf.String())
+	}
+
+	var event multiProgressEvent
+	if err := json.Unmarshal([]byte(lines[0]), &event); err != nil {
+		t.Fatalf("failed to unmarshal event: %v", err)
+	}
+
+	if event.Name != "security" || event.FilesScanned != 5 || event.TotalFiles != 10 || event.ElapsedMs != 2 {
+		t.Errorf("unexpected event: %+v", event)
+	}
+}
+
+func TestNoopMultiRenderer_DrainsWithoutBlocking(t *testing.T) {
+	renderer := NewMultiRenderer("none", &bytes.Buffer{})
+
+	events := make(chan AnalyzerProgress, 1)
+	events <- AnalyzerProgress{Name: "security"}
+	close(events)
+
+	renderer.Render(events)
+}
+
+func TestStartSample_ReportsNonNegativeElapsed(t *testing.T) {
+	sample := StartSample()
+	time.Sleep(time.Millisecond)
+
+	cpuMillis, _, elapsed := sample.Finish()
+
+	if cpuMillis < 0 {
+		t.Errorf("expected non-negative CPU millis, got %d", cpuMillis)
+	}
+	if elapsed <= 0 {
+		t.Errorf("expected positive elapsed duration, got %v", elapsed)
+	}
+}
@@ -0,0 +1,150 @@
+// Package progress reports phase/done/total progress for long-running
+// analyzers and fix operations, so large repositories don't look hung during
+// a file walk or a batch of dependency updates.
+package progress
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Reporter receives progress updates for a single phase at a time. Callers
+// start a phase with a known (or estimated) total, call Increment once per
+// unit of work completed, and call Finish when the phase ends.
+type Reporter interface {
+	Start(phase string, total int)
+	Increment()
+	Finish()
+}
+
+// NewReporter returns the Reporter matching format ("json" for machine-
+// readable events, anything else for the interactive TTY bar).
+func NewReporter(format string, out io.Writer) Reporter {
+	if format == "json" {
+		return NewJSONReporter(out)
+	}
+	return NewTTYReporter(out)
+}
+
+// NewNoop returns a Reporter that discards all updates, used as the default
+// for analyzers that aren't run from a context wired up for progress output.
+func NewNoop() Reporter {
+	return noopReporter{}
+}
+
+type noopReporter struct{}
+
+func (noopReporter) Start(string, int) {}
+func (noopReporter) Increment()        {}
+func (noopReporter) Finish()           {}
+
+// ttyReporter renders an inline, carriage-return-updated progress bar with
+// an ETA estimated from the average time per completed unit.
+type ttyReporter struct {
+	out     io.Writer
+	phase   string
+	total   int
+	done    int
+	started time.Time
+}
+
+func NewTTYReporter(out io.Writer) Reporter {
+	return &ttyReporter{out: out}
+}
+
+func (r *ttyReporter) Start(phase string, total int) {
+	r.phase = phase
+	r.total = total
+	r.done = 0
+	r.started = time.Now()
+	r.render()
+}
+
+func (r *ttyReporter) Increment() {
+	r.done++
+	r.render()
+}
+
+func (r *ttyReporter) render() {
+	if r.total <= 0 {
+		fmt.Fprintf(r.out, "\r%s: %d processed", r.phase, r.done)
+		return
+	}
+
+	pct := float64(r.done) / float64(r.total) * 100
+	eta := r.eta()
+	fmt.Fprintf(r.out, "\r%s: %d/%d (%.0f%%) ETA %s", r.phase, r.done, r.total, pct, eta.Round(time.Second))
+}
+
+func (r *ttyReporter) eta() time.Duration {
+	if r.done == 0 {
+		return 0
+	}
+	elapsed := time.Since(r.started)
+	perUnit := elapsed / time.Duration(r.done)
+	remaining := r.total - r.done
+	if remaining < 0 {
+		remaining = 0
+	}
+	return perUnit * time.Duration(remaining)
+}
+
+func (r *ttyReporter) Finish() {
+	fmt.Fprintln(r.out)
+}
+
+// jsonReporter emits one JSON event per update, suitable for CI log capture.
+type jsonReporter struct {
+	out     io.Writer
+	phase   string
+	total   int
+	done    int
+	started time.Time
+}
+
+func NewJSONReporter(out io.Writer) Reporter {
+	return &jsonReporter{out: out}
+}
+
+type jsonEvent struct {
+	Phase string `json:"phase"`
+	Done  int    `json:"done"`
+	Total int    `json:"total"`
+	ETAMs int64  `json:"eta_ms"`
+}
+
+func (r *jsonReporter) Start(phase string, total int) {
+	r.phase = phase
+	r.total = total
+	r.done = 0
+	r.started = time.Now()
+	r.emit()
+}
+
+func (r *jsonReporter) Increment() {
+	r.done++
+	r.emit()
+}
+
+func (r *jsonReporter) emit() {
+	var etaMs int64
+	if r.done > 0 && r.total > 0 {
+		elapsed := time.Since(r.started)
+		perUnit := elapsed / time.Duration(r.done)
+		remaining := r.total - r.done
+		if remaining < 0 {
+			remaining = 0
+		}
+		etaMs = (perUnit * time.Duration(remaining)).Milliseconds()
+	}
+
+	data, err := json.Marshal(jsonEvent{Phase: r.phase, Done: r.done, Total: r.total, ETAMs: etaMs})
+	if err != nil {
+		return
+	}
+	fmt.Fprintln(r.out, string(data))
+}
+
+func (r *jsonReporter) Finish() {}
@@ -0,0 +1,74 @@
+package progress
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestNewReporter_JSONFormat(t *testing.T) {
+	var buf bytes.Buffer
+	reporter := NewReporter("json", &buf)
+
+	if _, ok := reporter.(*jsonReporter); !ok {
+		t.Fatalf("expected *jsonReporter for format %q, got %T", "json", reporter)
+	}
+}
+
+func TestNewReporter_DefaultsToTTY(t *testing.T) {
+	var buf bytes.Buffer
+	reporter := NewReporter("text", &buf)
+
+	if _, ok := reporter.(*ttyReporter); !ok {
+		t.Fatalf("expected *ttyReporter for format %q, got %T", "text", reporter)
+	}
+}
+
+func TestNoopReporter_DiscardsUpdates(t *testing.T) {
+	reporter := NewNoop()
+
+	// Should not panic and should produce no observable output.
+	reporter.Start("phase", 10)
+	reporter.Increment()
+	reporter.Finish()
+}
+
+func TestTTYReporter_RendersProgress(t *testing.T) {
+	var buf bytes.Buffer
+	reporter := NewTTYReporter(&buf)
+
+	reporter.Start("scan", 2)
+	reporter.Increment()
+	reporter.Finish()
+
+	output := buf.String()
+	if !strings.Contains(output, "scan") {
+		t.Errorf("expected output to mention phase name, got %q", output)
+	}
+	if !strings.Contains(output, "1/2") {
+		t.Errorf("expected output to show 1/2 progress, got %q", output)
+	}
+}
+
+func TestJSONReporter_EmitsOneEventPerLine(t *testing.T) {
+	var buf bytes.Buffer
+	reporter := NewJSONReporter(&buf)
+
+	reporter.Start("scan", 2)
+	reporter.Increment()
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 JSON events, got %d: %q", len(lines), buf.String())
+	}
+
+	var event jsonEvent
+	if err := json.Unmarshal([]byte(lines[1]), &event); err != nil {
+		t.Fatalf("failed to unmarshal event: %v", err)
+	}
+
+	if event.Phase != "scan" || event.Done != 1 || event.Total != 2 {
+		t.Errorf("unexpected event: %+v", event)
+	}
+}
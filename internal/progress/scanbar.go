@@ -0,0 +1,106 @@
+package progress
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/githealthchecker/git-health-checker/internal/scanner"
+)
+
+// ScanReporter renders scanner.ScanProgress updates from a long-running
+// scan (ScanAllFilesCtx and friends), distinct from Reporter because a scan
+// doesn't know its total file count up front the way an analyzer phase
+// does - the bar it draws is driven by throughput (bytes/sec) rather than a
+// known total, so its ETA is necessarily absent.
+type ScanReporter interface {
+	// Watch drains ch, rendering each update, until ch is closed.
+	Watch(ch <-chan scanner.ScanProgress)
+}
+
+// NewScanReporter returns the ScanReporter matching format, mirroring
+// NewReporter's json/TTY split.
+func NewScanReporter(format string, out io.Writer) ScanReporter {
+	if format == "json" {
+		return &jsonScanReporter{out: out}
+	}
+	return &ttyScanReporter{out: out}
+}
+
+// ttyScanReporter renders an inline, carriage-return-updated line showing
+// files seen, matches, and a bytes/sec throughput figure.
+type ttyScanReporter struct {
+	out     io.Writer
+	started time.Time
+}
+
+func (r *ttyScanReporter) Watch(ch <-chan scanner.ScanProgress) {
+	r.started = time.Now()
+	last := scanner.ScanProgress{}
+	for update := range ch {
+		last = update
+		r.render(update)
+	}
+	r.render(last)
+	fmt.Fprintln(r.out)
+}
+
+func (r *ttyScanReporter) render(update scanner.ScanProgress) {
+	rate := r.bytesPerSecond(update.BytesRead)
+	fmt.Fprintf(r.out, "\rscanning: %d files (%d matched), %s, %s/s",
+		update.FilesSeen, update.FilesMatched, formatBytes(update.BytesRead), formatBytes(rate))
+}
+
+func (r *ttyScanReporter) bytesPerSecond(bytesRead int64) int64 {
+	elapsed := time.Since(r.started).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return int64(float64(bytesRead) / elapsed)
+}
+
+// formatBytes renders n using the same binary-prefix units `du -h` does,
+// since that's what a reader is used to estimating scan sizes with.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for val := n / unit; val >= unit; val /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// jsonScanReporter emits one JSON event per update, suitable for CI log
+// capture - mirroring jsonReporter's one-event-per-update design.
+type jsonScanReporter struct {
+	out io.Writer
+}
+
+type scanProgressEvent struct {
+	Phase        string `json:"phase"`
+	FilesSeen    int    `json:"files_seen"`
+	FilesMatched int    `json:"files_matched"`
+	BytesRead    int64  `json:"bytes_read"`
+	CurrentPath  string `json:"current_path"`
+}
+
+func (r *jsonScanReporter) Watch(ch <-chan scanner.ScanProgress) {
+	for update := range ch {
+		data, err := json.Marshal(scanProgressEvent{
+			Phase:        "scanning",
+			FilesSeen:    update.FilesSeen,
+			FilesMatched: update.FilesMatched,
+			BytesRead:    update.BytesRead,
+			CurrentPath:  update.CurrentPath,
+		})
+		if err != nil {
+			continue
+		}
+		fmt.Fprintln(r.out, string(data))
+	}
+}
@@ -0,0 +1,51 @@
+package progress
+
+import (
+	"runtime"
+	"syscall"
+	"time"
+)
+
+// Sample captures process resource usage immediately before an analyzer
+// runs, so Finish can diff against it the same way KICS reports "Total CPU
+// usage for <phase>" and "Total MEM usage for <phase>" per analysis phase.
+type Sample struct {
+	cpuStart   time.Duration
+	allocStart uint64
+	started    time.Time
+}
+
+// StartSample begins tracking CPU time and heap allocation for the
+// analyzer about to run.
+func StartSample() Sample {
+	return Sample{cpuStart: processCPUTime(), allocStart: heapAlloc(), started: time.Now()}
+}
+
+// Finish returns the CPU time consumed, the heap growth, and the wall-clock
+// elapsed since StartSample. Growth can be negative if the garbage
+// collector ran mid-analyzer; that's reported as zero rather than wrapping.
+func (s Sample) Finish() (cpuMillis int64, peakAllocBytes uint64, elapsed time.Duration) {
+	cpuMillis = (processCPUTime() - s.cpuStart).Milliseconds()
+	if current := heapAlloc(); current > s.allocStart {
+		peakAllocBytes = current - s.allocStart
+	}
+	elapsed = time.Since(s.started)
+	return
+}
+
+// processCPUTime returns the process's total (user+system) CPU time so
+// far. It's a process-wide reading, not per-goroutine, since Go exposes no
+// portable per-goroutine CPU accounting.
+func processCPUTime() time.Duration {
+	var usage syscall.Rusage
+	if err := syscall.Getrusage(syscall.RUSAGE_SELF, &usage); err != nil {
+		return 0
+	}
+	return time.Duration(usage.Utime.Nano() + usage.Stime.Nano())
+}
+
+func heapAlloc() uint64 {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	return mem.HeapAlloc
+}
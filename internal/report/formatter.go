@@ -4,9 +4,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/fatih/color"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
 )
 
 type langStat struct {
@@ -44,6 +47,7 @@ func (f *TableFormatter) Format(report *Report) (string, error) {
 
 	f.writeSummary(&output, &report.Summary)
 	f.writeCodeStats(&output, &report.CodeStats)
+	f.writeAnalyzerStats(&output, report.AnalyzerStats)
 
 	if len(report.Issues) > 0 {
 		output.WriteString("\nIssues Found:\n")
@@ -128,7 +132,6 @@ func (f *TableFormatter) writeCodeStats(output *strings.Builder, stats *CodeStat
 }
 
 func (f *TableFormatter) writeLanguageBreakdown(output *strings.Builder, stats *CodeStats) {
-	// Sort languages by percentage (descending)
 	var languages []langStat
 	for lang, lines := range stats.LanguageBreakdown {
 		percent := stats.LanguagePercent[lang]
@@ -138,15 +141,7 @@ func (f *TableFormatter) writeLanguageBreakdown(output *strings.Builder, stats *
 			percent: percent,
 		})
 	}
-
-	// Simple bubble sort by percentage (descending)
-	for i := 0; i < len(languages); i++ {
-		for j := 0; j < len(languages)-1-i; j++ {
-			if languages[j].percent < languages[j+1].percent {
-				languages[j], languages[j+1] = languages[j+1], languages[j]
-			}
-		}
-	}
+	sortLangStats(languages)
 
 	// Display top languages (limit to top 8 for readability)
 	maxDisplay := 8
@@ -166,6 +161,29 @@ func (f *TableFormatter) writeLanguageBreakdown(output *strings.Builder, stats *
 	}
 }
 
+// writeAnalyzerStats prints the CPU/memory/elapsed reading gathered around
+// each analyzer, the same pair of numbers KICS reports per phase as "Total
+// CPU usage for <phase>" and "Total MEM usage for <phase>".
+func (f *TableFormatter) writeAnalyzerStats(output *strings.Builder, stats []AnalyzerStats) {
+	if len(stats) == 0 {
+		return
+	}
+
+	output.WriteString("\n")
+	if f.colorize {
+		color.Set(color.FgCyan, color.Bold)
+	}
+	output.WriteString("Analyzer Performance:\n")
+	if f.colorize {
+		color.Unset()
+	}
+
+	for _, s := range stats {
+		output.WriteString(fmt.Sprintf("  %s: %dms CPU, %s peak heap growth, %dms elapsed\n",
+			s.Name, s.CPUMillis, formatBytes(s.PeakAllocBytes), s.ElapsedMillis))
+	}
+}
+
 func (f *TableFormatter) writeIssuesTable(output *strings.Builder, issues []Issue) {
 	for i, issue := range issues {
 		if i > 0 {
@@ -190,7 +208,13 @@ func (f *TableFormatter) writeIssuesTable(output *strings.Builder, issues []Issu
 		// Write issue in a readable format
 		output.WriteString(fmt.Sprintf("  [%s] %s (%s)\n", severity, file, issue.Category))
 		output.WriteString(fmt.Sprintf("    Issue: %s\n", issue.Description))
+		if issue.Rationale != "" {
+			output.WriteString(fmt.Sprintf("    Why:   %s\n", issue.Rationale))
+		}
 		output.WriteString(fmt.Sprintf("    Fix:   %s\n", issue.Fix))
+		if issue.FixGuidance != nil {
+			output.WriteString(fmt.Sprintf("    Effort: %s\n", issue.FixGuidance.Effort))
+		}
 	}
 }
 
@@ -216,6 +240,217 @@ func (f *TableFormatter) truncateString(s string, maxLen int) string {
 	return s[:maxLen-3] + "..."
 }
 
+// FriendlyFormatter renders issues grouped by rule with a revive-style
+// ✘/⚠ header per finding, followed by a per-rule statistics table so users
+// can see which rules are firing most without scanning the full issue list.
+type FriendlyFormatter struct {
+	colorize bool
+}
+
+func NewFriendlyFormatter(colorize bool) *FriendlyFormatter {
+	return &FriendlyFormatter{colorize: colorize}
+}
+
+// ruleStat tallies how often a single rule fired and at what severity, for
+// the summary table at the end of the friendly report.
+type ruleStat struct {
+	rule     string
+	category Category
+	severity Severity
+	count    int
+}
+
+func (f *FriendlyFormatter) Format(report *Report) (string, error) {
+	var output strings.Builder
+
+	if f.colorize {
+		color.Set(color.FgCyan, color.Bold)
+	}
+	output.WriteString(fmt.Sprintf("Git Health Report - %s\n", report.Repository))
+	output.WriteString(fmt.Sprintf("Branch: %s | Commit: %s\n\n", report.Branch, report.CommitHash[:8]))
+	if f.colorize {
+		color.Unset()
+	}
+
+	if len(report.Issues) == 0 {
+		if f.colorize {
+			color.Set(color.FgGreen, color.Bold)
+		}
+		output.WriteString("✅ No issues found! Repository is healthy.\n")
+		if f.colorize {
+			color.Unset()
+		}
+		return output.String(), nil
+	}
+
+	f.writeGroupedIssues(&output, report.Issues)
+	f.writeRuleStatsTable(&output, report.Issues)
+
+	return output.String(), nil
+}
+
+func (f *FriendlyFormatter) writeGroupedIssues(output *strings.Builder, issues []Issue) {
+	grouped := make(map[string][]Issue)
+	var ruleOrder []string
+	for _, issue := range issues {
+		rule := f.ruleKey(issue)
+		if _, seen := grouped[rule]; !seen {
+			ruleOrder = append(ruleOrder, rule)
+		}
+		grouped[rule] = append(grouped[rule], issue)
+	}
+
+	for _, rule := range ruleOrder {
+		ruleIssues := grouped[rule]
+		f.writeRuleHeader(output, rule, ruleIssues[0].Category)
+
+		for _, issue := range ruleIssues {
+			f.writeFriendlyIssue(output, issue)
+		}
+		output.WriteString("\n")
+	}
+}
+
+func (f *FriendlyFormatter) writeRuleHeader(output *strings.Builder, rule string, category Category) {
+	header := fmt.Sprintf("%s (%s)", rule, category)
+	if f.colorize {
+		output.WriteString(color.New(color.FgCyan, color.Bold).Sprintln(header))
+	} else {
+		output.WriteString(header + "\n")
+	}
+}
+
+func (f *FriendlyFormatter) writeFriendlyIssue(output *strings.Builder, issue Issue) {
+	icon := f.severityIcon(issue.Severity)
+
+	file := issue.File
+	if issue.Line > 0 {
+		file = fmt.Sprintf("%s:%d", file, issue.Line)
+	}
+
+	line := fmt.Sprintf("  %s %s", icon, issue.Description)
+	if file != "" {
+		line += fmt.Sprintf(" (%s)", file)
+	}
+
+	if f.colorize {
+		if severityColor := f.getSeverityColor(issue.Severity); severityColor != nil {
+			line = severityColor.Sprint(line)
+		}
+	}
+
+	output.WriteString(line + "\n")
+}
+
+func (f *FriendlyFormatter) severityIcon(severity Severity) string {
+	switch severity {
+	case SeverityCritical, SeverityHigh:
+		return "✘"
+	case SeverityMedium:
+		return "⚠"
+	default:
+		return "ℹ"
+	}
+}
+
+func (f *FriendlyFormatter) writeRuleStatsTable(output *strings.Builder, issues []Issue) {
+	stats := f.buildRuleStats(issues)
+
+	ruleWidth, countWidth, severityWidth := len("RULE"), len("COUNT"), len("SEVERITY")
+	for _, stat := range stats {
+		if len(stat.rule) > ruleWidth {
+			ruleWidth = len(stat.rule)
+		}
+		if w := len(fmt.Sprintf("%d", stat.count)); w > countWidth {
+			countWidth = w
+		}
+		if w := len(string(stat.severity)); w > severityWidth {
+			severityWidth = w
+		}
+	}
+
+	if f.colorize {
+		color.Set(color.FgYellow, color.Bold)
+	}
+	output.WriteString("Rule Statistics:\n")
+	if f.colorize {
+		color.Unset()
+	}
+
+	output.WriteString(fmt.Sprintf("  %-*s  %-*s  %-*s\n", ruleWidth, "RULE", countWidth, "COUNT", severityWidth, "SEVERITY"))
+	for _, stat := range stats {
+		output.WriteString(fmt.Sprintf("  %-*s  %-*d  %-*s\n",
+			ruleWidth, stat.rule, countWidth, stat.count, severityWidth, string(stat.severity)))
+	}
+}
+
+func (f *FriendlyFormatter) buildRuleStats(issues []Issue) []ruleStat {
+	index := make(map[string]int)
+	var stats []ruleStat
+
+	for _, issue := range issues {
+		rule := f.ruleKey(issue)
+		if i, ok := index[rule]; ok {
+			stats[i].count++
+			if severityRank(issue.Severity) > severityRank(stats[i].severity) {
+				stats[i].severity = issue.Severity
+			}
+			continue
+		}
+
+		index[rule] = len(stats)
+		stats = append(stats, ruleStat{
+			rule:     rule,
+			category: issue.Category,
+			severity: issue.Severity,
+			count:    1,
+		})
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].count > stats[j].count
+	})
+
+	return stats
+}
+
+func (f *FriendlyFormatter) ruleKey(issue Issue) string {
+	if issue.Rule != "" {
+		return issue.Rule
+	}
+	return string(issue.Category)
+}
+
+func (f *FriendlyFormatter) getSeverityColor(severity Severity) *color.Color {
+	switch severity {
+	case SeverityCritical:
+		return color.New(color.FgRed, color.Bold)
+	case SeverityHigh:
+		return color.New(color.FgRed)
+	case SeverityMedium:
+		return color.New(color.FgYellow)
+	case SeverityLow:
+		return color.New(color.FgBlue)
+	default:
+		return nil
+	}
+}
+
+func severityRank(severity Severity) int {
+	switch severity {
+	case SeverityCritical:
+		return 4
+	case SeverityHigh:
+		return 3
+	case SeverityMedium:
+		return 2
+	case SeverityLow:
+		return 1
+	default:
+		return 0
+	}
+}
+
 type JSONFormatter struct{}
 
 func NewJSONFormatter() *JSONFormatter {
@@ -292,10 +527,27 @@ func (f *MarkdownFormatter) writeIssuesMarkdown(output *strings.Builder, issues
 				output.WriteString(fmt.Sprintf("**Location:** `%s`\n\n", fileLocation))
 			}
 
+			if issue.Rationale != "" {
+				output.WriteString(fmt.Sprintf("**Why:** %s\n\n", issue.Rationale))
+			}
+
 			if issue.Fix != "" {
 				output.WriteString(fmt.Sprintf("**Suggested Fix:** %s\n\n", issue.Fix))
 			}
 
+			if issue.FixGuidance != nil {
+				output.WriteString(fmt.Sprintf("**Remediation Effort:** %s\n\n", issue.FixGuidance.Effort))
+				if issue.FixGuidance.Patch != "" {
+					output.WriteString(fmt.Sprintf("**Patch:** %s\n\n", issue.FixGuidance.Patch))
+				}
+				if issue.FixGuidance.DocURL != "" {
+					output.WriteString(fmt.Sprintf("**Docs:** %s\n\n", issue.FixGuidance.DocURL))
+				}
+				if issue.FixGuidance.Probe != "" {
+					output.WriteString(fmt.Sprintf("**Verify:** %s\n\n", issue.FixGuidance.Probe))
+				}
+			}
+
 			output.WriteString("---\n\n")
 		}
 	}
@@ -357,29 +609,82 @@ func (f *MarkdownFormatter) sortLanguagesByPercentage(stats *CodeStats) []langSt
 		})
 	}
 
-	// Sort by percentage (descending)
-	for i := 0; i < len(languages); i++ {
-		for j := 0; j < len(languages)-1-i; j++ {
-			if languages[j].percent < languages[j+1].percent {
-				languages[j], languages[j+1] = languages[j+1], languages[j]
-			}
+	sortLangStats(languages)
+	return languages
+}
+
+// sortLangStats sorts languages by percentage descending, breaking ties on
+// name so the order stays stable across runs when two languages tie.
+func sortLangStats(languages []langStat) {
+	sort.Slice(languages, func(i, j int) bool {
+		if languages[i].percent != languages[j].percent {
+			return languages[i].percent > languages[j].percent
 		}
+		return languages[i].name < languages[j].name
+	})
+}
+
+// FormatterOptions carries the settings GetFormatter resolves on behalf of a
+// formatter factory, so individual formatters don't each need to re-derive
+// things like terminal detection.
+type FormatterOptions struct {
+	// Colorize reports whether output is going to an interactive terminal.
+	Colorize bool
+	// TemplatePath is the file passed via --format-template, used only by
+	// the "template" formatter.
+	TemplatePath string
+}
+
+// FormatterFactory builds a Formatter from the resolved FormatterOptions.
+type FormatterFactory func(opts FormatterOptions) Formatter
+
+var formatterRegistry = map[string]FormatterFactory{}
+
+// RegisterFormatter makes a formatter available under name for GetFormatter
+// to resolve. External packages can call this from their own init() to add
+// output shapes (HTML, JUnit XML, CSV, GitLab Code Quality JSON, ...)
+// without modifying this package. Registering under a name that already
+// exists replaces it, so a downstream tool can also override a built-in.
+func RegisterFormatter(name string, factory FormatterFactory) {
+	formatterRegistry[strings.ToLower(name)] = factory
+}
+
+func init() {
+	RegisterFormatter("table", func(opts FormatterOptions) Formatter { return NewTableFormatter(opts.Colorize) })
+	RegisterFormatter("friendly", func(opts FormatterOptions) Formatter { return NewFriendlyFormatter(opts.Colorize) })
+	RegisterFormatter("json", func(opts FormatterOptions) Formatter { return NewJSONFormatter() })
+	RegisterFormatter("markdown", func(opts FormatterOptions) Formatter { return NewMarkdownFormatter() })
+	RegisterFormatter("md", func(opts FormatterOptions) Formatter { return NewMarkdownFormatter() })
+	RegisterFormatter("sarif", func(opts FormatterOptions) Formatter { return NewSARIFFormatter() })
+	RegisterFormatter("junit", func(opts FormatterOptions) Formatter { return NewJUnitFormatter() })
+	RegisterFormatter("template", func(opts FormatterOptions) Formatter { return NewTemplateFormatter(opts.TemplatePath) })
+}
+
+// GetFormatter resolves format by name from the formatter registry. An empty
+// format defaults to "table". An unknown format returns an error listing the
+// formats currently registered. templatePath is only consulted by the
+// "template" formatter and is otherwise ignored.
+func GetFormatter(format, templatePath string) (Formatter, error) {
+	name := strings.ToLower(format)
+	if name == "" {
+		name = "table"
 	}
 
-	return languages
+	factory, ok := formatterRegistry[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown output format %q (available: %s)", format, strings.Join(availableFormats(), ", "))
+	}
+
+	return factory(FormatterOptions{Colorize: isTerminal(), TemplatePath: templatePath}), nil
 }
 
-func GetFormatter(format string) Formatter {
-	switch strings.ToLower(format) {
-	case "json":
-		return NewJSONFormatter()
-	case "markdown", "md":
-		return NewMarkdownFormatter()
-	case "table":
-		fallthrough
-	default:
-		return NewTableFormatter(isTerminal())
+func availableFormats() []string {
+	names := make([]string, 0, len(formatterRegistry))
+	for name := range formatterRegistry {
+		names = append(names, name)
 	}
+	sort.Strings(names)
+	return names
 }
 
 func titleCase(s string) string {
@@ -394,20 +699,31 @@ func isTerminal() bool {
 	return fileInfo.Mode()&os.ModeCharDevice != 0
 }
 
+var numberPrinter = message.NewPrinter(language.English)
+
 func formatNumber(n int) string {
-	if n < 1000 {
-		return fmt.Sprintf("%d", n)
+	return numberPrinter.Sprintf("%d", n)
+}
+
+// formatBytes renders a byte count using the largest unit that keeps the
+// number readable, matching the precision (one decimal place) formatNumber
+// already uses for line/file counts.
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
 	}
 
-	str := fmt.Sprintf("%d", n)
-	result := ""
+	div, exp := uint64(unit), 0
+	for next := n / unit; next >= unit; next /= unit {
+		div *= unit
+		exp++
+	}
 
-	for i, char := range str {
-		if i > 0 && (len(str)-i)%3 == 0 {
-			result += ","
-		}
-		result += string(char)
+	units := []string{"KB", "MB", "GB", "TB"}
+	if exp >= len(units) {
+		exp = len(units) - 1
 	}
 
-	return result
+	return fmt.Sprintf("%.1f%s", float64(n)/float64(div), units[exp])
 }
@@ -1,7 +1,10 @@
 package report
 
 import (
+	"bytes"
 	"encoding/json"
+	"encoding/xml"
+	"os"
 	"strings"
 	"testing"
 	"time"
@@ -111,6 +114,248 @@ func TestMarkdownFormatter_Format(t *testing.T) {
 	// So remove these incorrect assertions
 }
 
+func TestFriendlyFormatter_Format(t *testing.T) {
+	formatter := NewFriendlyFormatter(false)
+
+	report := createTestReport()
+
+	output, err := formatter.Format(report)
+	if err != nil {
+		t.Fatalf("Failed to format report: %v", err)
+	}
+
+	t.Logf("Actual output:\n%s", output)
+
+	if !strings.Contains(output, "test-rule") {
+		t.Error("Output should group issues under their rule name")
+	}
+
+	if !strings.Contains(output, "✘") {
+		t.Error("Output should mark high severity issues with the ✘ icon")
+	}
+
+	if !strings.Contains(output, "Rule Statistics:") {
+		t.Error("Output should contain a rule statistics summary table")
+	}
+
+	if !strings.Contains(output, "RULE") || !strings.Contains(output, "COUNT") || !strings.Contains(output, "SEVERITY") {
+		t.Error("Rule statistics table should have RULE, COUNT and SEVERITY columns")
+	}
+}
+
+func TestFriendlyFormatter_NoIssues(t *testing.T) {
+	formatter := NewFriendlyFormatter(false)
+
+	report := createTestReport()
+	report.Issues = nil
+
+	output, err := formatter.Format(report)
+	if err != nil {
+		t.Fatalf("Failed to format report: %v", err)
+	}
+
+	if !strings.Contains(output, "No issues found") {
+		t.Error("Output should report a healthy repository when there are no issues")
+	}
+}
+
+func TestSARIFFormatter_Format(t *testing.T) {
+	formatter := NewSARIFFormatter()
+	testReport := createTestReport()
+	EnrichHelpURIs(testReport.Issues)
+
+	output, err := formatter.Format(testReport)
+	if err != nil {
+		t.Fatalf("Failed to format report: %v", err)
+	}
+
+	var log struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Name  string `json:"name"`
+					Rules []struct {
+						ID      string `json:"id"`
+						HelpURI string `json:"helpUri"`
+						Help    struct {
+							Text string `json:"text"`
+						} `json:"help"`
+						DefaultConfiguration struct {
+							Level string `json:"level"`
+						} `json:"defaultConfiguration"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			OriginalURIBaseIDs map[string]struct {
+				URI string `json:"uri"`
+			} `json:"originalUriBaseIds"`
+			Results []struct {
+				RuleID              string            `json:"ruleId"`
+				Level               string            `json:"level"`
+				PartialFingerprints map[string]string `json:"partialFingerprints"`
+				Properties          struct {
+					Tags []string `json:"tags"`
+				} `json:"properties"`
+				Locations []struct {
+					PhysicalLocation struct {
+						ArtifactLocation struct {
+							URIBaseID string `json:"uriBaseId"`
+						} `json:"artifactLocation"`
+					} `json:"physicalLocation"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+
+	if err := json.Unmarshal([]byte(output), &log); err != nil {
+		t.Fatalf("Failed to unmarshal SARIF output: %v", err)
+	}
+
+	if log.Version != "2.1.0" {
+		t.Errorf("Expected SARIF version 2.1.0, got %s", log.Version)
+	}
+
+	if len(log.Runs) != 1 {
+		t.Fatalf("Expected exactly 1 run, got %d", len(log.Runs))
+	}
+
+	run := log.Runs[0]
+	if run.Tool.Driver.Name != "githealthchecker" {
+		t.Errorf("Expected tool name githealthchecker, got %s", run.Tool.Driver.Name)
+	}
+
+	if len(run.Tool.Driver.Rules) != len(testReport.Issues) {
+		t.Errorf("Expected %d de-duplicated rules, got %d", len(testReport.Issues), len(run.Tool.Driver.Rules))
+	}
+
+	for _, rule := range run.Tool.Driver.Rules {
+		if rule.HelpURI == "" {
+			t.Errorf("Expected rule %s to carry a helpUri", rule.ID)
+		}
+		if rule.Help.Text == "" {
+			t.Errorf("Expected rule %s to carry help text from the issue's Fix", rule.ID)
+		}
+		if rule.DefaultConfiguration.Level == "" {
+			t.Errorf("Expected rule %s to carry a defaultConfiguration.level", rule.ID)
+		}
+	}
+
+	if len(run.Results) != len(testReport.Issues) {
+		t.Fatalf("Expected %d results, got %d", len(testReport.Issues), len(run.Results))
+	}
+
+	result := run.Results[0]
+	if result.Level != "error" {
+		t.Errorf("Expected high severity to map to 'error', got %s", result.Level)
+	}
+	if result.PartialFingerprints["githealthchecker/v1"] == "" {
+		t.Error("Expected a partialFingerprints entry for dedupe across runs")
+	}
+	if len(result.Properties.Tags) != 1 || result.Properties.Tags[0] != string(CategorySecurity) {
+		t.Errorf("Expected properties.tags to contain the issue category, got %v", result.Properties.Tags)
+	}
+
+	srcRoot, ok := run.OriginalURIBaseIDs["%SRCROOT%"]
+	if !ok || srcRoot.URI == "" {
+		t.Error("Expected originalUriBaseIds to map %SRCROOT% to the repository root")
+	}
+	if len(result.Locations) != 1 || result.Locations[0].PhysicalLocation.ArtifactLocation.URIBaseID != "%SRCROOT%" {
+		t.Error("Expected the result's artifactLocation to reference the %SRCROOT% base id")
+	}
+}
+
+func TestSARIFWriter_WriteTo_MatchesFormatterOutput(t *testing.T) {
+	testReport := createTestReport()
+	EnrichHelpURIs(testReport.Issues)
+
+	want, err := NewSARIFFormatter().Format(testReport)
+	if err != nil {
+		t.Fatalf("Failed to format report: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := NewSARIFWriter().WriteTo(&buf, testReport); err != nil {
+		t.Fatalf("Failed to write SARIF report: %v", err)
+	}
+
+	var wantLog, gotLog any
+	if err := json.Unmarshal([]byte(want), &wantLog); err != nil {
+		t.Fatalf("Failed to unmarshal Format output: %v", err)
+	}
+	if err := json.Unmarshal(buf.Bytes(), &gotLog); err != nil {
+		t.Fatalf("Failed to unmarshal WriteTo output: %v", err)
+	}
+
+	wantJSON, _ := json.Marshal(wantLog)
+	gotJSON, _ := json.Marshal(gotLog)
+	if string(wantJSON) != string(gotJSON) {
+		t.Errorf("WriteTo output does not match Format output:\nwant: %s\ngot:  %s", wantJSON, gotJSON)
+	}
+}
+
+func TestJUnitFormatter_Format(t *testing.T) {
+	formatter := NewJUnitFormatter()
+	testReport := createTestReport()
+
+	output, err := formatter.Format(testReport)
+	if err != nil {
+		t.Fatalf("Failed to format report: %v", err)
+	}
+
+	var suites struct {
+		XMLName xml.Name `xml:"testsuites"`
+		Suites  []struct {
+			Name      string `xml:"name,attr"`
+			Tests     int    `xml:"tests,attr"`
+			Failures  int    `xml:"failures,attr"`
+			Skipped   int    `xml:"skipped,attr"`
+			TestCases []struct {
+				Name    string `xml:"name,attr"`
+				Failure *struct {
+					Message string `xml:"message,attr"`
+				} `xml:"failure"`
+			} `xml:"testcase"`
+		} `xml:"testsuite"`
+	}
+
+	if err := xml.Unmarshal([]byte(output), &suites); err != nil {
+		t.Fatalf("Failed to unmarshal JUnit output: %v", err)
+	}
+
+	if len(suites.Suites) != 2 {
+		t.Fatalf("Expected 1 testsuite per category (2 categories), got %d", len(suites.Suites))
+	}
+
+	var securitySuite *struct {
+		Name      string `xml:"name,attr"`
+		Tests     int    `xml:"tests,attr"`
+		Failures  int    `xml:"failures,attr"`
+		Skipped   int    `xml:"skipped,attr"`
+		TestCases []struct {
+			Name    string `xml:"name,attr"`
+			Failure *struct {
+				Message string `xml:"message,attr"`
+			} `xml:"failure"`
+		} `xml:"testcase"`
+	}
+	for i := range suites.Suites {
+		if suites.Suites[i].Name == string(CategorySecurity) {
+			securitySuite = &suites.Suites[i]
+		}
+	}
+	if securitySuite == nil {
+		t.Fatal("Expected a testsuite for the security category")
+	}
+
+	if securitySuite.Failures != 1 {
+		t.Errorf("Expected 1 failure for the high-severity security issue, got %d", securitySuite.Failures)
+	}
+	if len(securitySuite.TestCases) != 1 || securitySuite.TestCases[0].Failure == nil {
+		t.Error("Expected the security testcase to carry a <failure> element")
+	}
+}
+
 func TestGetFormatter(t *testing.T) {
 	tests := []struct {
 		format   string
@@ -119,12 +364,18 @@ func TestGetFormatter(t *testing.T) {
 		{"table", "*report.TableFormatter"},
 		{"json", "*report.JSONFormatter"},
 		{"markdown", "*report.MarkdownFormatter"},
-		{"invalid", "*report.TableFormatter"}, // Should default to table
-		{"", "*report.TableFormatter"},        // Should default to table
+		{"sarif", "*report.SARIFFormatter"},
+		{"friendly", "*report.FriendlyFormatter"},
+		{"junit", "*report.JUnitFormatter"},
+		{"", "*report.TableFormatter"}, // Should default to table
 	}
 
 	for _, test := range tests {
-		formatter := GetFormatter(test.format)
+		formatter, err := GetFormatter(test.format, "")
+		if err != nil {
+			t.Fatalf("GetFormatter(%q) returned unexpected error: %v", test.format, err)
+		}
+
 		formatterType := getFormatterType(formatter)
 		if formatterType != test.expected {
 			t.Errorf("For format '%s', expected %s, got %s",
@@ -133,6 +384,57 @@ func TestGetFormatter(t *testing.T) {
 	}
 }
 
+func TestGetFormatter_UnknownFormatListsAvailable(t *testing.T) {
+	_, err := GetFormatter("invalid", "")
+	if err == nil {
+		t.Fatal("expected an error for an unknown format")
+	}
+
+	if !strings.Contains(err.Error(), "table") {
+		t.Errorf("expected error to list available formats, got: %v", err)
+	}
+}
+
+func TestRegisterFormatter_AllowsThirdPartyFormats(t *testing.T) {
+	RegisterFormatter("custom-test-format", func(opts FormatterOptions) Formatter {
+		return NewJSONFormatter()
+	})
+
+	formatter, err := GetFormatter("custom-test-format", "")
+	if err != nil {
+		t.Fatalf("expected custom-test-format to resolve, got error: %v", err)
+	}
+
+	if getFormatterType(formatter) != "*report.JSONFormatter" {
+		t.Errorf("expected the registered factory's formatter, got %s", getFormatterType(formatter))
+	}
+}
+
+func TestTemplateFormatter_Format(t *testing.T) {
+	dir := t.TempDir()
+	templatePath := dir + "/report.tmpl"
+	if err := os.WriteFile(templatePath, []byte("{{.Repository}} scored {{.Summary.Score}}\n"), 0644); err != nil {
+		t.Fatalf("failed to write template: %v", err)
+	}
+
+	formatter := NewTemplateFormatter(templatePath)
+	output, err := formatter.Format(createTestReport())
+	if err != nil {
+		t.Fatalf("Failed to format report: %v", err)
+	}
+
+	if !strings.Contains(output, "/test/repo scored 77") {
+		t.Errorf("expected template output to substitute report fields, got: %q", output)
+	}
+}
+
+func TestTemplateFormatter_MissingPathErrors(t *testing.T) {
+	formatter := NewTemplateFormatter("")
+	if _, err := formatter.Format(createTestReport()); err == nil {
+		t.Error("expected an error when no template path is configured")
+	}
+}
+
 func TestCalculateHealthScore(t *testing.T) {
 	tests := []struct {
 		issues   []Issue
@@ -293,6 +595,12 @@ func getFormatterType(formatter Formatter) string {
 		return "*report.JSONFormatter"
 	case *MarkdownFormatter:
 		return "*report.MarkdownFormatter"
+	case *SARIFFormatter:
+		return "*report.SARIFFormatter"
+	case *FriendlyFormatter:
+		return "*report.FriendlyFormatter"
+	case *JUnitFormatter:
+		return "*report.JUnitFormatter"
 	default:
 		return "unknown"
 	}
@@ -0,0 +1,130 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// junitTestSuites is a minimal JUnit XML document sufficient for CI systems
+// (Jenkins, GitLab, CircleCI, Buildkite) to render githealthchecker results
+// in their existing test-report UIs without a custom parser.
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name    string       `xml:"name,attr"`
+	Failure *junitResult `xml:"failure,omitempty"`
+	Skipped *junitResult `xml:"skipped,omitempty"`
+}
+
+type junitResult struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// JUnitFormatter renders a Report as a JUnit <testsuites> document, grouping
+// issues into a <testsuite> per Category and emitting a <testcase> per
+// Issue: a <failure> for critical/high severity, a <skipped> for low
+// severity, and a bare passing <testcase> otherwise.
+type JUnitFormatter struct{}
+
+func NewJUnitFormatter() *JUnitFormatter {
+	return &JUnitFormatter{}
+}
+
+func (f *JUnitFormatter) Format(report *Report) (string, error) {
+	suites := f.buildSuites(report.Issues)
+
+	data, err := xml.MarshalIndent(junitTestSuites{Suites: suites}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JUnit XML: %w", err)
+	}
+
+	return xml.Header + string(data) + "\n", nil
+}
+
+func (f *JUnitFormatter) buildSuites(issues []Issue) []junitTestSuite {
+	var order []Category
+	grouped := make(map[Category][]Issue)
+	for _, issue := range issues {
+		if _, seen := grouped[issue.Category]; !seen {
+			order = append(order, issue.Category)
+		}
+		grouped[issue.Category] = append(grouped[issue.Category], issue)
+	}
+
+	suites := make([]junitTestSuite, 0, len(order))
+	for _, category := range order {
+		categoryIssues := grouped[category]
+
+		suite := junitTestSuite{
+			Name:  string(category),
+			Tests: len(categoryIssues),
+		}
+
+		for _, issue := range categoryIssues {
+			testCase := f.buildTestCase(issue)
+			if testCase.Failure != nil {
+				suite.Failures++
+			}
+			if testCase.Skipped != nil {
+				suite.Skipped++
+			}
+			suite.TestCases = append(suite.TestCases, testCase)
+		}
+
+		suites = append(suites, suite)
+	}
+
+	return suites
+}
+
+func (f *JUnitFormatter) buildTestCase(issue Issue) junitTestCase {
+	testCase := junitTestCase{Name: f.testCaseName(issue)}
+	body := f.resultText(issue)
+
+	switch issue.Severity {
+	case SeverityCritical, SeverityHigh:
+		testCase.Failure = &junitResult{Message: issue.Description, Text: body}
+	case SeverityLow:
+		testCase.Skipped = &junitResult{Message: issue.Description, Text: body}
+	}
+
+	return testCase
+}
+
+func (f *JUnitFormatter) testCaseName(issue Issue) string {
+	if issue.File != "" {
+		if issue.Line > 0 {
+			return fmt.Sprintf("%s:%d", issue.File, issue.Line)
+		}
+		return issue.File
+	}
+	return issue.Title
+}
+
+func (f *JUnitFormatter) resultText(issue Issue) string {
+	location := issue.File
+	if issue.Line > 0 {
+		location = fmt.Sprintf("%s:%d", issue.File, issue.Line)
+	}
+
+	text := issue.Description
+	if location != "" {
+		text += "\n" + location
+	}
+	if issue.Fix != "" {
+		text += "\nFix: " + issue.Fix
+	}
+	return text
+}
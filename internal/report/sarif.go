@@ -0,0 +1,254 @@
+package report
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// sarifLog is a minimal representation of a SARIF 2.1.0 log sufficient for
+// uploading results to GitHub code scanning and similar SARIF consumers.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool sarifTool `json:"tool"`
+	// OriginalURIBaseIDs maps the "%SRCROOT%" token each result's
+	// artifactLocation.uriBaseId refers to back to the repository root,
+	// so a SARIF consumer (GitHub code scanning in particular) can resolve
+	// the relative file URIs below to an absolute path without having to
+	// know what directory this tool ran from.
+	OriginalURIBaseIDs map[string]sarifArtifactLocation `json:"originalUriBaseIds,omitempty"`
+	Results            []sarifResult                    `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	// InformationURI lets a SARIF consumer (GitHub code scanning's UI, for
+	// one) link the tool name back to somewhere a reader can learn what
+	// produced the result, the same way it links a rule's HelpURI.
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+	FullDescription  sarifMessage `json:"fullDescription"`
+	HelpURI          string       `json:"helpUri,omitempty"`
+	// Help carries the Issue's remediation text (Fix), so a SARIF viewer
+	// (VS Code, GitHub code scanning) can show "how to fix this" alongside
+	// the rule description instead of only linking out to HelpURI.
+	Help                 sarifMessage           `json:"help,omitempty"`
+	DefaultConfiguration sarifRuleConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifRuleConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID              string            `json:"ruleId"`
+	Level               string            `json:"level"`
+	Message             sarifMessage      `json:"message"`
+	Locations           []sarifLocation   `json:"locations,omitempty"`
+	PartialFingerprints map[string]string `json:"partialFingerprints,omitempty"`
+	Properties          *sarifProperties  `json:"properties,omitempty"`
+}
+
+type sarifProperties struct {
+	Tags []string `json:"tags,omitempty"`
+	// Rationale and the fixGuidance.* properties surface Issue.Rationale and
+	// Issue.FixGuidance, which SARIF has no first-class slot for, as an
+	// opaque properties bag - the same escape hatch GitHub code scanning and
+	// other SARIF consumers already use for tool-specific metadata.
+	Rationale         string `json:"rationale,omitempty"`
+	FixGuidanceEffort string `json:"fixGuidanceEffort,omitempty"`
+	FixGuidancePatch  string `json:"fixGuidancePatch,omitempty"`
+	FixGuidanceDocURL string `json:"fixGuidanceDocUrl,omitempty"`
+	FixGuidanceProbe  string `json:"fixGuidanceProbe,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// SARIFFormatter renders a Report as a SARIF 2.1.0 log.
+type SARIFFormatter struct{}
+
+func NewSARIFFormatter() *SARIFFormatter {
+	return &SARIFFormatter{}
+}
+
+func (f *SARIFFormatter) Format(report *Report) (string, error) {
+	var buf bytes.Buffer
+	if err := NewSARIFWriter().WriteTo(&buf, report); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func (f *SARIFFormatter) buildLog(report *Report) sarifLog {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           "githealthchecker",
+				Version:        report.Version,
+				InformationURI: "https://github.com/githealthchecker/git-health-checker",
+				Rules:          f.buildRules(report.Issues),
+			},
+		},
+		Results: f.buildResults(report.Issues),
+	}
+	if report.Repository != "" {
+		run.OriginalURIBaseIDs = map[string]sarifArtifactLocation{
+			"%SRCROOT%": {URI: "file://" + report.Repository + "/"},
+		}
+	}
+
+	return sarifLog{
+		Schema:  "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}
+}
+
+// SARIFWriter renders a Report as a SARIF 2.1.0 log directly onto an
+// io.Writer via json.Encoder, instead of building the whole document as a
+// string first. For the large scans --format sarif targets (uploading to
+// GitHub code scanning from CI), that avoids holding a second full copy of
+// the rendered JSON in memory alongside the in-memory Report it's built from.
+type SARIFWriter struct {
+	formatter *SARIFFormatter
+}
+
+func NewSARIFWriter() *SARIFWriter {
+	return &SARIFWriter{formatter: NewSARIFFormatter()}
+}
+
+// WriteTo encodes report's SARIF log straight to dst.
+func (w *SARIFWriter) WriteTo(dst io.Writer, report *Report) error {
+	enc := json.NewEncoder(dst)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(w.formatter.buildLog(report)); err != nil {
+		return fmt.Errorf("failed to write SARIF log: %w", err)
+	}
+	return nil
+}
+
+func (f *SARIFFormatter) buildRules(issues []Issue) []sarifRule {
+	seen := make(map[string]bool)
+	var rules []sarifRule
+
+	for _, issue := range issues {
+		ruleID := f.ruleID(issue)
+		if seen[ruleID] {
+			continue
+		}
+		seen[ruleID] = true
+
+		rules = append(rules, sarifRule{
+			ID:                   ruleID,
+			ShortDescription:     sarifMessage{Text: issue.Title},
+			FullDescription:      sarifMessage{Text: issue.Description},
+			HelpURI:              issue.HelpURI,
+			Help:                 sarifMessage{Text: issue.Fix},
+			DefaultConfiguration: sarifRuleConfiguration{Level: f.level(issue.Severity)},
+		})
+	}
+
+	return rules
+}
+
+func (f *SARIFFormatter) buildResults(issues []Issue) []sarifResult {
+	results := make([]sarifResult, 0, len(issues))
+
+	for _, issue := range issues {
+		properties := &sarifProperties{Tags: []string{string(issue.Category)}, Rationale: issue.Rationale}
+		if issue.FixGuidance != nil {
+			properties.FixGuidanceEffort = string(issue.FixGuidance.Effort)
+			properties.FixGuidancePatch = issue.FixGuidance.Patch
+			properties.FixGuidanceDocURL = issue.FixGuidance.DocURL
+			properties.FixGuidanceProbe = issue.FixGuidance.Probe
+		}
+
+		result := sarifResult{
+			RuleID:  f.ruleID(issue),
+			Level:   f.level(issue.Severity),
+			Message: sarifMessage{Text: issue.Description},
+			PartialFingerprints: map[string]string{
+				"githealthchecker/v1": f.fingerprint(issue),
+			},
+			Properties: properties,
+		}
+
+		if issue.File != "" {
+			result.Locations = []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: issue.File, URIBaseID: "%SRCROOT%"},
+					Region:           sarifRegion{StartLine: issue.Line, StartColumn: issue.Column},
+				},
+			}}
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// fingerprint derives a stable per-issue identity from its file, rule, and
+// line so SARIF consumers (e.g. GitHub code scanning) can dedupe the same
+// finding across runs even as unrelated issues shift around it.
+func (f *SARIFFormatter) fingerprint(issue Issue) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%d", issue.File, f.ruleID(issue), issue.Line)))
+	return hex.EncodeToString(sum[:])
+}
+
+func (f *SARIFFormatter) ruleID(issue Issue) string {
+	if issue.Rule != "" {
+		return fmt.Sprintf("%s/%s", issue.Category, issue.Rule)
+	}
+	return string(issue.Category)
+}
+
+func (f *SARIFFormatter) level(severity Severity) string {
+	switch severity {
+	case SeverityCritical, SeverityHigh:
+		return "error"
+	case SeverityMedium:
+		return "warning"
+	default:
+		return "note"
+	}
+}
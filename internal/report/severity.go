@@ -0,0 +1,146 @@
+package report
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gobwas/glob"
+)
+
+// SeverityRule is one entry in SeverityConfig.Rules, modeled on
+// golangci-lint's `severity.rules` section: the first rule in the list
+// whose non-empty fields all match an issue assigns it Severity. An empty
+// field imposes no constraint, the same "absence means don't care"
+// convention Config.Rules uses elsewhere.
+type SeverityRule struct {
+	Rule     string   `mapstructure:"rule" yaml:"rule,omitempty"`
+	Path     string   `mapstructure:"path" yaml:"path,omitempty"`
+	Category string   `mapstructure:"category" yaml:"category,omitempty"`
+	Text     string   `mapstructure:"text" yaml:"text,omitempty"`
+	Severity Severity `mapstructure:"severity" yaml:"severity"`
+}
+
+// SeverityConfig is ApplySeverityRules' policy: a fallback Default severity
+// plus the ordered Rules list. CaseInsensitive folds case for every rule's
+// Rule/Path/Category/Text match, rather than requiring each pattern to
+// carry its own "(?i)" prefix.
+type SeverityConfig struct {
+	Default         Severity       `mapstructure:"default_severity" yaml:"default_severity,omitempty"`
+	CaseInsensitive bool           `mapstructure:"case_insensitive" yaml:"case_insensitive,omitempty"`
+	Rules           []SeverityRule `mapstructure:"rules" yaml:"rules,omitempty"`
+}
+
+// ApplySeverityRules remaps every issue's Severity according to cfg: the
+// first rule in cfg.Rules that matches wins (first-match-wins, like
+// golangci-lint), and cfg.Default applies to anything no rule matched. A
+// zero-value cfg (no default, no rules) is a no-op, so profiles that don't
+// configure `severity:` pay nothing extra. Called after every analyzer has
+// produced its issues and before calculateSummary, so the health score
+// reflects the remapped severities.
+func ApplySeverityRules(issues []Issue, cfg SeverityConfig) []Issue {
+	if cfg.Default == "" && len(cfg.Rules) == 0 {
+		return issues
+	}
+
+	rules := compileSeverityRules(cfg.Rules, cfg.CaseInsensitive)
+
+	for i := range issues {
+		if rule, ok := firstMatchingSeverityRule(rules, issues[i]); ok {
+			issues[i].Severity = rule.severity
+		} else if cfg.Default != "" {
+			issues[i].Severity = cfg.Default
+		}
+	}
+
+	return issues
+}
+
+func firstMatchingSeverityRule(rules []compiledSeverityRule, issue Issue) (compiledSeverityRule, bool) {
+	for _, rule := range rules {
+		if rule.matches(issue) {
+			return rule, true
+		}
+	}
+	return compiledSeverityRule{}, false
+}
+
+// compiledSeverityRule is a SeverityRule with its patterns pre-compiled, so
+// ApplySeverityRules doesn't recompile a regex or glob per issue.
+type compiledSeverityRule struct {
+	rule            *regexp.Regexp
+	path            glob.Glob
+	category        *regexp.Regexp
+	text            *regexp.Regexp
+	severity        Severity
+	caseInsensitive bool
+}
+
+func compileSeverityRules(rules []SeverityRule, caseInsensitive bool) []compiledSeverityRule {
+	compiled := make([]compiledSeverityRule, 0, len(rules))
+	for _, r := range rules {
+		compiled = append(compiled, compiledSeverityRule{
+			rule:            compileSeverityRegex(r.Rule, caseInsensitive),
+			path:            compileSeverityGlob(r.Path, caseInsensitive),
+			category:        compileSeverityRegex(r.Category, caseInsensitive),
+			text:            compileSeverityRegex(r.Text, caseInsensitive),
+			severity:        r.Severity,
+			caseInsensitive: caseInsensitive,
+		})
+	}
+	return compiled
+}
+
+func (c compiledSeverityRule) matches(issue Issue) bool {
+	if c.rule != nil && !c.rule.MatchString(issue.Rule) {
+		return false
+	}
+	if c.category != nil && !c.category.MatchString(string(issue.Category)) {
+		return false
+	}
+	if c.text != nil && !c.text.MatchString(issue.Description) {
+		return false
+	}
+	if c.path != nil {
+		file := issue.File
+		if c.caseInsensitive {
+			file = strings.ToLower(file)
+		}
+		if !c.path.Match(file) {
+			return false
+		}
+	}
+	return true
+}
+
+// compileSeverityRegex compiles pattern, folding case via a leading "(?i)"
+// when caseInsensitive is set. An empty pattern imposes no constraint
+// (nil); an invalid one is skipped the same way security.go's
+// EntropyExcludeGlobs drops a glob.Compile rejection rather than failing
+// the whole rule.
+func compileSeverityRegex(pattern string, caseInsensitive bool) *regexp.Regexp {
+	if pattern == "" {
+		return nil
+	}
+	if caseInsensitive {
+		pattern = "(?i)" + pattern
+	}
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil
+	}
+	return compiled
+}
+
+func compileSeverityGlob(pattern string, caseInsensitive bool) glob.Glob {
+	if pattern == "" {
+		return nil
+	}
+	if caseInsensitive {
+		pattern = strings.ToLower(pattern)
+	}
+	compiled, err := glob.Compile(pattern, '/')
+	if err != nil {
+		return nil
+	}
+	return compiled
+}
@@ -0,0 +1,111 @@
+package report
+
+import "testing"
+
+func TestApplySeverityRules_DefaultAppliedWhenNoRuleMatches(t *testing.T) {
+	cfg := SeverityConfig{Default: SeverityLow}
+	issues := []Issue{{Rule: "max-file-lines", Severity: SeverityHigh}}
+
+	got := ApplySeverityRules(issues, cfg)
+	if got[0].Severity != SeverityLow {
+		t.Errorf("expected default severity %q, got %q", SeverityLow, got[0].Severity)
+	}
+}
+
+func TestApplySeverityRules_FirstMatchWins(t *testing.T) {
+	cfg := SeverityConfig{
+		Rules: []SeverityRule{
+			{Rule: "max-.*", Severity: SeverityMedium},
+			{Rule: "max-file-lines", Severity: SeverityCritical},
+		},
+	}
+	issues := []Issue{{Rule: "max-file-lines", Severity: SeverityLow}}
+
+	got := ApplySeverityRules(issues, cfg)
+	if got[0].Severity != SeverityMedium {
+		t.Errorf("expected the first matching rule (%q) to win, got %q", SeverityMedium, got[0].Severity)
+	}
+}
+
+func TestApplySeverityRules_PathGlob(t *testing.T) {
+	cfg := SeverityConfig{
+		Rules: []SeverityRule{
+			{Path: "vendor/**", Severity: SeverityLow},
+		},
+		Default: SeverityHigh,
+	}
+	issues := []Issue{
+		{File: "vendor/pkg/file.go", Severity: SeverityHigh},
+		{File: "internal/report/severity.go", Severity: SeverityHigh},
+	}
+
+	got := ApplySeverityRules(issues, cfg)
+	if got[0].Severity != SeverityLow {
+		t.Errorf("expected vendored file to match the path glob and become %q, got %q", SeverityLow, got[0].Severity)
+	}
+	if got[1].Severity != SeverityHigh {
+		t.Errorf("expected non-vendored file to fall through to the default severity %q, got %q", SeverityHigh, got[1].Severity)
+	}
+}
+
+func TestApplySeverityRules_CaseInsensitive(t *testing.T) {
+	cfg := SeverityConfig{
+		CaseInsensitive: true,
+		Rules: []SeverityRule{
+			{Rule: "MAX-FILE-LINES", Severity: SeverityLow},
+		},
+	}
+	issues := []Issue{{Rule: "max-file-lines", Severity: SeverityHigh}}
+
+	got := ApplySeverityRules(issues, cfg)
+	if got[0].Severity != SeverityLow {
+		t.Errorf("expected a case-insensitive match, got %q", got[0].Severity)
+	}
+}
+
+func TestApplySeverityRules_NoOpWhenUnconfigured(t *testing.T) {
+	issues := []Issue{{Rule: "max-file-lines", Severity: SeverityHigh}}
+	got := ApplySeverityRules(issues, SeverityConfig{})
+	if got[0].Severity != SeverityHigh {
+		t.Errorf("expected an unconfigured policy to leave severity untouched, got %q", got[0].Severity)
+	}
+}
+
+// TestApplySeverityRules_InteractsWithScoring mirrors
+// cmd.calculateHealthScore's per-severity point deductions to verify that a
+// severity remap changes the resulting health score, the way applying the
+// policy before calculateSummary is supposed to.
+func TestApplySeverityRules_InteractsWithScoring(t *testing.T) {
+	scoreFor := func(issues []Issue) int {
+		score := 100
+		for _, issue := range issues {
+			switch issue.Severity {
+			case SeverityCritical:
+				score -= 25
+			case SeverityHigh:
+				score -= 15
+			case SeverityMedium:
+				score -= 8
+			case SeverityLow:
+				score -= 3
+			}
+		}
+		return score
+	}
+
+	issues := []Issue{
+		{Rule: "cyclomatic-complexity", Severity: SeverityHigh},
+		{Rule: "max-file-lines", Severity: SeverityHigh},
+	}
+	before := scoreFor(issues)
+
+	cfg := SeverityConfig{Rules: []SeverityRule{{Rule: "cyclomatic-complexity", Severity: SeverityLow}}}
+	after := scoreFor(ApplySeverityRules(issues, cfg))
+
+	if after <= before {
+		t.Fatalf("expected downgrading one high-severity issue to low to raise the score above %d, got %d", before, after)
+	}
+	if want := 100 - 3 - 15; after != want {
+		t.Errorf("expected score %d after the remap, got %d", want, after)
+	}
+}
@@ -0,0 +1,137 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Range identifies a span of whole lines in a file using 1-based,
+// inclusive line numbers, the same numbering Issue.Line already uses.
+// StartCol/EndCol are carried for parity with LSP-style ranges but are
+// currently unused by ApplyEdits, which always replaces complete lines.
+type Range struct {
+	StartLine int `json:"start_line"`
+	StartCol  int `json:"start_col,omitempty"`
+	EndLine   int `json:"end_line"`
+	EndCol    int `json:"end_col,omitempty"`
+}
+
+// TextEdit replaces the lines in Range with NewText. Creating a brand-new
+// file is expressed as a single edit over {StartLine: 1, EndLine: 1} whose
+// NewText is the file's full starting content.
+type TextEdit struct {
+	Range   Range  `json:"range"`
+	NewText string `json:"new_text"`
+}
+
+// SuggestedFix is a structured, applyable alternative to Issue.Fix, modeled
+// on gopls' suggested fixes: either a set of per-file TextEdits (keyed by
+// path relative to the repository root), or a Command to run for fixes that
+// aren't a textual edit (e.g. "go mod tidy").
+type SuggestedFix struct {
+	Title   string                `json:"title"`
+	Edits   map[string][]TextEdit `json:"edits,omitempty"`
+	Command string                `json:"command,omitempty"`
+}
+
+// ApplyEdits returns content with edits applied, replacing whole lines
+// bottom-to-top so earlier edits don't shift the line numbers later ones
+// reference. A brand-new file is created by calling ApplyEdits("", edits)
+// with a single edit over {1,1}.
+func ApplyEdits(content string, edits []TextEdit) string {
+	if len(edits) == 0 {
+		return content
+	}
+
+	lines := strings.Split(content, "\n")
+
+	sorted := make([]TextEdit, len(edits))
+	copy(sorted, edits)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Range.StartLine > sorted[j].Range.StartLine
+	})
+
+	for _, edit := range sorted {
+		lines = applyLineEdit(lines, edit)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func applyLineEdit(lines []string, edit TextEdit) []string {
+	start := clamp(edit.Range.StartLine-1, 0, len(lines))
+	end := clamp(edit.Range.EndLine, start, len(lines))
+
+	replacement := strings.Split(edit.NewText, "\n")
+
+	merged := make([]string, 0, len(lines)-(end-start)+len(replacement))
+	merged = append(merged, lines[:start]...)
+	merged = append(merged, replacement...)
+	merged = append(merged, lines[end:]...)
+	return merged
+}
+
+func clamp(n, min, max int) int {
+	if n < min {
+		return min
+	}
+	if n > max {
+		return max
+	}
+	return n
+}
+
+// DiffPreview renders a minimal unified-diff-style preview of the lines
+// oldContent and newContent differ on, for `fix` to show before applying a
+// SuggestedFix. It trims the common prefix and suffix rather than computing
+// a full line-level diff, which is enough for the whole-line edits
+// ApplyEdits produces.
+func DiffPreview(path, oldContent, newContent string) string {
+	if oldContent == newContent {
+		return ""
+	}
+
+	oldLines := strings.Split(oldContent, "\n")
+	newLines := strings.Split(newContent, "\n")
+
+	prefix := commonLen(oldLines, newLines, false)
+	oldRest, newRest := oldLines[prefix:], newLines[prefix:]
+	suffix := commonLen(oldRest, newRest, true)
+
+	oldMiddle := oldLines[prefix : len(oldLines)-suffix]
+	newMiddle := newLines[prefix : len(newLines)-suffix]
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", path, path)
+	for _, line := range oldMiddle {
+		fmt.Fprintf(&b, "-%s\n", line)
+	}
+	for _, line := range newMiddle {
+		fmt.Fprintf(&b, "+%s\n", line)
+	}
+
+	return b.String()
+}
+
+// commonLen returns how many leading (or, when fromEnd, trailing) lines a
+// and b share.
+func commonLen(a, b []string, fromEnd bool) int {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+
+	count := 0
+	for i := 0; i < n; i++ {
+		ai, bi := i, i
+		if fromEnd {
+			ai, bi = len(a)-1-i, len(b)-1-i
+		}
+		if a[ai] != b[bi] {
+			break
+		}
+		count++
+	}
+	return count
+}
@@ -0,0 +1,41 @@
+package report
+
+import "testing"
+
+func TestApplyEditsCreatesNewFile(t *testing.T) {
+	edits := []TextEdit{{Range: Range{StartLine: 1, EndLine: 1}, NewText: "*.log\ndist/\n"}}
+
+	got := ApplyEdits("", edits)
+	want := "*.log\ndist/\n"
+	if got != want {
+		t.Errorf("ApplyEdits(\"\", ...) = %q, want %q", got, want)
+	}
+}
+
+func TestApplyEditsReplacesSingleLine(t *testing.T) {
+	original := "module example.com/foo\n\nrequire github.com/pkg/errors v0.8.0\n"
+	edits := []TextEdit{{Range: Range{StartLine: 3, EndLine: 3}, NewText: "require github.com/pkg/errors v0.9.1"}}
+
+	got := ApplyEdits(original, edits)
+	want := "module example.com/foo\n\nrequire github.com/pkg/errors v0.9.1\n"
+	if got != want {
+		t.Errorf("ApplyEdits(...) = %q, want %q", got, want)
+	}
+}
+
+func TestDiffPreviewNoChange(t *testing.T) {
+	if diff := DiffPreview("go.mod", "same", "same"); diff != "" {
+		t.Errorf("expected empty diff for unchanged content, got %q", diff)
+	}
+}
+
+func TestDiffPreviewShowsOnlyChangedLines(t *testing.T) {
+	old := "a\nb\nc\n"
+	updated := "a\nX\nc\n"
+
+	diff := DiffPreview("file.txt", old, updated)
+	want := "--- file.txt\n+++ file.txt\n-b\n+X\n"
+	if diff != want {
+		t.Errorf("DiffPreview() = %q, want %q", diff, want)
+	}
+}
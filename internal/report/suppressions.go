@@ -0,0 +1,167 @@
+package report
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// suppressionDirective records the rule globs a single inline suppression
+// comment names and whether it has silenced anything yet, so UnusedIssues
+// can flag directives that never matched a finding.
+type suppressionDirective struct {
+	rules []string
+	// line is the line the directive applies to, or 0 for a whole-file
+	// (`githealth:ignore-file`) directive.
+	line int
+	used bool
+}
+
+// suppressionPattern matches `// githealth:ignore <rule>[,<rule>...]`,
+// `// githealth:ignore-next-line <rule>...`, or `// githealth:ignore-file
+// <rule>...` - as either a `//` or `#` comment, trailing code or standing on
+// its own line. A bare `*` rule silences everything the directive's scope
+// covers.
+var suppressionPattern = regexp.MustCompile(`(?://|#)\s*githealth:ignore(-file|-next-line)?\s+([\w*,\s-]+)`)
+
+// Suppressions post-filters analyzer findings against inline
+// `githealth:ignore` comments, reading each referenced file lazily via
+// readFile. It's applied once, after every analyzer has produced its
+// issues, so any analyzer's findings can be silenced the same way without
+// each one re-implementing directive parsing itself.
+type Suppressions struct {
+	readFile func(path string) ([]byte, error)
+	byFile   map[string][]*suppressionDirective
+	loaded   map[string]bool
+}
+
+// NewSuppressions builds a Suppressions that reads source files via
+// readFile, e.g. `os.ReadFile` rooted at the repository path.
+func NewSuppressions(readFile func(path string) ([]byte, error)) *Suppressions {
+	return &Suppressions{
+		readFile: readFile,
+		byFile:   make(map[string][]*suppressionDirective),
+		loaded:   make(map[string]bool),
+	}
+}
+
+// Apply drops every issue a directive in its File silences, and returns
+// the rest. Issues with no File (repository-wide findings with no source
+// line to attach a comment to) always pass through.
+func (s *Suppressions) Apply(issues []Issue) []Issue {
+	kept := issues[:0]
+	for _, issue := range issues {
+		if issue.File != "" && s.isSuppressed(issue) {
+			continue
+		}
+		kept = append(kept, issue)
+	}
+	return kept
+}
+
+// UnusedIssues returns one low-severity `unused-suppression` Issue per
+// directive that Apply never matched against a finding, the same
+// nolintlint-style diagnostic for a stale suppression comment. Call only
+// after Apply has run over the full, final issue list.
+func (s *Suppressions) UnusedIssues() []Issue {
+	var issues []Issue
+
+	for path, directives := range s.byFile {
+		for _, directive := range directives {
+			if directive.used {
+				continue
+			}
+			issues = append(issues, Issue{
+				ID:          fmt.Sprintf("unused-suppression-%s-%d", strings.ReplaceAll(path, "/", "-"), directive.line),
+				Title:       "Unused suppression directive",
+				Description: fmt.Sprintf("githealth:ignore directive for '%s' in %s never suppressed a finding", strings.Join(directive.rules, ","), path),
+				Category:    CategoryQuality,
+				Severity:    SeverityLow,
+				File:        path,
+				Line:        directive.line,
+				Rule:        "unused-suppression",
+				Fix:         "Remove the stale githealth:ignore comment",
+				CreatedAt:   time.Now(),
+			})
+		}
+	}
+
+	return issues
+}
+
+func (s *Suppressions) isSuppressed(issue Issue) bool {
+	suppressed := false
+	for _, directive := range s.directivesFor(issue.File) {
+		if directive.line != 0 && directive.line != issue.Line {
+			continue
+		}
+		if matchesAnyRuleGlob(directive, issue.Rule) {
+			suppressed = true
+		}
+	}
+	return suppressed
+}
+
+func matchesAnyRuleGlob(directive *suppressionDirective, rule string) bool {
+	matched := false
+	for _, glob := range directive.rules {
+		if ok, _ := filepath.Match(glob, rule); ok {
+			directive.used = true
+			matched = true
+		}
+	}
+	return matched
+}
+
+// directivesFor returns path's suppression directives, parsing the file via
+// readFile the first time it's referenced and caching the result (including
+// a read failure, as no directives) for every later lookup.
+func (s *Suppressions) directivesFor(path string) []*suppressionDirective {
+	if s.loaded[path] {
+		return s.byFile[path]
+	}
+	s.loaded[path] = true
+
+	content, err := s.readFile(path)
+	if err != nil {
+		return nil
+	}
+
+	var directives []*suppressionDirective
+	for i, line := range strings.Split(string(content), "\n") {
+		match := suppressionPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		rules := splitSuppressionRuleGlobs(match[2])
+		if len(rules) == 0 {
+			continue
+		}
+
+		lineNum := i + 1
+		switch match[1] {
+		case "-file":
+			lineNum = 0
+		case "-next-line":
+			lineNum = i + 2
+		}
+
+		directives = append(directives, &suppressionDirective{rules: rules, line: lineNum})
+	}
+
+	s.byFile[path] = directives
+	return directives
+}
+
+func splitSuppressionRuleGlobs(raw string) []string {
+	var rules []string
+	for _, rule := range strings.Split(raw, ",") {
+		if rule = strings.TrimSpace(rule); rule != "" {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
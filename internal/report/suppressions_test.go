@@ -0,0 +1,100 @@
+package report
+
+import (
+	"fmt"
+	"testing"
+)
+
+func fakeFileReader(files map[string]string) func(string) ([]byte, error) {
+	return func(path string) ([]byte, error) {
+		content, ok := files[path]
+		if !ok {
+			return nil, fmt.Errorf("no such file: %s", path)
+		}
+		return []byte(content), nil
+	}
+}
+
+func TestSuppressions_ExactLine(t *testing.T) {
+	files := map[string]string{
+		"main.go": "package main\n\nfunc f() { // githealth:ignore cyclomatic-complexity\n}\n",
+	}
+	suppressions := NewSuppressions(fakeFileReader(files))
+
+	issues := []Issue{
+		{File: "main.go", Line: 3, Rule: "cyclomatic-complexity"},
+		{File: "main.go", Line: 4, Rule: "cyclomatic-complexity"},
+	}
+
+	kept := suppressions.Apply(issues)
+	if len(kept) != 1 || kept[0].Line != 4 {
+		t.Fatalf("expected only the line-4 issue to survive, got %+v", kept)
+	}
+}
+
+func TestSuppressions_NextLine(t *testing.T) {
+	files := map[string]string{
+		"main.go": "package main\n\n// githealth:ignore-next-line large-function\nfunc f() {\n}\n",
+	}
+	suppressions := NewSuppressions(fakeFileReader(files))
+
+	issues := []Issue{{File: "main.go", Line: 4, Rule: "large-function"}}
+	kept := suppressions.Apply(issues)
+	if len(kept) != 0 {
+		t.Fatalf("expected the next-line issue to be suppressed, got %+v", kept)
+	}
+}
+
+func TestSuppressions_FileLevel(t *testing.T) {
+	files := map[string]string{
+		"generated.go": "// githealth:ignore-file large-file-lines\npackage main\n",
+	}
+	suppressions := NewSuppressions(fakeFileReader(files))
+
+	issues := []Issue{{File: "generated.go", Line: 500, Rule: "large-file-lines"}}
+	kept := suppressions.Apply(issues)
+	if len(kept) != 0 {
+		t.Fatalf("expected the file-level directive to suppress an issue anywhere in the file, got %+v", kept)
+	}
+}
+
+func TestSuppressions_Wildcard(t *testing.T) {
+	files := map[string]string{
+		"main.go": "package main // githealth:ignore *\n",
+	}
+	suppressions := NewSuppressions(fakeFileReader(files))
+
+	issues := []Issue{{File: "main.go", Line: 1, Rule: "anything-at-all"}}
+	kept := suppressions.Apply(issues)
+	if len(kept) != 0 {
+		t.Fatalf("expected a wildcard directive to suppress any rule, got %+v", kept)
+	}
+}
+
+func TestSuppressions_UnusedDirective(t *testing.T) {
+	files := map[string]string{
+		"main.go": "package main // githealth:ignore cyclomatic-complexity\n",
+	}
+	suppressions := NewSuppressions(fakeFileReader(files))
+
+	issues := []Issue{{File: "main.go", Line: 1, Rule: "unrelated-rule"}}
+	kept := suppressions.Apply(issues)
+	if len(kept) != 1 {
+		t.Fatalf("expected the unrelated issue to survive, got %+v", kept)
+	}
+
+	unused := suppressions.UnusedIssues()
+	if len(unused) != 1 || unused[0].Rule != "unused-suppression" {
+		t.Fatalf("expected a single unused-suppression diagnostic, got %+v", unused)
+	}
+}
+
+func TestSuppressions_NoFileAlwaysKept(t *testing.T) {
+	suppressions := NewSuppressions(fakeFileReader(nil))
+
+	issues := []Issue{{Rule: "dependency-outdated"}}
+	kept := suppressions.Apply(issues)
+	if len(kept) != 1 {
+		t.Fatalf("expected a file-less issue to pass through untouched, got %+v", kept)
+	}
+}
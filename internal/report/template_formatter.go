@@ -0,0 +1,43 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// TemplateFormatter renders a Report by executing a user-provided
+// text/template file over the *Report struct, letting callers produce
+// arbitrary output shapes (CSV, a custom dashboard payload, ...) without
+// writing Go. Selected via --format template --format-template <file>.
+type TemplateFormatter struct {
+	templatePath string
+}
+
+func NewTemplateFormatter(templatePath string) *TemplateFormatter {
+	return &TemplateFormatter{templatePath: templatePath}
+}
+
+func (f *TemplateFormatter) Format(report *Report) (string, error) {
+	if f.templatePath == "" {
+		return "", fmt.Errorf("template formatter requires --format-template <file>")
+	}
+
+	data, err := os.ReadFile(f.templatePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read template %s: %w", f.templatePath, err)
+	}
+
+	tmpl, err := template.New(f.templatePath).Parse(string(data))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template %s: %w", f.templatePath, err)
+	}
+
+	var output strings.Builder
+	if err := tmpl.Execute(&output, report); err != nil {
+		return "", fmt.Errorf("failed to execute template %s: %w", f.templatePath, err)
+	}
+
+	return output.String(), nil
+}
@@ -1,6 +1,9 @@
 package report
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 type Severity string
 
@@ -20,20 +23,97 @@ const (
 	CategoryMaintenance  Category = "maintenance"
 	CategoryWorkflow     Category = "workflow"
 	CategoryDependencies Category = "dependencies"
+	// CategoryAnalyzerError marks a non-fatal issue recording that an
+	// analyzer itself failed to run, rather than a finding about the
+	// repository being analyzed.
+	CategoryAnalyzerError Category = "analyzer-error"
 )
 
 type Issue struct {
-	ID          string    `json:"id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	Category    Category  `json:"category"`
-	Severity    Severity  `json:"severity"`
-	File        string    `json:"file,omitempty"`
-	Line        int       `json:"line,omitempty"`
-	Column      int       `json:"column,omitempty"`
-	Rule        string    `json:"rule,omitempty"`
-	Fix         string    `json:"fix,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID          string       `json:"id"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Category    Category     `json:"category"`
+	Severity    Severity     `json:"severity"`
+	File        string       `json:"file,omitempty"`
+	Line        int          `json:"line,omitempty"`
+	Column      int          `json:"column,omitempty"`
+	Rule        string       `json:"rule,omitempty"`
+	Fix         string       `json:"fix,omitempty"`
+	HelpURI     string       `json:"help_uri,omitempty"`
+	Remediation *Remediation `json:"remediation,omitempty"`
+	// SuggestedFixes holds structured, applyable alternatives to the
+	// free-text Fix sentence, where the analyzer that raised the issue can
+	// describe one. It's a slice (not a single field) because an issue can
+	// sometimes be resolved more than one way, e.g. "update" vs "remove".
+	SuggestedFixes []SuggestedFix `json:"suggested_fixes,omitempty"`
+	// Metadata carries analyzer-specific, free-form explanation for why an
+	// issue was flagged (e.g. the computed Shannon entropy of a secret
+	// candidate) that doesn't warrant its own typed field.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Verified is true once a secret-detection Issue has been confirmed
+	// live against its issuing service (see internal/verifier and --verify).
+	// It's false both when verification hasn't run and when it ran and
+	// found the credential invalid or revoked.
+	Verified bool `json:"verified,omitempty"`
+	// VerificationError records why verification couldn't confirm or deny
+	// the secret (e.g. the upstream service timed out), distinct from a
+	// verification that ran cleanly and came back invalid.
+	VerificationError string    `json:"verification_error,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+	// Author, AuthorEmail, LastModified and CommitHash attribute a
+	// file/line-scoped issue to whoever last touched that line, via
+	// git.Repository.BlameLine. They're populated lazily by the analyzer
+	// that raised the issue (unless --no-blame is set) and left zero for
+	// issues that aren't tied to a specific line, e.g. dependency findings.
+	// A HistorySecretAnalyzer finding sets them itself, to the commit that
+	// introduced the secret rather than whoever last touched HEAD.
+	Author       string    `json:"author,omitempty"`
+	AuthorEmail  string    `json:"author_email,omitempty"`
+	LastModified time.Time `json:"last_modified,omitempty"`
+	CommitHash   string    `json:"commit_hash,omitempty"`
+	// Rationale explains why this specific match triggered the rule - e.g.
+	// which regex captured which substring, or which entropy score crossed
+	// the configured threshold - distinct from Description, which states
+	// what was found. Left empty for analyzers whose rule logic is already
+	// self-evident from Title and Description alone.
+	Rationale string `json:"rationale,omitempty"`
+	// FixGuidance carries structured remediation metadata for CI consumers
+	// that want more than the free-text Fix sentence, distinct from
+	// Remediation, which describes a dependency bump `fix` can apply on its
+	// own. Left nil for analyzers that haven't been updated to populate it.
+	FixGuidance *FixGuidance `json:"fix_guidance,omitempty"`
+}
+
+// RemediationEffort estimates how much work resolving an Issue will take,
+// for FixGuidance.Effort.
+type RemediationEffort string
+
+const (
+	EffortLow    RemediationEffort = "low"
+	EffortMedium RemediationEffort = "medium"
+	EffortHigh   RemediationEffort = "high"
+)
+
+// FixGuidance is a structured counterpart to Issue.Fix: Patch sketches the
+// change to make (where SuggestedFixes doesn't already cover it with an
+// applyable TextEdit), DocURL points at rule-specific documentation, and
+// Probe describes how to verify the fix worked, e.g. re-running the check
+// that raised the issue.
+type FixGuidance struct {
+	Effort RemediationEffort `json:"effort"`
+	Patch  string            `json:"patch,omitempty"`
+	DocURL string            `json:"doc_url,omitempty"`
+	Probe  string            `json:"probe,omitempty"`
+}
+
+// Remediation carries a machine-readable description of how an issue can be
+// applied automatically, so CI and editor tooling don't need to parse Fix.
+type Remediation struct {
+	Type        string `json:"type"` // e.g. "go-get", "go-mod-tidy", "edit-file"
+	Package     string `json:"package,omitempty"`
+	FromVersion string `json:"from_version,omitempty"`
+	ToVersion   string `json:"to_version,omitempty"`
 }
 
 type Summary struct {
@@ -51,14 +131,36 @@ type CodeStats struct {
 	LanguagePercent   map[string]float64 `json:"language_percent"`
 }
 
+// EnrichHelpURIs fills in HelpURI for any issue that doesn't already have one,
+// so every finding carries a stable link to its rule documentation regardless
+// of which analyzer produced it.
+func EnrichHelpURIs(issues []Issue) {
+	for i := range issues {
+		if issues[i].HelpURI == "" && issues[i].Rule != "" {
+			issues[i].HelpURI = fmt.Sprintf("https://github.com/githealthchecker/git-health-checker/blob/main/docs/rules/%s.md", issues[i].Rule)
+		}
+	}
+}
+
+// AnalyzerStats records resource usage for a single analyzer's run, the
+// same pair of numbers KICS reports per phase as "Total CPU usage for
+// <phase>" and "Total MEM usage for <phase>".
+type AnalyzerStats struct {
+	Name           string `json:"name"`
+	CPUMillis      int64  `json:"cpu_millis"`
+	PeakAllocBytes uint64 `json:"peak_alloc_bytes"`
+	ElapsedMillis  int64  `json:"elapsed_millis"`
+}
+
 type Report struct {
-	Repository string    `json:"repository"`
-	Branch     string    `json:"branch"`
-	CommitHash string    `json:"commit_hash"`
-	Timestamp  time.Time `json:"timestamp"`
-	Summary    Summary   `json:"summary"`
-	CodeStats  CodeStats `json:"code_stats"`
-	Issues     []Issue   `json:"issues"`
-	Duration   string    `json:"duration"`
-	Version    string    `json:"version"`
+	Repository    string          `json:"repository"`
+	Branch        string          `json:"branch"`
+	CommitHash    string          `json:"commit_hash"`
+	Timestamp     time.Time       `json:"timestamp"`
+	Summary       Summary         `json:"summary"`
+	CodeStats     CodeStats       `json:"code_stats"`
+	Issues        []Issue         `json:"issues"`
+	Duration      string          `json:"duration"`
+	Version       string          `json:"version"`
+	AnalyzerStats []AnalyzerStats `json:"analyzer_stats,omitempty"`
 }
@@ -0,0 +1,50 @@
+package scanner
+
+import "testing"
+
+func TestFileScanner_IsTextFromBytes(t *testing.T) {
+	scanner := &FileScanner{}
+
+	cases := []struct {
+		name string
+		b    []byte
+		want bool
+	}{
+		{"empty", nil, true},
+		{"ascii text", []byte("package main\n\nfunc main() {}\n"), true},
+		{"valid utf8", []byte("héllo wörld\n"), true},
+		{"nul byte", []byte("hello\x00world"), false},
+		{"utf16 text", []byte("h\x00e\x00l\x00l\x00o\x00"), false},
+		{"mostly control bytes", []byte{0x01, 0x02, 0x03, 0x04, 0x05, 'a', 0x06, 0x07}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := scanner.isTextFromBytes(tc.b); got != tc.want {
+				t.Errorf("isTextFromBytes(%q) = %v, want %v", tc.b, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSniffMimeType(t *testing.T) {
+	cases := []struct {
+		name string
+		head []byte
+		want string
+	}{
+		{"png", []byte("\x89PNG\r\n\x1a\nrest"), "image/png"},
+		{"zip", []byte("PK\x03\x04rest"), "application/zip"},
+		{"elf", []byte("\x7fELFrest"), "application/x-elf"},
+		{"pdf", []byte("%PDF-1.4"), "application/pdf"},
+		{"plain text", []byte("package main\n"), ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := sniffMimeType(tc.head); got != tc.want {
+				t.Errorf("sniffMimeType(%q) = %q, want %q", tc.head, got, tc.want)
+			}
+		})
+	}
+}
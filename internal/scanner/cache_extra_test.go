@@ -0,0 +1,107 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileScanner_RescanChanged(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "rescan_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer func(path string) {
+		if err := os.RemoveAll(path); err != nil {
+			t.Fatalf("Failed to clean up temp directory %s: %v", path, err)
+		}
+	}(tempDir)
+
+	path := filepath.Join(tempDir, "main.go")
+	if err := os.WriteFile(path, []byte("package main\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	scanner, err := NewFileScanner(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create scanner: %v", err)
+	}
+	defer scanner.Close()
+
+	if _, err := scanner.ScanAllFiles(); err != nil {
+		t.Fatalf("ScanAllFiles failed: %v", err)
+	}
+
+	changed, err := scanner.RescanChanged()
+	if err != nil {
+		t.Fatalf("RescanChanged failed: %v", err)
+	}
+	if len(changed) != 0 {
+		t.Errorf("Expected no changes on an untouched tree, got %v", changed)
+	}
+
+	// mtime resolution on some filesystems is 1s; back-date then rewrite so
+	// the new mtime is unambiguously different from what's cached.
+	past := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(path, past, past); err != nil {
+		t.Fatalf("Failed to backdate file: %v", err)
+	}
+	if err := os.WriteFile(path, []byte("package main\n\nfunc main() {}\n"), 0644); err != nil {
+		t.Fatalf("Failed to rewrite test file: %v", err)
+	}
+
+	changed, err = scanner.RescanChanged()
+	if err != nil {
+		t.Fatalf("RescanChanged failed: %v", err)
+	}
+	if len(changed) != 1 || changed[0] != "main.go" {
+		t.Fatalf("Expected main.go to be reported changed, got %v", changed)
+	}
+
+	updated, ok := scanner.GetCachedFile("main.go")
+	if !ok {
+		t.Fatal("Expected main.go to still be cached after rescan")
+	}
+	if updated.LineCount != 3 {
+		t.Errorf("Expected updated LineCount 3, got %d", updated.LineCount)
+	}
+}
+
+func TestFileCache_JanitorEvictsIdleEntries(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "janitor_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer func(path string) {
+		if err := os.RemoveAll(path); err != nil {
+			t.Fatalf("Failed to clean up temp directory %s: %v", path, err)
+		}
+	}(tempDir)
+
+	path := filepath.Join(tempDir, "main.go")
+	if err := os.WriteFile(path, []byte("package main\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	scanner, err := NewFileScanner(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create scanner: %v", err)
+	}
+	defer scanner.Close()
+	scanner.SetCacheDuration(20 * time.Millisecond)
+
+	if _, err := scanner.ScanAllFiles(); err != nil {
+		t.Fatalf("ScanAllFiles failed: %v", err)
+	}
+
+	if _, ok := scanner.GetCachedFile("main.go"); !ok {
+		t.Fatal("Expected main.go to be cached right after scanning")
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	if _, ok := scanner.GetCachedFile("main.go"); ok {
+		t.Error("Expected janitor to have evicted main.go after it went idle past cacheDuration")
+	}
+}
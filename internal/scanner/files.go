@@ -2,23 +2,49 @@ package scanner
 
 import (
 	"bufio"
+	"bytes"
+	"context"
 	"fmt"
+	"hash/fnv"
 	"io"
+	iofs "io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
+	"runtime"
 	"strings"
 	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/githealthchecker/git-health-checker/internal/scope"
 )
 
 const fileCloseErrorMsg = "Error closing file %s: %v\n"
 
+// cacheShardCount is the number of independent locks FileCache spreads its
+// entries across. Sharding by hash(relPath) lets ScanAllFiles's worker pool
+// write results in parallel instead of serializing on one mutex.
+const cacheShardCount = 16
+
+// defaultCacheDuration is how long a FileCache entry survives without being
+// accessed, and how often its disk mtime is re-checked, before the janitor
+// evicts it. Modeled on fasthttp's fsHandler cache-duration mechanism.
+const defaultCacheDuration = 10 * time.Second
+
 type FileScanner struct {
 	rootPath     string
-	gitIgnores   []string
+	fsys         iofs.FS
+	ignorer      *IgnoreMatcher
 	patterns     map[string]*regexp.Regexp
 	cache        *FileCache
 	maxCacheSize int64 // Max file size to cache content (default 1MB)
+	jobs         int   // Max files processed concurrently in ScanAllFiles
+	// scope, when set, restricts ScanAllFiles to paths matching its
+	// include/exclude package patterns (see internal/scope), so callers
+	// like --scope consume a pre-filtered set instead of walking the
+	// whole repository themselves.
+	scope *scope.Scope
 }
 
 type FileInfo struct {
@@ -41,194 +67,543 @@ type UnifiedFileInfo struct {
 	Content      []byte // Cached content for small files (<1MB)
 	FirstBytes   []byte // First 512 bytes for binary detection
 	ModTime      int64  // Modification time
+	// MimeType is the format sniffed from FirstBytes's magic number, e.g.
+	// "image/png" or "application/zip", or "" if it matched none - not a
+	// general-purpose content-type detector, just enough for callers to
+	// skip known-binary formats with confidence instead of guessing from
+	// Extension.
+	MimeType string
+}
+
+// cacheEntry wraps one cached UnifiedFileInfo with the bookkeeping the
+// janitor uses to decide when to evict it.
+type cacheEntry struct {
+	info       *UnifiedFileInfo
+	lastAccess time.Time
+}
+
+// fileCacheShard is one of FileCache's independently-locked partitions.
+type fileCacheShard struct {
+	mu      sync.RWMutex
+	entries map[string]*cacheEntry
 }
 
-// FileCache provides thread-safe access to cached file information
+// FileCache provides thread-safe access to cached file information, sharded
+// across cacheShardCount locks keyed by hash(relativePath) so concurrent
+// scanner workers don't serialize on a single mutex. A background janitor
+// evicts entries that haven't been accessed within cacheDuration, or whose
+// on-disk mtime has since changed, so a long-running caller (e.g. watch
+// mode) doesn't keep serving stale content forever.
 type FileCache struct {
-	mu    sync.RWMutex
-	files map[string]*UnifiedFileInfo
+	shards        [cacheShardCount]fileCacheShard
+	cacheDuration time.Duration
+	// checkChanged reports whether a cached entry's underlying file has
+	// changed since it was cached. It's supplied by the owning FileScanner
+	// (bound to its fsys) rather than hardcoded to os.Stat, so the cache
+	// works the same way against a non-OS fs.FS.
+	checkChanged func(*UnifiedFileInfo) bool
+	stop         chan struct{}
+	stopOnce     sync.Once
 }
 
-func NewFileScanner(rootPath string) (*FileScanner, error) {
-	absPath, err := filepath.Abs(rootPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get absolute path: %w", err)
+func newFileCache(cacheDuration time.Duration, checkChanged func(*UnifiedFileInfo) bool) *FileCache {
+	cache := &FileCache{cacheDuration: cacheDuration, checkChanged: checkChanged, stop: make(chan struct{})}
+	for i := range cache.shards {
+		cache.shards[i].entries = make(map[string]*cacheEntry)
+	}
+	go cache.runJanitor(cacheDuration, cache.stop)
+	return cache
+}
+
+// shardFor returns the shard relPath is stored in. FNV-1a is used purely to
+// spread keys evenly across shards, not for any cryptographic property.
+func (c *FileCache) shardFor(relPath string) *fileCacheShard {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(relPath))
+	return &c.shards[h.Sum32()%cacheShardCount]
+}
+
+// reset clears every shard, e.g. before a fresh ScanAllFiles pass.
+func (c *FileCache) reset() {
+	for i := range c.shards {
+		c.shards[i].mu.Lock()
+		c.shards[i].entries = make(map[string]*cacheEntry)
+		c.shards[i].mu.Unlock()
 	}
+}
+
+func (c *FileCache) set(relPath string, info *UnifiedFileInfo) {
+	shard := c.shardFor(relPath)
+	shard.mu.Lock()
+	shard.entries[relPath] = &cacheEntry{info: info, lastAccess: time.Now()}
+	shard.mu.Unlock()
+}
+
+func (c *FileCache) delete(relPath string) {
+	shard := c.shardFor(relPath)
+	shard.mu.Lock()
+	delete(shard.entries, relPath)
+	shard.mu.Unlock()
+}
+
+func (c *FileCache) get(relPath string) (*UnifiedFileInfo, bool) {
+	shard := c.shardFor(relPath)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	entry, ok := shard.entries[relPath]
+	if !ok {
+		return nil, false
+	}
+	entry.lastAccess = time.Now()
+	return entry.info, true
+}
+
+// all returns a copy of every cached file, merged across shards.
+func (c *FileCache) all() map[string]*UnifiedFileInfo {
+	now := time.Now()
+	cp := make(map[string]*UnifiedFileInfo)
+	for i := range c.shards {
+		c.shards[i].mu.Lock()
+		for k, v := range c.shards[i].entries {
+			v.lastAccess = now
+			cp[k] = v.info
+		}
+		c.shards[i].mu.Unlock()
+	}
+	return cp
+}
 
+// runJanitor wakes every duration and evicts entries that have gone
+// unaccessed for a full duration, or whose file has changed on disk since
+// it was cached - the same idle-eviction plus freshness-check shape
+// fasthttp's fsHandler cache uses for its in-memory file cache. duration
+// and stop are taken as parameters, rather than read from c, so a
+// concurrent setDuration swapping those fields can never race this loop.
+func (c *FileCache) runJanitor(duration time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(duration)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.evictStale(duration)
+		case <-stop:
+			return
+		}
+	}
+}
+
+// evictStale removes every entry that's either idle past duration or whose
+// on-disk size/mtime no longer matches what was cached.
+func (c *FileCache) evictStale(duration time.Duration) {
+	now := time.Now()
+	for i := range c.shards {
+		shard := &c.shards[i]
+		shard.mu.Lock()
+		for relPath, entry := range shard.entries {
+			if now.Sub(entry.lastAccess) >= duration || c.checkChanged(entry.info) {
+				delete(shard.entries, relPath)
+			}
+		}
+		shard.mu.Unlock()
+	}
+}
+
+// close stops the janitor goroutine. Safe to call more than once.
+func (c *FileCache) close() {
+	c.stopOnce.Do(func() { close(c.stop) })
+}
+
+// setDuration replaces cacheDuration and restarts the janitor so the new
+// interval takes effect immediately, rather than waiting for the old
+// ticker to fire once more first.
+func (c *FileCache) setDuration(d time.Duration) {
+	c.close()
+	c.cacheDuration = d
+	stop := make(chan struct{})
+	c.stop = stop
+	c.stopOnce = sync.Once{}
+	go c.runJanitor(d, stop)
+}
+
+// newFileScanner builds a FileScanner over fsys, labeling its results with
+// rootPath. Shared by NewFileScanner and NewFileScannerFS so both paths wire
+// up the ignorer and cache the same way.
+func newFileScanner(fsys iofs.FS, rootPath string) *FileScanner {
 	scanner := &FileScanner{
-		rootPath:     absPath,
+		rootPath:     rootPath,
+		fsys:         fsys,
+		ignorer:      NewIgnoreMatcher(fsys),
 		patterns:     make(map[string]*regexp.Regexp),
-		cache:        &FileCache{files: make(map[string]*UnifiedFileInfo)},
 		maxCacheSize: 1024 * 1024, // 1MB default
+		jobs:         runtime.GOMAXPROCS(0),
 	}
+	scanner.cache = newFileCache(defaultCacheDuration, scanner.fileChanged)
+	return scanner
+}
 
-	if err := scanner.loadGitIgnores(); err != nil {
-		return nil, fmt.Errorf("failed to load .gitignore: %w", err)
+// NewFileScanner creates a FileScanner rooted at rootPath on the real
+// filesystem.
+func NewFileScanner(rootPath string) (*FileScanner, error) {
+	absPath, err := filepath.Abs(rootPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get absolute path: %w", err)
 	}
 
-	return scanner, nil
+	return newFileScanner(os.DirFS(absPath), absPath), nil
+}
+
+// NewFileScannerFS creates a FileScanner backed by fsys instead of the real
+// filesystem - an in-memory fstest.MapFS in tests, or a git-tree fs.FS (see
+// internal/git's Repository.TreeFS) to scan a specific commit without
+// checking it out. root is used only to render UnifiedFileInfo.Path and to
+// scope .gitignore lookups; it doesn't need to be a real filesystem path.
+func NewFileScannerFS(fsys iofs.FS, root string) (*FileScanner, error) {
+	return newFileScanner(fsys, root), nil
+}
+
+// fileChanged reports whether relPath's size or mtime, as seen through
+// fs.fsys, no longer matches what was recorded in info when it was cached -
+// including the file having been removed entirely.
+func (fs *FileScanner) fileChanged(info *UnifiedFileInfo) bool {
+	stat, err := iofs.Stat(fs.fsys, info.RelativePath)
+	if err != nil {
+		return true
+	}
+	return stat.Size() != info.Size || stat.ModTime().Unix() != info.ModTime
+}
+
+// SetCacheDuration overrides how long GetCachedFiles/GetCachedFile entries
+// may sit idle, and how often their on-disk mtime is re-checked, before the
+// cache's background janitor evicts them. NewFileScanner defaults this to
+// defaultCacheDuration; a long-running caller (e.g. watch mode) may want it
+// shorter so edits are picked up sooner, or longer to reduce stat traffic.
+func (fs *FileScanner) SetCacheDuration(d time.Duration) {
+	fs.cache.setDuration(d)
 }
 
-// ScanAllFiles performs a single traversal collecting all file information
+// Close stops the cache's background janitor goroutine. Callers that create
+// a FileScanner for a single scan-then-exit don't need to call this; it
+// matters for long-running processes that construct many scanners over
+// their lifetime.
+func (fs *FileScanner) Close() {
+	fs.cache.close()
+}
+
+// SetJobs bounds how many files ScanAllFiles analyzes concurrently. Values
+// below 1 fall back to a single worker, i.e. the scanner's original
+// sequential behavior. NewFileScanner defaults this to runtime.GOMAXPROCS(0).
+func (fs *FileScanner) SetJobs(jobs int) {
+	fs.jobs = jobs
+}
+
+// SetScope restricts ScanAllFiles to paths s matches, letting callers focus
+// a scan on a subtree of a monorepo (e.g. "./cmd/...", "-internal/testdata/...").
+// A nil scope (the default) matches every file, preserving the scanner's
+// original whole-repository behavior.
+func (fs *FileScanner) SetScope(s *scope.Scope) {
+	fs.scope = s
+}
+
+// unifiedPath is a file path discovered by the directory walk, queued for
+// the concurrent content-analysis stage.
+type unifiedPath struct {
+	path string
+	info iofs.FileInfo
+}
+
+// ScanAllFiles runs the directory walk and the per-file content analysis as
+// a producer/consumer pipeline: one walker goroutine feeds discovered paths
+// into a buffered channel, and up to fs.jobs worker goroutines drain it in
+// parallel, so the expensive part - file reads and line counting - overlaps
+// the walk instead of waiting for it to finish first.
 func (fs *FileScanner) ScanAllFiles() (map[string]*UnifiedFileInfo, error) {
-	fs.cache.mu.Lock()
-	defer fs.cache.mu.Unlock()
+	fs.cache.reset()
 
-	// Clear existing cache
-	fs.cache.files = make(map[string]*UnifiedFileInfo)
+	jobs := fs.jobs
+	if jobs < 1 {
+		jobs = 1
+	}
 
-	err := filepath.Walk(fs.rootPath, func(path string, info os.FileInfo, err error) error {
-		return fs.processUnifiedPath(path, info, err)
-	})
+	paths := make(chan unifiedPath, jobs*4)
+	walkErr := make(chan error, 1)
 
-	if err != nil {
+	go func() {
+		defer close(paths)
+		walkErr <- fs.walkUnifiedPaths(paths)
+	}()
+
+	fs.processPathsConcurrently(paths, jobs)
+
+	if err := <-walkErr; err != nil {
 		return nil, fmt.Errorf("failed to scan files: %w", err)
 	}
 
-	return fs.cache.files, nil
+	return fs.GetCachedFiles(), nil
 }
 
-// processUnifiedPath processes each file/directory in the unified scan
-func (fs *FileScanner) processUnifiedPath(path string, info os.FileInfo, err error) error {
-	if err != nil {
-		return err
-	}
+// RescanChanged stats every currently cached file and re-runs
+// analyzeFileContent for the ones whose size or mtime no longer match what
+// was cached, or that have been deleted, returning their relative paths.
+// Unlike ScanAllFiles it never walks the directory tree, so a long-running
+// caller (e.g. watch mode) can pick up edits to an already-scanned tree
+// without paying to rediscover files that haven't moved.
+func (fs *FileScanner) RescanChanged() ([]string, error) {
+	var changed []string
+
+	for relPath, cached := range fs.GetCachedFiles() {
+		info, err := iofs.Stat(fs.fsys, relPath)
+		if err != nil {
+			fs.cache.delete(relPath)
+			changed = append(changed, relPath)
+			continue
+		}
+
+		if info.Size() == cached.Size && info.ModTime().Unix() == cached.ModTime {
+			continue
+		}
 
-	if shouldSkip := fs.shouldSkipDirectory(path, info); shouldSkip != nil {
-		return shouldSkip
+		updated := fs.createFileInfo(relPath, info)
+		fs.analyzeFileContent(updated)
+		fs.cache.set(relPath, updated)
+		changed = append(changed, relPath)
 	}
 
-	if info.IsDir() {
+	return changed, nil
+}
+
+// walkUnifiedPaths walks the tree sequentially - fs.WalkDir's SkipDir
+// semantics require visiting directories in order - and sends every regular
+// file it finds to paths for the worker pool to analyze concurrently.
+func (fs *FileScanner) walkUnifiedPaths(paths chan<- unifiedPath) error {
+	return iofs.WalkDir(fs.fsys, ".", func(path string, d iofs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if shouldSkip := fs.shouldSkipDirectory(path, d); shouldSkip != nil {
+			return shouldSkip
+		}
+
+		if d.IsDir() || fs.shouldSkipPath(path) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		paths <- unifiedPath{path: path, info: info}
 		return nil
+	})
+}
+
+// processPathsConcurrently drains paths with a fixed pool of jobs worker
+// goroutines, each calling processFile until the channel is closed.
+func (fs *FileScanner) processPathsConcurrently(paths <-chan unifiedPath, jobs int) {
+	var wg sync.WaitGroup
+	wg.Add(jobs)
+
+	for i := 0; i < jobs; i++ {
+		go func() {
+			defer wg.Done()
+			for p := range paths {
+				_ = fs.processFile(p.path, p.info)
+			}
+		}()
 	}
 
-	return fs.processFile(path, info)
+	wg.Wait()
 }
 
-// shouldSkipDirectory checks if a directory should be skipped
-func (fs *FileScanner) shouldSkipDirectory(path string, info os.FileInfo) error {
-	if fs.shouldSkipPath(path) {
-		if info.IsDir() {
-			return filepath.SkipDir
-		}
-		return filepath.SkipDir
+// shouldSkipDirectory reports how a WalkDirFunc should handle an entry
+// matched by shouldSkipPath: iofs.SkipDir for a directory (skip its whole
+// subtree), or iofs.SkipDir is never returned for a plain file - per
+// fs.WalkDir's documented contract, SkipDir from a non-directory entry
+// aborts the rest of that entry's siblings too, not just the one file.
+func (fs *FileScanner) shouldSkipDirectory(path string, d iofs.DirEntry) error {
+	if fs.shouldSkipPath(path) && d.IsDir() {
+		return iofs.SkipDir
 	}
 	return nil
 }
 
-// processFile processes a single file and adds it to cache
-func (fs *FileScanner) processFile(path string, info os.FileInfo) error {
-	relPath, err := filepath.Rel(fs.rootPath, path)
-	if err != nil {
-		return err
+// processFile processes a single file, discovered at relPath (fs.fsys
+// relative), and adds it to cache
+func (fs *FileScanner) processFile(relPath string, info iofs.FileInfo) error {
+	if fs.shouldIgnore(relPath) {
+		return nil
 	}
 
-	if fs.shouldIgnore(relPath) {
+	if !fs.scope.Match(relPath) {
 		return nil
 	}
 
-	uniFileInfo := fs.createFileInfo(path, relPath, info)
+	uniFileInfo := fs.createFileInfo(relPath, info)
 	fs.analyzeFileContent(uniFileInfo)
-	fs.cache.files[relPath] = uniFileInfo
+
+	fs.cache.set(relPath, uniFileInfo)
 	return nil
 }
 
 // createFileInfo creates basic file information
-func (fs *FileScanner) createFileInfo(path, relPath string, info os.FileInfo) *UnifiedFileInfo {
+func (fs *FileScanner) createFileInfo(relPath string, info iofs.FileInfo) *UnifiedFileInfo {
 	return &UnifiedFileInfo{
-		Path:         path,
+		Path:         fs.displayPath(relPath),
 		RelativePath: relPath,
 		Size:         info.Size(),
-		Extension:    strings.ToLower(filepath.Ext(path)),
+		Extension:    strings.ToLower(filepath.Ext(relPath)),
 		ModTime:      info.ModTime().Unix(),
 	}
 }
 
-// analyzeFileContent analyzes file content and populates cache
+// displayPath renders relPath (fs.fsys-relative, slash-separated) the way
+// fs.rootPath would show it on disk - the form UnifiedFileInfo.Path needs
+// for an OS-backed scanner, whose callers open it directly via os.ReadFile.
+// For a non-OS fsys it's purely a label.
+func (fs *FileScanner) displayPath(relPath string) string {
+	if relPath == "." {
+		return fs.rootPath
+	}
+	return filepath.Join(fs.rootPath, filepath.FromSlash(relPath))
+}
+
+// analyzeFileContent reads fileInfo.Path exactly once - up to maxCacheSize
+// bytes for files that fit the content cache, or just 512 bytes for files
+// that don't - and reuses that single read for binary detection, content
+// caching, and line counting, instead of opening the file separately for
+// each one.
 func (fs *FileScanner) analyzeFileContent(fileInfo *UnifiedFileInfo) {
-	// Read first bytes for binary detection
-	if err := fs.readFirstBytes(fileInfo); err != nil {
-		fileInfo.IsText = false
-		return
+	readSize := fs.maxCacheSize
+	cacheable := fileInfo.Size <= fs.maxCacheSize
+	if !cacheable {
+		readSize = 512
 	}
 
-	fileInfo.IsText = fs.isTextFromBytes(fileInfo.FirstBytes)
-	if !fileInfo.IsText {
+	head, err := fs.readHead(fileInfo.RelativePath, readSize)
+	if err != nil {
+		fileInfo.IsText = false
 		return
 	}
 
-	fs.handleTextFileContent(fileInfo)
-	fs.calculateLineCount(fileInfo)
-}
+	firstBytesLen := len(head)
+	if firstBytesLen > 512 {
+		firstBytesLen = 512
+	}
+	fileInfo.FirstBytes = head[:firstBytesLen]
+	fileInfo.MimeType = sniffMimeType(fileInfo.FirstBytes)
 
-// handleTextFileContent caches content for small text files
-func (fs *FileScanner) handleTextFileContent(fileInfo *UnifiedFileInfo) {
-	if fileInfo.Size <= fs.maxCacheSize {
-		err := fs.cacheFileContent(fileInfo)
-		if err != nil {
-			return
-		} // Ignore errors, non-critical
+	fileInfo.IsText = fileInfo.MimeType == "" && fs.isTextFromBytes(fileInfo.FirstBytes)
+	if !fileInfo.IsText {
+		return
 	}
-}
 
-// calculateLineCount calculates line count from cache or file
-func (fs *FileScanner) calculateLineCount(fileInfo *UnifiedFileInfo) {
-	if len(fileInfo.Content) > 0 {
+	if cacheable {
+		fileInfo.Content = head
 		fileInfo.LineCount = fs.countLinesFromBytes(fileInfo.Content)
-	} else {
-		if lineCount, err := fs.countLines(fileInfo.Path); err == nil {
-			fileInfo.LineCount = lineCount
-		}
+	} else if lineCount, err := fs.countLines(fileInfo.RelativePath); err == nil {
+		fileInfo.LineCount = lineCount
 	}
 }
 
-// readFirstBytes reads the first 512 bytes for binary detection
-func (fs *FileScanner) readFirstBytes(fileInfo *UnifiedFileInfo) error {
-	file, err := os.Open(fileInfo.Path)
+// readHead opens relPath once and reads up to n bytes, returning whatever
+// was read even if the file is shorter than n - a short read is expected,
+// not an error, since n is usually maxCacheSize rather than the true file
+// size.
+func (fs *FileScanner) readHead(relPath string, n int64) ([]byte, error) {
+	file, err := fs.fsys.Open(relPath)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer func(file *os.File) {
+	defer func(file iofs.File) {
 		err := file.Close()
 		if err != nil {
-			fmt.Printf(fileCloseErrorMsg, fileInfo.Path, err)
+			fmt.Printf(fileCloseErrorMsg, relPath, err)
 		}
 	}(file)
 
-	fileInfo.FirstBytes = make([]byte, 512)
-	n, err := file.Read(fileInfo.FirstBytes)
-	if err != nil && err != io.EOF {
-		return err
+	buf := make([]byte, n)
+	read, err := io.ReadFull(file, buf)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
 	}
-	fileInfo.FirstBytes = fileInfo.FirstBytes[:n]
-	return nil
+	return buf[:read], nil
 }
 
-// cacheFileContent caches the entire file content for small files
-func (fs *FileScanner) cacheFileContent(fileInfo *UnifiedFileInfo) error {
-	file, err := os.Open(fileInfo.Path)
-	if err != nil {
-		return err
+// isTextFromBytes reports whether b looks like text, modeled on the
+// git/file(1) heuristic: a NUL byte anywhere rules it out outright (which
+// also catches UTF-16, since it encodes every other byte of ASCII text as
+// \x00), then the fraction of bytes that are neither printable ASCII nor
+// part of a valid UTF-8 rune is compared against a 30% threshold. Scattered
+// control bytes are normal in text (tabs, CR, an occasional non-ASCII
+// rune), but a binary format with no matching magic number is usually
+// mostly such bytes.
+func (fs *FileScanner) isTextFromBytes(b []byte) bool {
+	if bytes.IndexByte(b, 0) >= 0 {
+		return false
+	}
+	if len(b) == 0 {
+		return true
 	}
-	defer func(file *os.File) {
-		err := file.Close()
-		if err != nil {
-			fmt.Printf(fileCloseErrorMsg, fileInfo.Path, err)
+
+	var nonText int
+	for i := 0; i < len(b); {
+		c := b[i]
+		if c == '\t' || c == '\n' || c == '\r' || (c >= 0x20 && c < 0x7f) {
+			i++
+			continue
 		}
-	}(file)
+		if c < 0x80 {
+			nonText++
+			i++
+			continue
+		}
+
+		r, size := utf8.DecodeRune(b[i:])
+		if r == utf8.RuneError && size <= 1 {
+			nonText++
+			i++
+			continue
+		}
+		i += size
+	}
 
-	fileInfo.Content, err = io.ReadAll(file)
-	return err
+	return float64(nonText)/float64(len(b)) <= 0.3
 }
 
-// isTextFromBytes determines if file is text based on byte content
-func (fs *FileScanner) isTextFromBytes(bytes []byte) bool {
-	for i := 0; i < len(bytes); i++ {
-		if bytes[i] == 0 {
-			return false
+// magicNumbers maps well-known file-format prefixes to their MIME type, so
+// sniffMimeType can classify a file even when isTextFromBytes's printable-
+// byte ratio alone wouldn't catch it - a handful of PNG/ZIP payloads are
+// mostly printable bytes in their first 512.
+var magicNumbers = []struct {
+	prefix []byte
+	mime   string
+}{
+	{[]byte("\x89PNG\r\n\x1a\n"), "image/png"},
+	{[]byte("\xff\xd8\xff"), "image/jpeg"},
+	{[]byte("GIF87a"), "image/gif"},
+	{[]byte("GIF89a"), "image/gif"},
+	{[]byte("\x7fELF"), "application/x-elf"},
+	{[]byte("%PDF-"), "application/pdf"},
+	{[]byte("PK\x03\x04"), "application/zip"},
+	{[]byte("PK\x05\x06"), "application/zip"},
+	{[]byte("\x1f\x8b"), "application/gzip"},
+	{[]byte("BM"), "image/bmp"},
+	{[]byte("MZ"), "application/x-msdownload"},
+	{[]byte("%!PS"), "application/postscript"},
+}
+
+// sniffMimeType returns the MIME type matching head's magic number, or ""
+// if head matches none of the formats in magicNumbers.
+func sniffMimeType(head []byte) string {
+	for _, m := range magicNumbers {
+		if bytes.HasPrefix(head, m.prefix) {
+			return m.mime
 		}
 	}
-	return true
+	return ""
 }
 
 // countLinesFromBytes counts lines from cached byte content
@@ -247,15 +622,15 @@ func (fs *FileScanner) countLinesFromBytes(content []byte) int {
 }
 
 // countLinesStreaming counts lines in large files using streaming
-func (fs *FileScanner) countLinesStreaming(path string) (int, error) {
-	file, err := os.Open(path)
+func (fs *FileScanner) countLinesStreaming(relPath string) (int, error) {
+	file, err := fs.fsys.Open(relPath)
 	if err != nil {
 		return 0, err
 	}
-	defer func(file *os.File) {
+	defer func(file iofs.File) {
 		err := file.Close()
 		if err != nil {
-			fmt.Printf(fileCloseErrorMsg, path, err)
+			fmt.Printf(fileCloseErrorMsg, relPath, err)
 		}
 	}(file)
 
@@ -263,7 +638,7 @@ func (fs *FileScanner) countLinesStreaming(path string) (int, error) {
 }
 
 // streamingLineCount performs the actual streaming line count
-func (fs *FileScanner) streamingLineCount(file *os.File) (int, error) {
+func (fs *FileScanner) streamingLineCount(file iofs.File) (int, error) {
 	const bufferSize = 64 * 1024 // 64KB buffer
 	buffer := make([]byte, bufferSize)
 	count := 0
@@ -314,73 +689,58 @@ func (fs *FileScanner) adjustFinalLineCount(count int, lastCharWasNewline bool)
 }
 
 // searchInFileStreaming searches for patterns in large files using streaming
-func (fs *FileScanner) searchInFileStreaming(path string, regex *regexp.Regexp) ([]Match, error) {
-	file, err := os.Open(path)
+func (fs *FileScanner) searchInFileStreaming(ctx context.Context, relPath string, regex *regexp.Regexp, matches chan<- Match) error {
+	file, err := fs.fsys.Open(relPath)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	defer func(file *os.File) {
+	defer func(file iofs.File) {
 		err := file.Close()
 		if err != nil {
-			fmt.Printf(fileCloseErrorMsg, path, err)
+			fmt.Printf(fileCloseErrorMsg, relPath, err)
 		}
 	}(file)
 
-	var matches []Match
 	scanner := bufio.NewScanner(file)
 	lineNum := 1
 
-	relPath, _ := filepath.Rel(fs.rootPath, path)
-
 	// Use a larger buffer for better performance on large files
 	buf := make([]byte, 0, 256*1024) // 256KB buffer
 	scanner.Buffer(buf, 1024*1024)   // 1MB max token size
 
 	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
 		line := scanner.Text()
 		if regex.MatchString(line) {
-			matches = append(matches, Match{
-				File:    relPath,
-				Line:    lineNum,
-				Content: line,
-				Pattern: regex.String(),
-			})
+			select {
+			case matches <- Match{File: relPath, Line: lineNum, Content: line, Pattern: regex.String()}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
 		}
 		lineNum++
 	}
 
-	return matches, scanner.Err()
+	return scanner.Err()
 }
 
 // GetCachedFiles returns cached file information (thread-safe)
 func (fs *FileScanner) GetCachedFiles() map[string]*UnifiedFileInfo {
-	fs.cache.mu.RLock()
-	defer fs.cache.mu.RUnlock()
-
-	// Return copy to prevent modification
-	cp := make(map[string]*UnifiedFileInfo)
-	for k, v := range fs.cache.files {
-		cp[k] = v
-	}
-	return cp
+	return fs.cache.all()
 }
 
 // GetCachedFile returns a specific cached file (thread-safe)
 func (fs *FileScanner) GetCachedFile(relativePath string) (*UnifiedFileInfo, bool) {
-	fs.cache.mu.RLock()
-	defer fs.cache.mu.RUnlock()
-
-	file, exists := fs.cache.files[relativePath]
-	return file, exists
+	return fs.cache.get(relativePath)
 }
 
 // FilterCachedFiles returns cached files matching the filter function
 func (fs *FileScanner) FilterCachedFiles(filter func(*UnifiedFileInfo) bool) []*UnifiedFileInfo {
-	fs.cache.mu.RLock()
-	defer fs.cache.mu.RUnlock()
-
 	var filtered []*UnifiedFileInfo
-	for _, file := range fs.cache.files {
+	for _, file := range fs.cache.all() {
 		if filter(file) {
 			filtered = append(filtered, file)
 		}
@@ -388,33 +748,6 @@ func (fs *FileScanner) FilterCachedFiles(filter func(*UnifiedFileInfo) bool) []*
 	return filtered
 }
 
-func (fs *FileScanner) loadGitIgnores() error {
-	gitIgnorePath := filepath.Join(fs.rootPath, ".gitignore")
-	file, err := os.Open(gitIgnorePath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil
-		}
-		return err
-	}
-	defer func(file *os.File) {
-		err := file.Close()
-		if err != nil {
-			fmt.Printf("Error closing .gitignore file: %v\n", err)
-		}
-	}(file)
-
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line != "" && !strings.HasPrefix(line, "#") {
-			fs.gitIgnores = append(fs.gitIgnores, line)
-		}
-	}
-
-	return scanner.Err()
-}
-
 // ScanFiles provides backward compatibility - converts cached files to legacy format
 func (fs *FileScanner) ScanFiles() ([]FileInfo, error) {
 	cachedFiles := fs.GetCachedFiles()
@@ -441,55 +774,54 @@ func (fs *FileScanner) ScanFiles() ([]FileInfo, error) {
 	return files, nil
 }
 
+// shouldSkipPath reports whether path is inside a .git directory, matching
+// ".git" as a whole path segment rather than a substring so a root-level
+// .gitignore or .gitattributes file - which legitimately contains ".git" as
+// a substring - isn't mistaken for part of the .git directory itself.
 func (fs *FileScanner) shouldSkipPath(path string) bool {
-	return strings.Contains(path, ".git")
-}
-
-func (fs *FileScanner) shouldIgnore(path string) bool {
-	for _, pattern := range fs.gitIgnores {
-		// Handle directory patterns ending with /
-		if strings.HasSuffix(pattern, "/") {
-			dirPattern := strings.TrimSuffix(pattern, "/")
-			if matched, _ := filepath.Match(dirPattern, path); matched {
-				return true
-			}
-			if matched, _ := filepath.Match(dirPattern, filepath.Base(path)); matched {
-				return true
-			}
-		}
-
-		// Standard pattern matching
-		if matched, _ := filepath.Match(pattern, path); matched {
-			return true
-		}
-		if matched, _ := filepath.Match(pattern, filepath.Base(path)); matched {
+	for _, part := range strings.Split(path, string(filepath.Separator)) {
+		if part == ".git" {
 			return true
 		}
 	}
 	return false
 }
 
-func (fs *FileScanner) countLines(path string) (int, error) {
+// shouldIgnore reports whether path (relative to fs.rootPath) matches the
+// repository's .gitignore rules, via fs.ignorer.
+func (fs *FileScanner) shouldIgnore(path string) bool {
+	return fs.ignorer.Match(path)
+}
+
+// IgnoreMatcher returns the scanner's .gitignore/.gitattributes matcher, so
+// an analyzer walking the same repository (CodeStatsAnalyzer, say) can
+// apply identical exclusion rules instead of keeping its own hardcoded
+// directory list that could drift out of sync with fs's.
+func (fs *FileScanner) IgnoreMatcher() *IgnoreMatcher {
+	return fs.ignorer
+}
+
+func (fs *FileScanner) countLines(relPath string) (int, error) {
 	// Check file size to decide whether to use streaming
-	info, err := os.Stat(path)
+	info, err := iofs.Stat(fs.fsys, relPath)
 	if err != nil {
 		return 0, err
 	}
 
 	// Use streaming for files larger than 1MB
 	if info.Size() > 1024*1024 {
-		return fs.countLinesStreaming(path)
+		return fs.countLinesStreaming(relPath)
 	}
 
 	// Use regular method for smaller files
-	file, err := os.Open(path)
+	file, err := fs.fsys.Open(relPath)
 	if err != nil {
 		return 0, err
 	}
-	defer func(file *os.File) {
+	defer func(file iofs.File) {
 		err := file.Close()
 		if err != nil {
-			fmt.Printf(fileCloseErrorMsg, path, err)
+			fmt.Printf(fileCloseErrorMsg, relPath, err)
 		}
 	}(file)
 
@@ -502,55 +834,150 @@ func (fs *FileScanner) countLines(path string) (int, error) {
 	return count, scanner.Err()
 }
 
+// SearchInFiles searches fsys for pattern, restricted to extensions if any
+// are given, and returns every match as a slice. It's a thin wrapper around
+// SearchInFilesStream with an unbounded context, for callers that want all
+// matches at once and have no need to cancel early.
 func (fs *FileScanner) SearchInFiles(pattern string, extensions []string) ([]Match, error) {
-	regex, err := regexp.Compile(pattern)
-	if err != nil {
-		return nil, fmt.Errorf("invalid regex pattern: %w", err)
-	}
+	matchCh, errCh := fs.SearchInFilesStream(context.Background(), pattern, extensions)
 
 	var matches []Match
+	for m := range matchCh {
+		matches = append(matches, m)
+	}
 
-	err = filepath.Walk(fs.rootPath, func(path string, info os.FileInfo, err error) error {
-		return fs.processSearchPath(path, info, err, regex, extensions, &matches)
-	})
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to search in files: %w", err)
+	if err := <-errCh; err != nil {
+		return nil, err
 	}
 
 	return matches, nil
 }
 
-func (fs *FileScanner) processSearchPath(path string, info os.FileInfo, err error, regex *regexp.Regexp, extensions []string, matches *[]Match) error {
+// SearchInFilesStream walks fsys with the same producer/consumer pipeline as
+// ScanAllFiles and sends each Match to the returned channel as it's found,
+// instead of accumulating them in memory - scanning a large history for
+// secrets can turn up more matches than comfortably fit in a slice. ctx is
+// honored at directory, file, and per-line granularity, so cancelling it
+// stops the walk and its workers promptly; any resulting error, including
+// ctx.Err(), is sent on the returned error channel. Both channels are
+// closed once the search - or its cancellation - completes.
+func (fs *FileScanner) SearchInFilesStream(ctx context.Context, pattern string, extensions []string) (<-chan Match, <-chan error) {
+	matches := make(chan Match)
+	errCh := make(chan error, 1)
+
+	regex, err := regexp.Compile(pattern)
 	if err != nil {
-		return err
+		close(matches)
+		errCh <- fmt.Errorf("invalid regex pattern: %w", err)
+		close(errCh)
+		return matches, errCh
 	}
 
-	if skipResult := fs.handleSearchSkipConditions(path, info); skipResult != nil {
-		return skipResult
+	jobs := fs.jobs
+	if jobs < 1 {
+		jobs = 1
 	}
 
-	return fs.processSearchFile(path, regex, extensions, matches)
+	go func() {
+		defer close(matches)
+		defer close(errCh)
+
+		paths := make(chan searchTask, jobs*4)
+		walkErr := make(chan error, 1)
+
+		go func() {
+			defer close(paths)
+			walkErr <- fs.walkSearchPaths(ctx, paths)
+		}()
+
+		fs.processSearchPathsConcurrently(ctx, paths, jobs, regex, extensions, matches)
+
+		if err := <-walkErr; err != nil {
+			errCh <- fmt.Errorf("failed to search in files: %w", err)
+		}
+	}()
+
+	return matches, errCh
+}
+
+// searchTask is a file path discovered by SearchInFilesStream's directory
+// walk, queued for the concurrent per-file search stage.
+type searchTask struct {
+	path string
+	d    iofs.DirEntry
+}
+
+// walkSearchPaths walks the tree sequentially - fs.WalkDir's SkipDir
+// semantics require visiting directories in order - and sends every regular
+// file it finds to paths for the worker pool to search concurrently,
+// aborting as soon as ctx is done.
+func (fs *FileScanner) walkSearchPaths(ctx context.Context, paths chan<- searchTask) error {
+	return iofs.WalkDir(fs.fsys, ".", func(path string, d iofs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if skipResult := fs.handleSearchSkipConditions(path, d); skipResult != nil {
+			return skipResult
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		select {
+		case paths <- searchTask{path: path, d: d}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		return nil
+	})
+}
+
+// processSearchPathsConcurrently drains paths with a fixed pool of jobs
+// worker goroutines, each searching files and sending Matches to matches
+// until paths is closed or ctx is done.
+func (fs *FileScanner) processSearchPathsConcurrently(ctx context.Context, paths <-chan searchTask, jobs int, regex *regexp.Regexp, extensions []string, matches chan<- Match) {
+	var wg sync.WaitGroup
+	wg.Add(jobs)
+
+	for i := 0; i < jobs; i++ {
+		go func() {
+			defer wg.Done()
+			for task := range paths {
+				if ctx.Err() != nil {
+					continue
+				}
+				_ = fs.processSearchFile(ctx, task.path, regex, extensions, matches)
+			}
+		}()
+	}
+
+	wg.Wait()
 }
 
 // handleSearchSkipConditions checks if path should be skipped during search
-func (fs *FileScanner) handleSearchSkipConditions(path string, info os.FileInfo) error {
+func (fs *FileScanner) handleSearchSkipConditions(path string, d iofs.DirEntry) error {
 	if fs.shouldSkipPath(path) {
-		if info.IsDir() {
-			return filepath.SkipDir
+		if d.IsDir() {
+			return iofs.SkipDir
 		}
 		return nil
 	}
 
-	if info.IsDir() {
+	if d.IsDir() {
 		return nil
 	}
 
 	return nil
 }
 
-// processSearchFile processes a file for search matches
-func (fs *FileScanner) processSearchFile(path string, regex *regexp.Regexp, extensions []string, matches *[]Match) error {
+// processSearchFile processes a file for search matches, sending any to
+// matches.
+func (fs *FileScanner) processSearchFile(ctx context.Context, path string, regex *regexp.Regexp, extensions []string, matches chan<- Match) error {
 	shouldProcess, err := fs.shouldProcessFileForSearch(path, extensions)
 	if err != nil {
 		return err
@@ -559,38 +986,27 @@ func (fs *FileScanner) processSearchFile(path string, regex *regexp.Regexp, exte
 		return nil
 	}
 
-	fileMatches, err := fs.searchInFile(path, regex)
-	if err != nil {
-		return err
-	}
-
-	*matches = append(*matches, fileMatches...)
-	return nil
+	return fs.searchInFile(ctx, path, regex, matches)
 }
 
-func (fs *FileScanner) shouldProcessFileForSearch(path string, extensions []string) (bool, error) {
-	relPath, err := filepath.Rel(fs.rootPath, path)
-	if err != nil {
-		return false, err
-	}
-
+func (fs *FileScanner) shouldProcessFileForSearch(relPath string, extensions []string) (bool, error) {
 	if fs.shouldIgnore(relPath) {
 		return false, nil
 	}
 
-	if !fs.hasValidExtension(path, extensions) {
+	if !fs.hasValidExtension(relPath, extensions) {
 		return false, nil
 	}
 
 	// Check if file is text by reading first bytes
-	file, err := os.Open(path)
+	file, err := fs.fsys.Open(relPath)
 	if err != nil {
 		return false, nil
 	}
-	defer func(file *os.File) {
+	defer func(file iofs.File) {
 		err := file.Close()
 		if err != nil {
-			fmt.Printf(fileCloseErrorMsg, path, err)
+			fmt.Printf(fileCloseErrorMsg, relPath, err)
 		}
 	}(file)
 
@@ -624,50 +1040,50 @@ func (fs *FileScanner) hasValidExtension(path string, extensions []string) bool
 	return false
 }
 
-func (fs *FileScanner) searchInFile(path string, regex *regexp.Regexp) ([]Match, error) {
+func (fs *FileScanner) searchInFile(ctx context.Context, relPath string, regex *regexp.Regexp, matches chan<- Match) error {
 	// Check file size to decide whether to use streaming
-	info, err := os.Stat(path)
+	info, err := iofs.Stat(fs.fsys, relPath)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Use streaming for files larger than 1MB
 	if info.Size() > 1024*1024 {
-		return fs.searchInFileStreaming(path, regex)
+		return fs.searchInFileStreaming(ctx, relPath, regex, matches)
 	}
 
 	// Use regular method for smaller files
-	file, err := os.Open(path)
+	file, err := fs.fsys.Open(relPath)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	defer func(file *os.File) {
+	defer func(file iofs.File) {
 		err := file.Close()
 		if err != nil {
-			fmt.Printf(fileCloseErrorMsg, path, err)
+			fmt.Printf(fileCloseErrorMsg, relPath, err)
 		}
 	}(file)
 
-	var matches []Match
 	scanner := bufio.NewScanner(file)
 	lineNum := 1
 
-	relPath, _ := filepath.Rel(fs.rootPath, path)
-
 	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
 		line := scanner.Text()
 		if regex.MatchString(line) {
-			matches = append(matches, Match{
-				File:    relPath,
-				Line:    lineNum,
-				Content: line,
-				Pattern: regex.String(),
-			})
+			select {
+			case matches <- Match{File: relPath, Line: lineNum, Content: line, Pattern: regex.String()}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
 		}
 		lineNum++
 	}
 
-	return matches, scanner.Err()
+	return scanner.Err()
 }
 
 type Match struct {
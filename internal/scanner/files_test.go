@@ -1,8 +1,10 @@
 package scanner
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 )
@@ -43,10 +45,14 @@ node_modules/
 		t.Errorf("Expected rootPath %s, got %s", tempDir, scanner.rootPath)
 	}
 
-	// Verify gitignore patterns were loaded
-	expectedPatterns := []string{"*.log", "*.tmp", "build/", "node_modules/", ".env"}
-	if len(scanner.gitIgnores) != len(expectedPatterns) {
-		t.Errorf("Expected %d gitignore patterns, got %d", len(expectedPatterns), len(scanner.gitIgnores))
+	// Verify the .gitignore's patterns take effect
+	for _, path := range []string{"app.log", "scratch.tmp", "build", "node_modules", ".env"} {
+		if !scanner.shouldIgnore(path) {
+			t.Errorf("expected %q to be ignored per .gitignore", path)
+		}
+	}
+	if scanner.shouldIgnore("main.go") {
+		t.Error("expected main.go, which matches no pattern, not to be ignored")
 	}
 }
 
@@ -172,6 +178,125 @@ func TestFileScanner_ScanFiles(t *testing.T) {
 	}
 }
 
+func TestFileScanner_ScanAllFiles_SetJobsMatchesSequentialResults(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "scan_jobs_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer func(path string) {
+		if err := os.RemoveAll(path); err != nil {
+			t.Fatalf("Failed to clean up temp directory %s: %v", path, err)
+		}
+	}(tempDir)
+
+	testFiles := map[string]string{
+		"main.go":          "package main\nfunc main() {}",
+		"util.py":          "print('hello')",
+		"README.md":        "# Test Project",
+		"subdir/nested.js": "console.log('nested');",
+	}
+	if err := os.MkdirAll(filepath.Join(tempDir, "subdir"), 0755); err != nil {
+		t.Fatalf("Failed to create subdirectory: %v", err)
+	}
+	for filename, content := range testFiles {
+		if err := os.WriteFile(filepath.Join(tempDir, filename), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create test file %s: %v", filename, err)
+		}
+	}
+
+	sequential, err := NewFileScanner(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create scanner: %v", err)
+	}
+	sequential.SetJobs(1)
+	sequentialFiles, err := sequential.ScanAllFiles()
+	if err != nil {
+		t.Fatalf("Sequential ScanAllFiles failed: %v", err)
+	}
+
+	concurrent, err := NewFileScanner(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create scanner: %v", err)
+	}
+	concurrent.SetJobs(8)
+	concurrentFiles, err := concurrent.ScanAllFiles()
+	if err != nil {
+		t.Fatalf("Concurrent ScanAllFiles failed: %v", err)
+	}
+
+	if len(concurrentFiles) != len(sequentialFiles) {
+		t.Fatalf("Expected %d files with jobs=8, got %d", len(sequentialFiles), len(concurrentFiles))
+	}
+
+	for relPath, want := range sequentialFiles {
+		got, ok := concurrentFiles[relPath]
+		if !ok {
+			t.Errorf("Expected %s to be scanned with jobs=8", relPath)
+			continue
+		}
+		if got.Size != want.Size || got.LineCount != want.LineCount || string(got.Content) != string(want.Content) {
+			t.Errorf("File %s differs between jobs=1 and jobs=8: got %+v, want %+v", relPath, got, want)
+		}
+	}
+}
+
+// TestFileScanner_ScanFiles_RootGitignoreDoesNotTruncateScan guards against
+// a regression where shouldSkipPath matched ".git" as a substring, so a
+// root-level .gitignore or .gitattributes (both legitimately containing
+// ".git") was mistaken for the .git directory itself and, combined with
+// shouldSkipDirectory returning SkipDir even for a non-directory entry,
+// silently aborted the rest of the walk after hitting it.
+func TestFileScanner_ScanFiles_RootGitignoreDoesNotTruncateScan(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "gitignore_truncation_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	if err := os.WriteFile(filepath.Join(tempDir, ".gitignore"), []byte("*.log\n"), 0644); err != nil {
+		t.Fatalf("Failed to create .gitignore: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, ".gitattributes"), []byte("*.go text\n"), 0644); err != nil {
+		t.Fatalf("Failed to create .gitattributes: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, "main.go"), []byte("package main"), 0644); err != nil {
+		t.Fatalf("Failed to create main.go: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(tempDir, "subdir"), 0755); err != nil {
+		t.Fatalf("Failed to create subdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, "subdir", "nested.go"), []byte("package subdir"), 0644); err != nil {
+		t.Fatalf("Failed to create subdir/nested.go: %v", err)
+	}
+
+	scanner, err := NewFileScanner(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create scanner: %v", err)
+	}
+
+	files, err := scanner.ScanFiles()
+	if err != nil {
+		t.Fatalf("Failed to scan files: %v", err)
+	}
+
+	foundMain, foundNested := false, false
+	for _, file := range files {
+		switch file.RelativePath {
+		case "main.go":
+			foundMain = true
+		case "subdir/nested.go":
+			foundNested = true
+		}
+	}
+
+	if !foundMain {
+		t.Error("expected main.go to be scanned despite a root .gitignore")
+	}
+	if !foundNested {
+		t.Error("expected subdir/nested.go to be scanned - the walk should not have been truncated after .gitignore/.gitattributes")
+	}
+}
+
 func TestFileScanner_SearchInFiles(t *testing.T) {
 	// Create a temporary directory
 	tempDir, err := os.MkdirTemp("", "search_test")
@@ -326,11 +451,19 @@ func TestFileScanner_IsTextFile(t *testing.T) {
 	}
 
 	// Test text file detection
-	if !scanner.isTextFile(textFile) {
+	textContent, err := os.ReadFile(textFile)
+	if err != nil {
+		t.Fatalf("Failed to read text file: %v", err)
+	}
+	if !scanner.isTextFromBytes(textContent) {
 		t.Error("Text file should be detected as text")
 	}
 
-	if scanner.isTextFile(binaryFile) {
+	binaryBytes, err := os.ReadFile(binaryFile)
+	if err != nil {
+		t.Fatalf("Failed to read binary file: %v", err)
+	}
+	if scanner.isTextFromBytes(binaryBytes) {
 		t.Error("Binary file should not be detected as text")
 	}
 }
@@ -390,3 +523,64 @@ node_modules/
 		}
 	}
 }
+
+// buildSyntheticTree creates fileCount small text files spread across
+// subdirectories, for benchmarking ScanAllFiles without depending on a real
+// checkout's size.
+func buildSyntheticTree(b *testing.B, fileCount int) string {
+	b.Helper()
+
+	root, err := os.MkdirTemp("", "scan_bench")
+	if err != nil {
+		b.Fatalf("Failed to create temp directory: %v", err)
+	}
+	b.Cleanup(func() {
+		if err := os.RemoveAll(root); err != nil {
+			b.Fatalf("Failed to clean up temp directory %s: %v", root, err)
+		}
+	})
+
+	const filesPerDir = 200
+	content := []byte("package bench\n\nfunc noop() {}\n")
+	for i := 0; i < fileCount; i++ {
+		dir := filepath.Join(root, fmt.Sprintf("pkg%d", i/filesPerDir))
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			b.Fatalf("Failed to create subdirectory %s: %v", dir, err)
+		}
+		path := filepath.Join(dir, fmt.Sprintf("file%d.go", i))
+		if err := os.WriteFile(path, content, 0644); err != nil {
+			b.Fatalf("Failed to create file %s: %v", path, err)
+		}
+	}
+
+	return root
+}
+
+func benchmarkScanAllFiles(b *testing.B, jobs int) {
+	root := buildSyntheticTree(b, 50000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		scanner, err := NewFileScanner(root)
+		if err != nil {
+			b.Fatalf("Failed to create scanner: %v", err)
+		}
+		scanner.SetJobs(jobs)
+		if _, err := scanner.ScanAllFiles(); err != nil {
+			b.Fatalf("ScanAllFiles failed: %v", err)
+		}
+	}
+}
+
+// BenchmarkScanAllFiles_Serial runs ScanAllFiles with a single worker, the
+// scanner's original sequential behavior, as a baseline for
+// BenchmarkScanAllFiles_Parallel.
+func BenchmarkScanAllFiles_Serial(b *testing.B) {
+	benchmarkScanAllFiles(b, 1)
+}
+
+// BenchmarkScanAllFiles_Parallel runs ScanAllFiles with the scanner's
+// default worker count (runtime.GOMAXPROCS(0)).
+func BenchmarkScanAllFiles_Parallel(b *testing.B) {
+	benchmarkScanAllFiles(b, runtime.GOMAXPROCS(0))
+}
@@ -0,0 +1,304 @@
+package scanner
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	iofs "io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/githealthchecker/git-health-checker/internal/report"
+)
+
+// kgramSize is the number of normalized bytes per k-gram hashed for
+// fingerprinting - the Winnowing paper's own evaluation uses k≈50, big
+// enough that a single common token ("return nil") won't trigger a false
+// match, small enough to survive a small edit to a copied block.
+const kgramSize = 50
+
+// winnowWindow is the number of consecutive k-gram hashes winnowing slides
+// its window over; it guarantees at least one hash from every such window
+// is selected as a fingerprint.
+const winnowWindow = 4
+
+// Fingerprint is one winnowed k-gram hash selected from a file's normalized
+// content, paired with the source line it starts on so a DetectDuplicates
+// finding can point at a location rather than just naming two files.
+type Fingerprint struct {
+	Hash       uint64
+	LineOffset int
+}
+
+// ComputeFingerprints computes a Winnowing-style fingerprint set for every
+// cached text file - normalizing whitespace and stripping line comments
+// first, so two files differing only in formatting still match - and
+// writes the result to .githealth.fingerprints under fs.rootPath for other
+// tooling to consume without recomputing it. Binary and non-text files are
+// skipped, the same set analyzeFileContent marks IsText for.
+func (fs *FileScanner) ComputeFingerprints() (map[string][]Fingerprint, error) {
+	cachedFiles := fs.GetCachedFiles()
+	if len(cachedFiles) == 0 {
+		if _, err := fs.ScanAllFiles(); err != nil {
+			return nil, err
+		}
+		cachedFiles = fs.GetCachedFiles()
+	}
+
+	fingerprints := make(map[string][]Fingerprint, len(cachedFiles))
+	for relPath, file := range cachedFiles {
+		if !file.IsText {
+			continue
+		}
+
+		content, err := fs.fileContentForFingerprint(file)
+		if err != nil {
+			continue
+		}
+
+		if fp := fingerprintContent(content); len(fp) > 0 {
+			fingerprints[relPath] = fp
+		}
+	}
+
+	if err := fs.writeFingerprintsArtifact(fingerprints); err != nil {
+		return nil, err
+	}
+
+	return fingerprints, nil
+}
+
+// fileContentForFingerprint returns file's full content, reusing
+// UnifiedFileInfo.Content when analyzeFileContent already cached it and
+// re-reading it from fsys only for files too large to have been cached.
+func (fs *FileScanner) fileContentForFingerprint(file *UnifiedFileInfo) ([]byte, error) {
+	if file.Content != nil {
+		return file.Content, nil
+	}
+	return iofs.ReadFile(fs.fsys, file.RelativePath)
+}
+
+// writeFingerprintsArtifact persists fingerprints as JSON to
+// .githealth.fingerprints under fs.rootPath. rootPath needs to be a real
+// directory for this to succeed; a scanner backed by a non-OS fs.FS (e.g.
+// a git tree, see NewFileScannerFS) has nowhere to write one, so this is a
+// no-op rather than an error in that case.
+func (fs *FileScanner) writeFingerprintsArtifact(fingerprints map[string][]Fingerprint) error {
+	info, err := os.Stat(fs.rootPath)
+	if err != nil || !info.IsDir() {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(fingerprints, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal fingerprints: %w", err)
+	}
+
+	path := filepath.Join(fs.rootPath, ".githealth.fingerprints")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write fingerprints artifact: %w", err)
+	}
+
+	return nil
+}
+
+// DetectDuplicates compares every pair of fingerprinted files by Jaccard
+// similarity - the ratio of shared fingerprints to the union of both sets -
+// and flags any pair at or above threshold as a report.CategoryQuality
+// issue: duplicated code, or a vendored copy of another file that was
+// accidentally checked in rather than tracked as an edit via git history.
+func (fs *FileScanner) DetectDuplicates(threshold float64) ([]report.Issue, error) {
+	fingerprints, err := fs.ComputeFingerprints()
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(fingerprints))
+	for path := range fingerprints {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var issues []report.Issue
+	for i := 0; i < len(paths); i++ {
+		for j := i + 1; j < len(paths); j++ {
+			similarity := jaccardSimilarity(fingerprints[paths[i]], fingerprints[paths[j]])
+			if similarity < threshold {
+				continue
+			}
+			issues = append(issues, duplicateIssue(paths[i], paths[j], similarity))
+		}
+	}
+
+	return issues, nil
+}
+
+// jaccardSimilarity returns the fraction of a and b's fingerprint hashes
+// that the two sets share, 0 when either is empty.
+func jaccardSimilarity(a, b []Fingerprint) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	set := make(map[uint64]bool, len(a))
+	for _, fp := range a {
+		set[fp.Hash] = true
+	}
+
+	shared := 0
+	union := len(set)
+	for _, fp := range b {
+		if set[fp.Hash] {
+			shared++
+		} else {
+			union++
+		}
+	}
+
+	return float64(shared) / float64(union)
+}
+
+// duplicateIssue builds the report.Issue for a file pair DetectDuplicates
+// flagged.
+func duplicateIssue(fileA, fileB string, similarity float64) report.Issue {
+	severity := report.SeverityMedium
+	if similarity >= 0.95 {
+		severity = report.SeverityHigh
+	}
+
+	return report.Issue{
+		ID:          fmt.Sprintf("duplicate-code-%s-%s", strings.ReplaceAll(fileA, "/", "-"), strings.ReplaceAll(fileB, "/", "-")),
+		Title:       "Duplicate or vendored code detected",
+		Description: fmt.Sprintf("%s and %s share %.0f%% of their content fingerprints, suggesting duplicated or accidentally vendored code", fileA, fileB, similarity*100),
+		Category:    report.CategoryQuality,
+		Severity:    severity,
+		File:        fileA,
+		Rule:        "duplicate-code",
+		Fix:         fmt.Sprintf("Extract the shared logic into one location, or confirm %s is an intentional vendored copy", fileB),
+		Metadata:    map[string]string{"duplicate_of": fileB, "similarity": fmt.Sprintf("%.2f", similarity)},
+		CreatedAt:   time.Now(),
+	}
+}
+
+// fingerprintContent normalizes content into k-grams and winnows their
+// hashes down to a representative fingerprint set.
+func fingerprintContent(content []byte) []Fingerprint {
+	normalized, lineOffsets := normalizeForFingerprint(content)
+	if len(normalized) < kgramSize {
+		return nil
+	}
+
+	kgramCount := len(normalized) - kgramSize + 1
+	hashes := make([]uint64, kgramCount)
+	hashLines := make([]int, kgramCount)
+	for i := 0; i < kgramCount; i++ {
+		hashes[i] = hashKgram(normalized[i : i+kgramSize])
+		hashLines[i] = lineOffsets[i]
+	}
+
+	return winnow(hashes, hashLines)
+}
+
+// hashKgram hashes one k-gram with FNV-1a, the same hash family
+// FileCache.set's shard key uses elsewhere in this package, scaled up to
+// 64 bits since a collision here would wrongly merge two unrelated
+// k-grams.
+func hashKgram(kgram []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(kgram)
+	return h.Sum64()
+}
+
+// winnow selects one hash per window of winnowWindow consecutive k-grams -
+// the minimum, breaking ties by keeping the rightmost occurrence - per
+// Schleimer, Wilkerson & Aiken's winnowing algorithm. A fingerprint already
+// selected by the previous window is never re-added, so a run of windows
+// sharing the same minimum contributes only one Fingerprint.
+func winnow(hashes []uint64, lines []int) []Fingerprint {
+	if len(hashes) == 0 {
+		return nil
+	}
+	if len(hashes) <= winnowWindow {
+		minIdx := rightmostMin(hashes, 0, len(hashes))
+		return []Fingerprint{{Hash: hashes[minIdx], LineOffset: lines[minIdx]}}
+	}
+
+	var fingerprints []Fingerprint
+	lastSelected := -1
+	for start := 0; start+winnowWindow <= len(hashes); start++ {
+		minIdx := rightmostMin(hashes, start, start+winnowWindow)
+		if minIdx != lastSelected {
+			fingerprints = append(fingerprints, Fingerprint{Hash: hashes[minIdx], LineOffset: lines[minIdx]})
+			lastSelected = minIdx
+		}
+	}
+
+	return fingerprints
+}
+
+// rightmostMin returns the index in [start, end) of the smallest hash,
+// preferring the rightmost index on ties as winnowing specifies - so a
+// fingerprint "expires" out of the window as soon as a new minimum
+// supersedes it, rather than lingering on an earlier tie.
+func rightmostMin(hashes []uint64, start, end int) int {
+	minIdx := start
+	for i := start + 1; i < end; i++ {
+		if hashes[i] <= hashes[minIdx] {
+			minIdx = i
+		}
+	}
+	return minIdx
+}
+
+// normalizeForFingerprint strips "//" line comments and collapses
+// whitespace runs to a single space, so re-indentation or trailing
+// whitespace doesn't change a file's fingerprint, and records, for each
+// byte kept, the 1-based source line it came from, so a k-gram's window
+// can be attributed back to a line via its first byte's line.
+func normalizeForFingerprint(content []byte) ([]byte, []int) {
+	normalized := make([]byte, 0, len(content))
+	lineOffsets := make([]int, 0, len(content))
+
+	line := 1
+	inLineComment := false
+	lastWasSpace := true // collapses leading whitespace too
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+
+		if c == '\n' {
+			line++
+			inLineComment = false
+			lastWasSpace = true
+			continue
+		}
+
+		if inLineComment {
+			continue
+		}
+
+		if c == '/' && i+1 < len(content) && content[i+1] == '/' {
+			inLineComment = true
+			continue
+		}
+
+		if unicode.IsSpace(rune(c)) {
+			if !lastWasSpace {
+				normalized = append(normalized, ' ')
+				lineOffsets = append(lineOffsets, line)
+			}
+			lastWasSpace = true
+			continue
+		}
+
+		normalized = append(normalized, c)
+		lineOffsets = append(lineOffsets, line)
+		lastWasSpace = false
+	}
+
+	return normalized, lineOffsets
+}
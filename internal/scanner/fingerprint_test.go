@@ -0,0 +1,139 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileScanner_ComputeFingerprints(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "fingerprint_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer func(path string) {
+		if err := os.RemoveAll(path); err != nil {
+			t.Fatalf("Failed to clean up temp directory %s: %v", path, err)
+		}
+	}(tempDir)
+
+	content := `package sample
+
+func DoSomething(x int) int {
+	result := x * 2
+	result = result + 1
+	return result
+}
+`
+	if err := os.WriteFile(filepath.Join(tempDir, "a.go"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	scanner, err := NewFileScanner(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create scanner: %v", err)
+	}
+	defer scanner.Close()
+
+	fingerprints, err := scanner.ComputeFingerprints()
+	if err != nil {
+		t.Fatalf("ComputeFingerprints failed: %v", err)
+	}
+
+	fp, ok := fingerprints["a.go"]
+	if !ok {
+		t.Fatalf("expected a fingerprint set for a.go, got %v", fingerprints)
+	}
+	if len(fp) == 0 {
+		t.Error("expected at least one fingerprint for a non-trivial file")
+	}
+
+	artifact := filepath.Join(tempDir, ".githealth.fingerprints")
+	if _, err := os.Stat(artifact); err != nil {
+		t.Errorf("expected .githealth.fingerprints artifact to be written: %v", err)
+	}
+}
+
+func TestFileScanner_DetectDuplicates(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "duplicate_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer func(path string) {
+		if err := os.RemoveAll(path); err != nil {
+			t.Fatalf("Failed to clean up temp directory %s: %v", path, err)
+		}
+	}(tempDir)
+
+	shared := `package sample
+
+func DoSomething(x int) int {
+	result := x * 2
+	result = result + 1
+	result = result - 3
+	return result
+}
+
+func DoSomethingElse(y int) int {
+	total := y + 10
+	total = total * 2
+	return total
+}
+`
+	unrelated := `package other
+
+func Greet(name string) string {
+	return "hello, " + name
+}
+`
+
+	if err := os.WriteFile(filepath.Join(tempDir, "a.go"), []byte(shared), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+	// b.go is a.go with only whitespace changed, so it should fingerprint
+	// identically after normalization.
+	if err := os.WriteFile(filepath.Join(tempDir, "b.go"), []byte("  "+shared), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, "c.go"), []byte(unrelated), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	scanner, err := NewFileScanner(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create scanner: %v", err)
+	}
+	defer scanner.Close()
+
+	issues, err := scanner.DetectDuplicates(0.8)
+	if err != nil {
+		t.Fatalf("DetectDuplicates failed: %v", err)
+	}
+
+	if len(issues) != 1 {
+		t.Fatalf("expected exactly 1 duplicate pair, got %d: %+v", len(issues), issues)
+	}
+
+	issue := issues[0]
+	if issue.Rule != "duplicate-code" {
+		t.Errorf("expected rule 'duplicate-code', got %q", issue.Rule)
+	}
+	if issue.File != "a.go" || issue.Metadata["duplicate_of"] != "b.go" {
+		t.Errorf("expected a.go/b.go pair, got %s / %s", issue.File, issue.Metadata["duplicate_of"])
+	}
+}
+
+func TestJaccardSimilarity(t *testing.T) {
+	a := []Fingerprint{{Hash: 1}, {Hash: 2}, {Hash: 3}}
+	b := []Fingerprint{{Hash: 2}, {Hash: 3}, {Hash: 4}}
+
+	got := jaccardSimilarity(a, b)
+	want := 2.0 / 4.0
+	if got != want {
+		t.Errorf("jaccardSimilarity() = %v, want %v", got, want)
+	}
+
+	if jaccardSimilarity(nil, b) != 0 {
+		t.Error("expected 0 similarity when one set is empty")
+	}
+}
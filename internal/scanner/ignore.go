@@ -0,0 +1,429 @@
+package scanner
+
+import (
+	"bufio"
+	iofs "io/fs"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// ignorePattern is one parsed, non-comment line from a .gitignore file.
+type ignorePattern struct {
+	regex    *regexp.Regexp
+	negate   bool   // line started with "!"
+	anchored bool   // a mid-string "/" ties the pattern to baseDir instead of any depth below it
+	baseDir  string // slash-separated directory (relative to the IgnoreMatcher's root) the pattern was loaded from
+}
+
+// ignoreDir holds the patterns parsed from one directory's .gitignore, in
+// file order - later patterns in the same file take precedence over
+// earlier ones, matching git's last-match-wins rule.
+type ignoreDir struct {
+	patterns []ignorePattern
+	loaded   bool
+}
+
+// IgnoreMatcher evaluates repository-relative paths against every
+// .gitignore file found along their ancestor chain, the same way git itself
+// does: patterns are considered in order from the root .gitignore down to
+// the file's own directory, and the last pattern that matches decides the
+// outcome - including a later "!" pattern un-ignoring what an earlier
+// pattern ignored. It additionally honors .gitattributes, treating a path
+// marked binary, linguist-generated, or linguist-vendored as excluded the
+// same as a gitignore match, so FileScanner and any analyzer sharing this
+// matcher (via FileScanner.IgnoreMatcher) agree on what counts as source.
+// Per-directory pattern lists are parsed lazily and cached, and whole-path
+// match results are cached too, both behind an RWMutex, so a scan over a
+// large tree parses each .gitignore/.gitattributes once and evaluates each
+// distinct path once.
+type IgnoreMatcher struct {
+	fsys iofs.FS
+
+	mu         sync.RWMutex
+	dirs       map[string]*ignoreDir
+	attrDirs   map[string][]attrPattern
+	matchCache map[string]bool
+}
+
+// NewIgnoreMatcher creates an IgnoreMatcher that reads .gitignore and
+// .gitattributes files from fsys. Nothing is read until the first Match
+// call.
+func NewIgnoreMatcher(fsys iofs.FS) *IgnoreMatcher {
+	return &IgnoreMatcher{
+		fsys:       fsys,
+		dirs:       make(map[string]*ignoreDir),
+		attrDirs:   make(map[string][]attrPattern),
+		matchCache: make(map[string]bool),
+	}
+}
+
+// Match reports whether relPath (slash-separated, relative to the
+// IgnoreMatcher's root) is ignored, either by .gitignore or because
+// .gitattributes marks it binary, linguist-generated, or
+// linguist-vendored.
+func (ig *IgnoreMatcher) Match(relPath string) bool {
+	relPath = filepath.ToSlash(strings.TrimPrefix(filepath.ToSlash(relPath), "./"))
+	relPath = strings.TrimSuffix(relPath, "/")
+	if relPath == "" {
+		return false
+	}
+
+	ig.mu.RLock()
+	if cached, ok := ig.matchCache[relPath]; ok {
+		ig.mu.RUnlock()
+		return cached
+	}
+	ig.mu.RUnlock()
+
+	ignored := ig.evaluate(relPath) || ig.excludedByAttributes(relPath)
+
+	ig.mu.Lock()
+	ig.matchCache[relPath] = ignored
+	ig.mu.Unlock()
+
+	return ignored
+}
+
+// evaluate applies every pattern from the root down to relPath's own
+// directory, in order, returning whichever of "ignored" or "not ignored"
+// the last matching pattern decided.
+func (ig *IgnoreMatcher) evaluate(relPath string) bool {
+	ignored := false
+	for _, dir := range ig.ancestorDirs(relPath) {
+		for _, pattern := range ig.patternsFor(dir) {
+			candidate := relPath
+			if pattern.baseDir != "" {
+				candidate = strings.TrimPrefix(relPath, pattern.baseDir+"/")
+			}
+			if pattern.matches(candidate) {
+				ignored = !pattern.negate
+			}
+		}
+	}
+	return ignored
+}
+
+// ancestorDirs returns relPath's containing directory and every ancestor
+// of it up to (and including) the root, ordered from the root down - the
+// order .gitignore precedence is applied in.
+func (ig *IgnoreMatcher) ancestorDirs(relPath string) []string {
+	dir := filepath.ToSlash(filepath.Dir(relPath))
+	if dir == "." {
+		dir = ""
+	}
+
+	dirs := []string{""}
+	if dir == "" {
+		return dirs
+	}
+
+	var cur string
+	for _, part := range strings.Split(dir, "/") {
+		if cur == "" {
+			cur = part
+		} else {
+			cur = cur + "/" + part
+		}
+		dirs = append(dirs, cur)
+	}
+	return dirs
+}
+
+// patternsFor returns dir's parsed .gitignore patterns, loading and caching
+// them on first use.
+func (ig *IgnoreMatcher) patternsFor(dir string) []ignorePattern {
+	ig.mu.RLock()
+	entry, ok := ig.dirs[dir]
+	ig.mu.RUnlock()
+	if ok {
+		return entry.patterns
+	}
+
+	ig.mu.Lock()
+	defer ig.mu.Unlock()
+
+	if entry, ok := ig.dirs[dir]; ok {
+		return entry.patterns
+	}
+
+	patterns := ig.loadPatterns(dir)
+	ig.dirs[dir] = &ignoreDir{patterns: patterns, loaded: true}
+	return patterns
+}
+
+// loadPatterns reads and parses dir's .gitignore, if one exists.
+func (ig *IgnoreMatcher) loadPatterns(dir string) []ignorePattern {
+	name := ".gitignore"
+	if dir != "" {
+		name = dir + "/.gitignore"
+	}
+
+	file, err := ig.fsys.Open(name)
+	if err != nil {
+		return nil
+	}
+	defer func(file iofs.File) { _ = file.Close() }(file)
+
+	var patterns []ignorePattern
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if pattern, ok := parseIgnoreLine(line, dir); ok {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
+
+// parseIgnoreLine converts one .gitignore line into an ignorePattern. ok is
+// false only for a line that reduces to nothing once escapes are stripped.
+func parseIgnoreLine(line, baseDir string) (ignorePattern, bool) {
+	negate := strings.HasPrefix(line, "!")
+	if negate {
+		line = line[1:]
+	}
+	line = strings.ReplaceAll(line, `\ `, " ")
+
+	// A trailing "/" marks a directory-only pattern, but since a matched
+	// directory implicitly ignores everything inside it anyway (see
+	// ignorePattern.matches' ancestor-prefix check), it's stripped without
+	// otherwise being tracked.
+	line = strings.TrimSuffix(line, "/")
+	if line == "" {
+		return ignorePattern{}, false
+	}
+
+	anchored := strings.HasPrefix(line, "/")
+	line = strings.TrimPrefix(line, "/")
+	if strings.Contains(line, "/") {
+		// A slash anywhere in the middle of the pattern also anchors it to
+		// baseDir, per gitignore's documented rules.
+		anchored = true
+	}
+
+	return ignorePattern{
+		regex:    gitignoreGlobToRegex(line, anchored),
+		negate:   negate,
+		anchored: anchored,
+		baseDir:  baseDir,
+	}, true
+}
+
+// gitignoreGlobToRegex translates a single gitignore glob (already stripped
+// of its leading "!", anchoring "/", and trailing directory "/") into a
+// regex matching the candidate path it applies to. An unanchored glob (no
+// "/" anywhere in the original pattern) matches at any depth, not just at
+// its baseDir, so it's given an optional "any directories" prefix.
+// ignorePattern.matches separately checks ancestor directory prefixes, so a
+// file nested under an ignored directory is still caught even though the
+// directory pattern's regex only describes the directory's own name.
+func gitignoreGlobToRegex(glob string, anchored bool) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("^")
+	if !anchored {
+		sb.WriteString("(?:.*/)?")
+	}
+
+	runes := []rune(glob)
+	for i := 0; i < len(runes); {
+		switch c := runes[i]; {
+		case c == '*' && i+1 < len(runes) && runes[i+1] == '*':
+			if i+2 < len(runes) && runes[i+2] == '/' {
+				sb.WriteString("(?:.*/)?")
+				i += 3
+			} else {
+				sb.WriteString(".*")
+				i += 2
+			}
+		case c == '*':
+			sb.WriteString("[^/]*")
+			i++
+		case c == '?':
+			sb.WriteString("[^/]")
+			i++
+		case c == '[':
+			j := i + 1
+			for j < len(runes) && runes[j] != ']' {
+				j++
+			}
+			if j < len(runes) {
+				sb.WriteString(string(runes[i : j+1]))
+				i = j + 1
+			} else {
+				sb.WriteString(regexp.QuoteMeta(string(c)))
+				i++
+			}
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+			i++
+		}
+	}
+
+	// dirOnly doesn't change the regex itself - it only matters to
+	// ignorePattern.matches, which separately checks ancestor directory
+	// prefixes so a file nested under an ignored directory is still caught.
+	sb.WriteString("$")
+
+	return regexp.MustCompile(sb.String())
+}
+
+// attrPattern is one parsed, non-comment line from a .gitattributes file
+// that sets one of the three attributes IgnoreMatcher treats as exclusion:
+// binary, linguist-generated, or linguist-vendored.
+type attrPattern struct {
+	regex    *regexp.Regexp
+	anchored bool
+	baseDir  string
+	excluded bool // false for a "-binary" or "binary=false" line, which un-sets the attribute
+}
+
+// excludedByAttributes reports whether relPath's nearest-matching
+// .gitattributes entry marks it binary, linguist-generated, or
+// linguist-vendored - git's own rule that a later pattern in the same file
+// overrides an earlier one applies here too, which is why the result is
+// taken from the last match rather than the first.
+func (ig *IgnoreMatcher) excludedByAttributes(relPath string) bool {
+	excluded := false
+	for _, dir := range ig.ancestorDirs(relPath) {
+		for _, pattern := range ig.attrPatternsFor(dir) {
+			candidate := relPath
+			if pattern.baseDir != "" {
+				candidate = strings.TrimPrefix(relPath, pattern.baseDir+"/")
+			}
+			if (ignorePattern{regex: pattern.regex, anchored: pattern.anchored}).matches(candidate) {
+				excluded = pattern.excluded
+			}
+		}
+	}
+	return excluded
+}
+
+// attrPatternsFor returns dir's parsed .gitattributes patterns, loading and
+// caching them on first use.
+func (ig *IgnoreMatcher) attrPatternsFor(dir string) []attrPattern {
+	ig.mu.RLock()
+	patterns, ok := ig.attrDirs[dir]
+	ig.mu.RUnlock()
+	if ok {
+		return patterns
+	}
+
+	ig.mu.Lock()
+	defer ig.mu.Unlock()
+
+	if patterns, ok := ig.attrDirs[dir]; ok {
+		return patterns
+	}
+
+	patterns = ig.loadAttrPatterns(dir)
+	ig.attrDirs[dir] = patterns
+	return patterns
+}
+
+// loadAttrPatterns reads and parses dir's .gitattributes, if one exists.
+func (ig *IgnoreMatcher) loadAttrPatterns(dir string) []attrPattern {
+	name := ".gitattributes"
+	if dir != "" {
+		name = dir + "/.gitattributes"
+	}
+
+	file, err := ig.fsys.Open(name)
+	if err != nil {
+		return nil
+	}
+	defer func(file iofs.File) { _ = file.Close() }(file)
+
+	var patterns []attrPattern
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if pattern, ok := parseAttrLine(line, dir); ok {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
+
+// exclusionAttrs are the .gitattributes attributes IgnoreMatcher treats as
+// "not source" - git's own "binary" (implies -diff -merge) plus GitHub
+// linguist's conventions for generated and vendored files, both of which
+// tools like SearchInFiles and language-based line counting should skip the
+// same way they skip a vendor/ directory caught by .gitignore.
+var exclusionAttrs = map[string]bool{
+	"binary":             true,
+	"linguist-generated": true,
+	"linguist-vendored":  true,
+}
+
+// parseAttrLine converts one .gitattributes line into an attrPattern. ok is
+// false unless the line sets one of exclusionAttrs - every other attribute
+// (eol, diff, filter, merge, ...) doesn't affect what IgnoreMatcher excludes.
+func parseAttrLine(line, baseDir string) (attrPattern, bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 2 {
+		return attrPattern{}, false
+	}
+	glob := fields[0]
+
+	excluded, found := false, false
+	for _, attr := range fields[1:] {
+		name, value := attr, "true"
+		switch {
+		case strings.HasPrefix(attr, "-"):
+			name, value = attr[1:], "false"
+		case strings.Contains(attr, "="):
+			parts := strings.SplitN(attr, "=", 2)
+			name, value = parts[0], parts[1]
+		}
+		if !exclusionAttrs[name] {
+			continue
+		}
+		found = true
+		excluded = value != "false"
+	}
+	if !found {
+		return attrPattern{}, false
+	}
+
+	anchored := strings.HasPrefix(glob, "/")
+	glob = strings.TrimPrefix(glob, "/")
+	if strings.Contains(glob, "/") {
+		anchored = true
+	}
+
+	return attrPattern{
+		regex:    gitignoreGlobToRegex(glob, anchored),
+		anchored: anchored,
+		baseDir:  baseDir,
+		excluded: excluded,
+	}, true
+}
+
+// matches reports whether candidate - or one of its ancestor directories -
+// matches pattern, so a file nested under an ignored directory is caught
+// even though the file itself never appears in any .gitignore.
+func (pattern ignorePattern) matches(candidate string) bool {
+	if candidate == "" || strings.HasPrefix(candidate, "../") {
+		return false
+	}
+
+	if pattern.regex.MatchString(candidate) {
+		return true
+	}
+
+	parts := strings.Split(candidate, "/")
+	for k := 1; k < len(parts); k++ {
+		if pattern.regex.MatchString(strings.Join(parts[:k], "/")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package scanner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIgnoreMatcher_NestedGitignoreAndNegation(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "ignore_nested_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer func(path string) {
+		if err := os.RemoveAll(path); err != nil {
+			t.Fatalf("Failed to clean up temp directory %s: %v", path, err)
+		}
+	}(tempDir)
+
+	if err := os.WriteFile(filepath.Join(tempDir, ".gitignore"), []byte("*.log\n"), 0644); err != nil {
+		t.Fatalf("Failed to write root .gitignore: %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(tempDir, "pkg"), 0755); err != nil {
+		t.Fatalf("Failed to create nested directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, "pkg", ".gitignore"), []byte("*.log\n!keep.log\n"), 0644); err != nil {
+		t.Fatalf("Failed to write nested .gitignore: %v", err)
+	}
+
+	matcher := NewIgnoreMatcher(os.DirFS(tempDir))
+
+	tests := []struct {
+		path     string
+		expected bool
+	}{
+		{"app.log", true},       // caught by the root .gitignore
+		{"pkg/debug.log", true}, // caught by both root and pkg's .gitignore
+		{"pkg/keep.log", false}, // pkg's "!keep.log" un-ignores it, overriding the root pattern
+		{"pkg/main.go", false},  // no pattern matches
+	}
+
+	for _, test := range tests {
+		if got := matcher.Match(test.path); got != test.expected {
+			t.Errorf("Match(%q) = %v, want %v", test.path, got, test.expected)
+		}
+	}
+}
+
+func TestIgnoreMatcher_Gitattributes(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "ignore_attrs_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer func(path string) {
+		if err := os.RemoveAll(path); err != nil {
+			t.Fatalf("Failed to clean up temp directory %s: %v", path, err)
+		}
+	}(tempDir)
+
+	attrsContent := `*.pb.go linguist-generated
+vendor/** linguist-vendored
+*.bin binary
+*.txt -binary
+`
+	if err := os.WriteFile(filepath.Join(tempDir, ".gitattributes"), []byte(attrsContent), 0644); err != nil {
+		t.Fatalf("Failed to write .gitattributes: %v", err)
+	}
+
+	matcher := NewIgnoreMatcher(os.DirFS(tempDir))
+
+	tests := []struct {
+		path     string
+		expected bool
+	}{
+		{"api.pb.go", true},           // linguist-generated
+		{"vendor/lib/thing.go", true}, // linguist-vendored
+		{"data.bin", true},            // binary
+		{"notes.txt", false},          // explicitly un-set, so not excluded
+		{"main.go", false},            // no matching pattern
+	}
+
+	for _, test := range tests {
+		if got := matcher.Match(test.path); got != test.expected {
+			t.Errorf("Match(%q) = %v, want %v", test.path, got, test.expected)
+		}
+	}
+}
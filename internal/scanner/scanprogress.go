@@ -0,0 +1,200 @@
+package scanner
+
+import (
+	"context"
+	iofs "io/fs"
+	"sync"
+	"sync/atomic"
+)
+
+// ScanProgress is one snapshot of a long-running scan's progress, sent to
+// the channel ScanAllFilesCtx/ScanFilesCtx/SearchInFilesCtx were given.
+// CurrentPath is the file a worker goroutine most recently finished, not a
+// serialized "currently processing" pointer - with multiple workers in
+// flight there isn't a single current file, only a most-recent one.
+type ScanProgress struct {
+	FilesSeen    int
+	FilesMatched int
+	BytesRead    int64
+	CurrentPath  string
+}
+
+// progressEmitter batches the counters ScanAllFilesCtx's worker pool
+// updates concurrently and sends a ScanProgress snapshot after every file,
+// dropping the update instead of blocking a worker if the caller isn't
+// keeping up with ch - a slow progress bar must never slow down the scan
+// it's reporting on.
+type progressEmitter struct {
+	ch           chan<- ScanProgress
+	filesSeen    int64
+	filesMatched int64
+	bytesRead    int64
+}
+
+func newProgressEmitter(ch chan<- ScanProgress) *progressEmitter {
+	return &progressEmitter{ch: ch}
+}
+
+func (p *progressEmitter) fileSeen(path string, size int64) {
+	if p == nil || p.ch == nil {
+		return
+	}
+	seen := atomic.AddInt64(&p.filesSeen, 1)
+	read := atomic.AddInt64(&p.bytesRead, size)
+	p.send(ScanProgress{
+		FilesSeen:    int(seen),
+		FilesMatched: int(atomic.LoadInt64(&p.filesMatched)),
+		BytesRead:    read,
+		CurrentPath:  path,
+	})
+}
+
+func (p *progressEmitter) fileMatched() {
+	if p == nil || p.ch == nil {
+		return
+	}
+	atomic.AddInt64(&p.filesMatched, 1)
+}
+
+func (p *progressEmitter) send(update ScanProgress) {
+	select {
+	case p.ch <- update:
+	default:
+	}
+}
+
+// ScanAllFilesCtx is ScanAllFiles with cancellation - checked at every file
+// boundary so a scan of a large monorepo can be interrupted between files
+// rather than only once the whole tree has been walked - and a
+// ScanProgress update after every file processed. progress may be nil, in
+// which case no updates are sent and this behaves exactly like
+// ScanAllFiles plus cancellation.
+func (fs *FileScanner) ScanAllFilesCtx(ctx context.Context, progress chan<- ScanProgress) (map[string]*UnifiedFileInfo, error) {
+	fs.cache.reset()
+
+	jobs := fs.jobs
+	if jobs < 1 {
+		jobs = 1
+	}
+
+	paths := make(chan unifiedPath, jobs*4)
+	walkErr := make(chan error, 1)
+
+	go func() {
+		defer close(paths)
+		walkErr <- fs.walkUnifiedPathsCtx(ctx, paths)
+	}()
+
+	fs.processPathsConcurrentlyCtx(ctx, paths, jobs, newProgressEmitter(progress))
+
+	if err := <-walkErr; err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return fs.GetCachedFiles(), err
+	}
+
+	return fs.GetCachedFiles(), nil
+}
+
+// walkUnifiedPathsCtx is walkUnifiedPaths, stopping the walk as soon as ctx
+// is canceled instead of continuing to enumerate the rest of the tree.
+func (fs *FileScanner) walkUnifiedPathsCtx(ctx context.Context, paths chan<- unifiedPath) error {
+	return iofs.WalkDir(fs.fsys, ".", func(path string, d iofs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		if shouldSkip := fs.shouldSkipDirectory(path, d); shouldSkip != nil {
+			return shouldSkip
+		}
+
+		if d.IsDir() || fs.shouldSkipPath(path) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		select {
+		case paths <- unifiedPath{path: path, info: info}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		return nil
+	})
+}
+
+// processPathsConcurrentlyCtx is processPathsConcurrently, additionally
+// skipping remaining work once ctx is canceled and publishing a
+// ScanProgress update (via emit) after every file it processes.
+func (fs *FileScanner) processPathsConcurrentlyCtx(ctx context.Context, paths <-chan unifiedPath, jobs int, emit *progressEmitter) {
+	var wg sync.WaitGroup
+	wg.Add(jobs)
+
+	for i := 0; i < jobs; i++ {
+		go func() {
+			defer wg.Done()
+			for p := range paths {
+				if ctx.Err() != nil {
+					continue // drain paths without processing so the walker goroutine isn't left blocked on a full channel
+				}
+				_ = fs.processFile(p.path, p.info)
+				emit.fileSeen(p.path, p.info.Size())
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// ScanFilesCtx is ScanFiles with cancellation and ScanProgress reporting -
+// see ScanAllFilesCtx.
+func (fs *FileScanner) ScanFilesCtx(ctx context.Context, progress chan<- ScanProgress) ([]FileInfo, error) {
+	cachedFiles, err := fs.ScanAllFilesCtx(ctx, progress)
+
+	files := make([]FileInfo, 0, len(cachedFiles))
+	for _, file := range cachedFiles {
+		files = append(files, FileInfo{
+			Path:         file.Path,
+			RelativePath: file.RelativePath,
+			Size:         file.Size,
+			Extension:    file.Extension,
+			IsText:       file.IsText,
+			LineCount:    file.LineCount,
+		})
+	}
+
+	return files, err
+}
+
+// SearchInFilesCtx is SearchInFiles with ScanProgress reporting, built on
+// top of SearchInFilesStream the same way SearchInFiles itself is -
+// FilesSeen/BytesRead count every candidate file opened, FilesMatched
+// counts distinct files that produced at least one Match.
+func (fs *FileScanner) SearchInFilesCtx(ctx context.Context, pattern string, extensions []string, progress chan<- ScanProgress) ([]Match, error) {
+	matchCh, errCh := fs.SearchInFilesStream(ctx, pattern, extensions)
+
+	emit := newProgressEmitter(progress)
+	matchedFiles := make(map[string]bool)
+
+	var matches []Match
+	for m := range matchCh {
+		matches = append(matches, m)
+		if !matchedFiles[m.File] {
+			matchedFiles[m.File] = true
+			emit.fileMatched()
+		}
+		emit.fileSeen(m.File, 0)
+	}
+
+	if err := <-errCh; err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
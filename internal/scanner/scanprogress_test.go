@@ -0,0 +1,125 @@
+package scanner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileScanner_ScanAllFilesCtx_MatchesScanAllFiles(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "scan_ctx_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer func(path string) {
+		if err := os.RemoveAll(path); err != nil {
+			t.Fatalf("Failed to clean up temp directory %s: %v", path, err)
+		}
+	}(tempDir)
+
+	testFiles := map[string]string{
+		"main.go":   "package main\nfunc main() {}",
+		"README.md": "# Test Project",
+	}
+	for filename, content := range testFiles {
+		if err := os.WriteFile(filepath.Join(tempDir, filename), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create test file %s: %v", filename, err)
+		}
+	}
+
+	fileScanner, err := NewFileScanner(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create scanner: %v", err)
+	}
+
+	progressCh := make(chan ScanProgress, 16)
+	files, err := fileScanner.ScanAllFilesCtx(context.Background(), progressCh)
+	close(progressCh)
+	if err != nil {
+		t.Fatalf("ScanAllFilesCtx failed: %v", err)
+	}
+	if len(files) != len(testFiles) {
+		t.Fatalf("Expected %d files, got %d", len(testFiles), len(files))
+	}
+
+	var last ScanProgress
+	updates := 0
+	for update := range progressCh {
+		updates++
+		last = update
+	}
+	if updates != len(testFiles) {
+		t.Errorf("Expected %d progress updates, got %d", len(testFiles), updates)
+	}
+	if last.FilesSeen != len(testFiles) {
+		t.Errorf("Expected final FilesSeen to be %d, got %d", len(testFiles), last.FilesSeen)
+	}
+}
+
+func TestFileScanner_ScanAllFilesCtx_Canceled(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "scan_ctx_cancel_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer func(path string) {
+		if err := os.RemoveAll(path); err != nil {
+			t.Fatalf("Failed to clean up temp directory %s: %v", path, err)
+		}
+	}(tempDir)
+
+	if err := os.WriteFile(filepath.Join(tempDir, "main.go"), []byte("package main"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	fileScanner, err := NewFileScanner(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create scanner: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := fileScanner.ScanAllFilesCtx(ctx, nil); err == nil {
+		t.Error("Expected ScanAllFilesCtx to return an error for an already-canceled context")
+	}
+}
+
+func TestFileScanner_SearchInFilesCtx(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "search_ctx_test")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer func(path string) {
+		if err := os.RemoveAll(path); err != nil {
+			t.Fatalf("Failed to clean up temp directory %s: %v", path, err)
+		}
+	}(tempDir)
+
+	if err := os.WriteFile(filepath.Join(tempDir, "main.go"), []byte("password := \"hunter2\""), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	fileScanner, err := NewFileScanner(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to create scanner: %v", err)
+	}
+
+	progressCh := make(chan ScanProgress, 16)
+	matches, err := fileScanner.SearchInFilesCtx(context.Background(), "password", nil, progressCh)
+	close(progressCh)
+	if err != nil {
+		t.Fatalf("SearchInFilesCtx failed: %v", err)
+	}
+	if len(matches) != 1 {
+		t.Fatalf("Expected 1 match, got %d", len(matches))
+	}
+
+	var last ScanProgress
+	for update := range progressCh {
+		last = update
+	}
+	if last.FilesMatched != 1 {
+		t.Errorf("Expected FilesMatched to be 1, got %d", last.FilesMatched)
+	}
+}
@@ -0,0 +1,130 @@
+// Package scope implements Go-style package-pattern scope selection
+// (e.g. "./cmd/...", "internal/...") for restricting analyzers to a
+// subtree of a repository, the same shape `go build ./...` uses for its
+// package patterns.
+package scope
+
+import (
+	"fmt"
+	"strings"
+)
+
+// pattern is one compiled scope entry: a directory prefix, optionally
+// recursive (a trailing "/..."), and whether it's an include or an
+// exclude (a leading "-").
+type pattern struct {
+	prefix    string
+	recursive bool
+	exclude   bool
+}
+
+// Scope filters repository-relative file paths against a list of
+// include/exclude package patterns. A Scope with no include patterns
+// matches everything except what its excludes remove.
+type Scope struct {
+	patterns []pattern
+}
+
+// Parse compiles raw into a Scope. Each entry names a directory: "..."
+// matches the whole repository, "./cmd/..." or "cmd/..." matches cmd and
+// everything beneath it, and a bare "internal/scope" (no "...") matches
+// only files directly in that directory, not its subdirectories - the
+// same distinction `go build ./foo` vs `go build ./foo/...` makes. A
+// leading "-" excludes instead of includes, e.g. "-internal/testdata/...".
+func Parse(raw []string) (*Scope, error) {
+	s := &Scope{}
+	for _, entry := range raw {
+		p, err := parsePattern(entry)
+		if err != nil {
+			return nil, err
+		}
+		s.patterns = append(s.patterns, p)
+	}
+	return s, nil
+}
+
+func parsePattern(entry string) (pattern, error) {
+	if entry == "" {
+		return pattern{}, fmt.Errorf("scope pattern must not be empty")
+	}
+
+	exclude := false
+	if strings.HasPrefix(entry, "-") {
+		exclude = true
+		entry = entry[1:]
+	}
+
+	entry = strings.TrimPrefix(entry, "./")
+	if entry == "" {
+		return pattern{}, fmt.Errorf("invalid scope pattern %q", entry)
+	}
+
+	if entry == "..." {
+		return pattern{recursive: true, exclude: exclude}, nil
+	}
+
+	if strings.HasSuffix(entry, "/...") {
+		prefix := strings.TrimSuffix(entry, "/...")
+		return pattern{prefix: prefix, recursive: true, exclude: exclude}, nil
+	}
+
+	return pattern{prefix: strings.TrimSuffix(entry, "/"), exclude: exclude}, nil
+}
+
+// Match reports whether relPath (repository-relative, forward-slash
+// separated) is in scope: it must match at least one include pattern (or
+// there must be no include patterns at all), and it must not match any
+// exclude pattern. An exclude always wins over an include regardless of
+// pattern order, the same override-wins composition --file-patterns uses.
+func (s *Scope) Match(relPath string) bool {
+	if s == nil || len(s.patterns) == 0 {
+		return true
+	}
+
+	hasIncludes := false
+	included := false
+	excluded := false
+
+	for _, p := range s.patterns {
+		if p.exclude {
+			if p.matches(relPath) {
+				excluded = true
+			}
+			continue
+		}
+		hasIncludes = true
+		if p.matches(relPath) {
+			included = true
+		}
+	}
+
+	if excluded {
+		return false
+	}
+	return !hasIncludes || included
+}
+
+// matches reports whether relPath falls under p: an empty prefix (the
+// bare "..." pattern) matches everything, an exact match always counts,
+// and a recursive pattern additionally matches any path nested beneath
+// its prefix.
+func (p pattern) matches(relPath string) bool {
+	if p.prefix == "" {
+		return p.recursive
+	}
+	if relPath == p.prefix {
+		return true
+	}
+	if p.recursive {
+		return strings.HasPrefix(relPath, p.prefix+"/")
+	}
+	return dirOf(relPath) == p.prefix
+}
+
+// dirOf returns relPath's containing directory, "" for a top-level file.
+func dirOf(relPath string) string {
+	if idx := strings.LastIndex(relPath, "/"); idx >= 0 {
+		return relPath[:idx]
+	}
+	return ""
+}
@@ -0,0 +1,83 @@
+package scope
+
+import "testing"
+
+func TestScope_Match_NoPatterns(t *testing.T) {
+	s, err := Parse(nil)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if !s.Match("cmd/check.go") {
+		t.Error("expected a Scope with no patterns to match everything")
+	}
+}
+
+func TestScope_Match_RecursiveInclude(t *testing.T) {
+	s, err := Parse([]string{"./cmd/..."})
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	tests := []struct {
+		path     string
+		expected bool
+	}{
+		{"cmd/check.go", true},
+		{"cmd/sub/dir/file.go", true},
+		{"internal/analyzer/security.go", false},
+		{"cmdx/file.go", false}, // prefix match must respect the path boundary
+	}
+
+	for _, test := range tests {
+		if got := s.Match(test.path); got != test.expected {
+			t.Errorf("Match(%q) = %v, want %v", test.path, got, test.expected)
+		}
+	}
+}
+
+func TestScope_Match_NonRecursiveMatchesOnlyDirectChildren(t *testing.T) {
+	s, err := Parse([]string{"internal/scope"})
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if !s.Match("internal/scope/scope.go") {
+		t.Error("expected a bare directory pattern to match its direct children")
+	}
+	if s.Match("internal/scope/sub/nested.go") {
+		t.Error("expected a bare directory pattern not to match nested subdirectories")
+	}
+}
+
+func TestScope_Match_ExcludeWinsOverInclude(t *testing.T) {
+	s, err := Parse([]string{"./internal/...", "-internal/testdata/..."})
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if !s.Match("internal/scope/scope.go") {
+		t.Error("expected internal/scope/scope.go to be in scope")
+	}
+	if s.Match("internal/testdata/fixture.go") {
+		t.Error("expected internal/testdata/... to be excluded even though it's under internal/...")
+	}
+}
+
+func TestScope_Match_WholeRepo(t *testing.T) {
+	s, err := Parse([]string{"..."})
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if !s.Match("anything/at/all.go") {
+		t.Error("expected the bare \"...\" pattern to match every path")
+	}
+}
+
+func TestParse_RejectsEmptyPattern(t *testing.T) {
+	if _, err := Parse([]string{""}); err == nil {
+		t.Error("expected an empty scope pattern to be rejected")
+	}
+	if _, err := Parse([]string{"-"}); err == nil {
+		t.Error("expected a bare \"-\" scope pattern to be rejected")
+	}
+}
@@ -0,0 +1,143 @@
+package verifier
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const awsSTSHost = "sts.amazonaws.com"
+
+// awsVerifier confirms an AWS credential by calling sts:GetCallerIdentity,
+// the standard read-only "who am I" call for any AWS principal.
+type awsVerifier struct{}
+
+func (awsVerifier) Name() string   { return "aws" }
+func (awsVerifier) Host() string   { return awsSTSHost }
+func (awsVerifier) ReadOnly() bool { return true }
+
+// awsCallerIdentity mirrors the fields of GetCallerIdentity's XML response
+// this verifier cares about.
+type awsCallerIdentity struct {
+	XMLName xml.Name `xml:"GetCallerIdentityResponse"`
+	Result  struct {
+		Account string `xml:"Account"`
+		Arn     string `xml:"Arn"`
+		UserID  string `xml:"UserId"`
+	} `xml:"GetCallerIdentityResult"`
+}
+
+// Verify signs and sends sts:GetCallerIdentity using secret. sign-v4
+// requires both an access key ID and a secret access key, so secret is
+// expected in "AKIAID:secretaccesskey" form; the two SecretRule regexes
+// that match AWS credentials (aws-access-key-id, aws-secret-access-key)
+// each capture only one half, so pairing them into that form is left to a
+// rule that captures both (e.g. via a capture group combining adjacent
+// lines) and names this verifier.
+func (v awsVerifier) Verify(ctx context.Context, secret string) (Result, error) {
+	accessKeyID, secretKey, ok := strings.Cut(secret, ":")
+	if !ok || accessKeyID == "" || secretKey == "" {
+		return Result{}, fmt.Errorf("aws verifier requires a %q formatted candidate pairing an access key ID with its secret key", "AKIAID:secretkey")
+	}
+
+	now := time.Now().UTC()
+	req, err := signedGetCallerIdentityRequest(ctx, accessKeyID, secretKey, now)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to build signed STS request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to call sts:GetCallerIdentity: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		// An invalid/expired credential fails auth, not the verifier itself.
+		return Result{Valid: false}, nil
+	}
+
+	var identity awsCallerIdentity
+	if err := xml.NewDecoder(resp.Body).Decode(&identity); err != nil {
+		return Result{}, fmt.Errorf("failed to decode STS response: %w", err)
+	}
+
+	return Result{Valid: true, Identity: identity.Result.Account}, nil
+}
+
+// signedGetCallerIdentityRequest builds a SigV4-signed GET request for
+// sts:GetCallerIdentity, the minimal signing case since the call has no
+// body and every parameter lives in the query string.
+func signedGetCallerIdentityRequest(ctx context.Context, accessKeyID, secretKey string, now time.Time) (*http.Request, error) {
+	const region = "us-east-1"
+	const service = "sts"
+
+	amzDate := now.Format("20060102T150405Z")
+	dateStamp := now.Format("20060102")
+
+	canonicalQuery := "Action=GetCallerIdentity&Version=2011-06-15"
+	canonicalHeaders := fmt.Sprintf("host:%s\nx-amz-date:%s\n", awsSTSHost, amzDate)
+	signedHeaders := "host;x-amz-date"
+	payloadHash := sha256Hex("")
+
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		"/",
+		canonicalQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	credentialScope := fmt.Sprintf("%s/%s/%s/aws4_request", dateStamp, region, service)
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		credentialScope,
+		sha256Hex(canonicalRequest),
+	}, "\n")
+
+	signingKey := awsSigningKey(secretKey, dateStamp, region, service)
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	authHeader := fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKeyID, credentialScope, signedHeaders, signature,
+	)
+
+	url := fmt.Sprintf("https://%s/?%s", awsSTSHost, canonicalQuery)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Host", awsSTSHost)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("Authorization", authHeader)
+	req.Header.Set("Accept", "application/json")
+
+	return req, nil
+}
+
+func awsSigningKey(secretKey, dateStamp, region, service string) []byte {
+	kDate := hmacSHA256([]byte("AWS4"+secretKey), dateStamp)
+	kRegion := hmacSHA256(kDate, region)
+	kService := hmacSHA256(kRegion, service)
+	return hmacSHA256(kService, "aws4_request")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func sha256Hex(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,46 @@
+package verifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const githubHost = "api.github.com"
+
+// githubVerifier confirms a GitHub token by calling GET /user, the
+// read-only endpoint every valid token (classic or fine-grained) can reach.
+type githubVerifier struct{}
+
+func (githubVerifier) Name() string   { return "github" }
+func (githubVerifier) Host() string   { return githubHost }
+func (githubVerifier) ReadOnly() bool { return true }
+
+func (githubVerifier) Verify(ctx context.Context, secret string) (Result, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+githubHost+"/user", nil)
+	if err != nil {
+		return Result{}, err
+	}
+	req.Header.Set("Authorization", "Bearer "+secret)
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to call GitHub /user: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return Result{Valid: false}, nil
+	}
+
+	var user struct {
+		Login string `json:"login"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return Result{}, fmt.Errorf("failed to decode GitHub /user response: %w", err)
+	}
+
+	return Result{Valid: true, Identity: user.Login}, nil
+}
@@ -0,0 +1,50 @@
+package verifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const slackHost = "slack.com"
+
+// slackVerifier confirms a Slack token by calling auth.test, the read-only
+// endpoint Slack documents specifically for checking a token's validity.
+type slackVerifier struct{}
+
+func (slackVerifier) Name() string   { return "slack" }
+func (slackVerifier) Host() string   { return slackHost }
+func (slackVerifier) ReadOnly() bool { return true }
+
+func (slackVerifier) Verify(ctx context.Context, secret string) (Result, error) {
+	url := "https://" + slackHost + "/api/auth.test"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(""))
+	if err != nil {
+		return Result{}, err
+	}
+	req.Header.Set("Authorization", "Bearer "+secret)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to call Slack auth.test: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var result struct {
+		OK   bool   `json:"ok"`
+		User string `json:"user"`
+		Team string `json:"team"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return Result{}, fmt.Errorf("failed to decode Slack auth.test response: %w", err)
+	}
+
+	if !result.OK {
+		return Result{Valid: false}, nil
+	}
+
+	return Result{Valid: true, Identity: fmt.Sprintf("%s/%s", result.Team, result.User)}, nil
+}
@@ -0,0 +1,45 @@
+package verifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const stripeHost = "api.stripe.com"
+
+// stripeVerifier confirms a Stripe API key by calling GET /v1/account, the
+// read-only endpoint that returns the account the key belongs to.
+type stripeVerifier struct{}
+
+func (stripeVerifier) Name() string   { return "stripe" }
+func (stripeVerifier) Host() string   { return stripeHost }
+func (stripeVerifier) ReadOnly() bool { return true }
+
+func (stripeVerifier) Verify(ctx context.Context, secret string) (Result, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+stripeHost+"/v1/account", nil)
+	if err != nil {
+		return Result{}, err
+	}
+	req.SetBasicAuth(secret, "")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to call Stripe /v1/account: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return Result{Valid: false}, nil
+	}
+
+	var account struct {
+		ID string `json:"id"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&account); err != nil {
+		return Result{}, fmt.Errorf("failed to decode Stripe /v1/account response: %w", err)
+	}
+
+	return Result{Valid: true, Identity: account.ID}, nil
+}
@@ -0,0 +1,185 @@
+// Package verifier confirms that a candidate secret found by the security
+// analyzer is actually live, by probing the issuing service's own identity
+// endpoint (AWS STS, GitHub's /user, Slack's auth.test, Stripe's /v1/account,
+// and so on). It never echoes the secret back; a successful Verify only
+// returns the responding identity (account ID, username, ...).
+package verifier
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Mode controls how much of a repository scan is allowed to reach out to
+// live services. ModeSafe only runs verifiers whose ReadOnly is true.
+type Mode string
+
+const (
+	ModeOff  Mode = "off"
+	ModeSafe Mode = "safe"
+	ModeAll  Mode = "all"
+)
+
+// ParseMode validates a --verify flag value.
+func ParseMode(s string) (Mode, error) {
+	switch Mode(s) {
+	case ModeOff, ModeSafe, ModeAll:
+		return Mode(s), nil
+	default:
+		return "", fmt.Errorf("invalid verify mode %q, must be one of: off, safe, all", s)
+	}
+}
+
+// Result is what a Verifier learns about a candidate secret. Identity is
+// the responding service's own account/user identifier, never the secret
+// itself.
+type Result struct {
+	Valid    bool
+	Identity string
+}
+
+// Verifier probes one issuing service to confirm a candidate credential is
+// still valid.
+type Verifier interface {
+	Name() string
+	// Host identifies the upstream service for per-host rate limiting.
+	Host() string
+	// ReadOnly reports whether Verify only hits a read-only identity
+	// endpoint, so ModeSafe can call it without risking a billable or
+	// mutating request.
+	ReadOnly() bool
+	Verify(ctx context.Context, secret string) (Result, error)
+}
+
+var registry = map[string]Verifier{}
+
+// Register adds v to the set of verifiers a config.SecretRule's Verifier
+// field can name. Built-in verifiers register themselves in this package's
+// init; callers embedding this package can register their own.
+func Register(v Verifier) {
+	registry[v.Name()] = v
+}
+
+// Lookup returns the verifier registered under name, if any.
+func Lookup(name string) (Verifier, bool) {
+	v, ok := registry[name]
+	return v, ok
+}
+
+func init() {
+	Register(awsVerifier{})
+	Register(githubVerifier{})
+	Register(slackVerifier{})
+	Register(stripeVerifier{})
+}
+
+// Job is one candidate secret to verify, tagged with the rule that matched
+// it so the caller can reattach Result to the right Issue.
+type Job struct {
+	RuleID   string
+	Verifier string
+	Secret   string
+}
+
+// JobResult is the outcome of running a Job through a Pool.
+type JobResult struct {
+	Job
+	Result
+	Err error
+}
+
+// defaultConcurrency bounds how many verification requests run at once,
+// and defaultHostInterval spaces out requests to the same host, so a scan
+// with many candidate secrets can't hammer (or get rate-limited by) a
+// single upstream service.
+const (
+	defaultConcurrency  = 4
+	defaultHostInterval = 500 * time.Millisecond
+	defaultTimeout      = 10 * time.Second
+)
+
+// Pool runs verification Jobs behind a bounded worker pool with per-host
+// rate limiting and a context-based timeout per call, so a repo scan can't
+// stall waiting on a slow or unresponsive upstream service.
+type Pool struct {
+	Mode Mode
+
+	concurrency  int
+	hostInterval time.Duration
+	timeout      time.Duration
+
+	mu      sync.Mutex
+	lastHit map[string]time.Time
+}
+
+// NewPool creates a Pool that runs verification in the given Mode.
+func NewPool(mode Mode) *Pool {
+	return &Pool{
+		Mode:         mode,
+		concurrency:  defaultConcurrency,
+		hostInterval: defaultHostInterval,
+		timeout:      defaultTimeout,
+		lastHit:      make(map[string]time.Time),
+	}
+}
+
+// Run verifies every job concurrently (bounded by the pool's concurrency
+// limit) and returns one JobResult per job, in the same order. A job naming
+// an unregistered verifier, or a mutating verifier under ModeSafe, resolves
+// to a JobResult carrying Err instead of being dropped.
+func (p *Pool) Run(ctx context.Context, jobs []Job) []JobResult {
+	results := make([]JobResult, len(jobs))
+	sem := make(chan struct{}, p.concurrency)
+	var wg sync.WaitGroup
+
+	for i, job := range jobs {
+		v, ok := Lookup(job.Verifier)
+		if !ok {
+			results[i] = JobResult{Job: job, Err: fmt.Errorf("unknown verifier %q", job.Verifier)}
+			continue
+		}
+		if p.Mode == ModeSafe && !v.ReadOnly() {
+			results[i] = JobResult{Job: job, Err: fmt.Errorf("verifier %q is not read-only, skipped under --verify=safe", job.Verifier)}
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, job Job, v Verifier) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			p.throttle(v.Host())
+
+			callCtx, cancel := context.WithTimeout(ctx, p.timeout)
+			defer cancel()
+
+			res, err := v.Verify(callCtx, job.Secret)
+			results[i] = JobResult{Job: job, Result: res, Err: err}
+		}(i, job, v)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// throttle blocks until at least hostInterval has passed since the last
+// call for host, enforcing the per-host rate limit across all workers.
+func (p *Pool) throttle(host string) {
+	p.mu.Lock()
+	last, seen := p.lastHit[host]
+	wait := time.Duration(0)
+	if seen {
+		if elapsed := time.Since(last); elapsed < p.hostInterval {
+			wait = p.hostInterval - elapsed
+		}
+	}
+	p.lastHit[host] = time.Now().Add(wait)
+	p.mu.Unlock()
+
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+}
@@ -0,0 +1,89 @@
+package verifier
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// fakeVerifier is a test-only Verifier that never makes a network call, so
+// Pool.Run can be exercised without external dependencies.
+type fakeVerifier struct {
+	name     string
+	host     string
+	readOnly bool
+	result   Result
+	err      error
+}
+
+func (f fakeVerifier) Name() string   { return f.name }
+func (f fakeVerifier) Host() string   { return f.host }
+func (f fakeVerifier) ReadOnly() bool { return f.readOnly }
+func (f fakeVerifier) Verify(_ context.Context, _ string) (Result, error) {
+	return f.result, f.err
+}
+
+func TestParseMode(t *testing.T) {
+	for _, valid := range []string{"off", "safe", "all"} {
+		if _, err := ParseMode(valid); err != nil {
+			t.Errorf("expected %q to be a valid mode, got error: %v", valid, err)
+		}
+	}
+
+	if _, err := ParseMode("bogus"); err == nil {
+		t.Error("expected an error for an invalid mode")
+	}
+}
+
+func TestPool_Run_ReturnsResultsInOrder(t *testing.T) {
+	Register(fakeVerifier{name: "fake-valid", host: "fake.example", readOnly: true, result: Result{Valid: true, Identity: "acct-1"}})
+	Register(fakeVerifier{name: "fake-invalid", host: "fake.example", readOnly: true, result: Result{Valid: false}})
+
+	pool := &Pool{Mode: ModeSafe, concurrency: 2, hostInterval: time.Millisecond, timeout: time.Second, lastHit: make(map[string]time.Time)}
+	jobs := []Job{
+		{RuleID: "r1", Verifier: "fake-valid", Secret: "s1"},
+		{RuleID: "r2", Verifier: "fake-invalid", Secret: "s2"},
+	}
+
+	results := pool.Run(context.Background(), jobs)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if !results[0].Valid || results[0].Identity != "acct-1" {
+		t.Errorf("expected job 0 to be valid with identity acct-1, got %+v", results[0])
+	}
+	if results[1].Valid {
+		t.Errorf("expected job 1 to be invalid, got %+v", results[1])
+	}
+}
+
+func TestPool_Run_UnknownVerifier(t *testing.T) {
+	pool := &Pool{Mode: ModeAll, concurrency: 1, hostInterval: time.Millisecond, timeout: time.Second, lastHit: make(map[string]time.Time)}
+	results := pool.Run(context.Background(), []Job{{RuleID: "r1", Verifier: "does-not-exist", Secret: "s1"}})
+
+	if results[0].Err == nil {
+		t.Error("expected an error for an unregistered verifier")
+	}
+}
+
+func TestPool_Run_SafeModeSkipsMutatingVerifier(t *testing.T) {
+	Register(fakeVerifier{name: "fake-mutating", host: "fake.example", readOnly: false, result: Result{Valid: true}})
+
+	pool := &Pool{Mode: ModeSafe, concurrency: 1, hostInterval: time.Millisecond, timeout: time.Second, lastHit: make(map[string]time.Time)}
+	results := pool.Run(context.Background(), []Job{{RuleID: "r1", Verifier: "fake-mutating", Secret: "s1"}})
+
+	if results[0].Err == nil {
+		t.Error("expected --verify=safe to skip a non-read-only verifier")
+	}
+	if results[0].Valid {
+		t.Error("a skipped job should not report Valid")
+	}
+}
+
+func TestBuiltinVerifiersRegistered(t *testing.T) {
+	for _, name := range []string{"aws", "github", "slack", "stripe"} {
+		if _, ok := Lookup(name); !ok {
+			t.Errorf("expected built-in verifier %q to be registered", name)
+		}
+	}
+}